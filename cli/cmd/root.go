@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	v1 "redis/internal/gen/cloud/v1"
 	cloudv1connect "redis/internal/gen/cloud/v1/cloudv1connect"
 	"redis/internal/route"
@@ -35,6 +36,7 @@ var (
 	logLevel  string
 	httpAddr  string // Changed from 'address' to 'httpAddr' for clarity
 	raftDir   string
+	backupDir string
 	raftAddr  string
 	joinAddr  string
 	nodeID    string
@@ -71,6 +73,7 @@ func init() {
 
 	// Local flags
 	rootCmd.Flags().StringVar(&raftDir, "dir", "", "Raft storage directory")
+	rootCmd.Flags().StringVar(&backupDir, "backup-dir", "", "Directory for backup files (default <dir>/backups)")
 	rootCmd.Flags().StringVar(&httpAddr, "addr", "127.0.0.1:12000", "HTTP server address")
 	rootCmd.Flags().StringVar(&raftAddr, "raft-addr", "127.0.0.1:12001", "Raft bind address")
 	rootCmd.Flags().StringVar(&joinAddr, "join", "", "Set join address, if any")
@@ -114,7 +117,7 @@ func runRootCommand() error {
 
 	serverErrChan := startServer(srv)
 
-	return handleServerLifecycle(srv, exitChan, serverErrChan)
+	return handleServerLifecycle(srv, store, exitChan, serverErrChan)
 }
 
 func initLogger(level string) {
@@ -141,6 +144,10 @@ func initializeStore() *store.Store {
 
 	redisServerStore := store.New(true)
 	redisServerStore.RaftDir = raftDir
+	redisServerStore.BackupDir = backupDir
+	if backupDir == "" {
+		redisServerStore.BackupDir = filepath.Join(raftDir, "backups")
+	}
 	redisServerStore.RaftBind = raftAddr
 	if err := redisServerStore.Open(joinAddr == "", nodeID); err != nil {
 		log.Fatalf("failed to open store: %s", err.Error())
@@ -234,7 +241,7 @@ func joinCluster(joinAddr, raftAddr, nodeID string) error {
 	return nil
 }
 
-func handleServerLifecycle(srv *http.Server, exitChan chan os.Signal, serverErrChan chan error) error {
+func handleServerLifecycle(srv *http.Server, store *store.Store, exitChan chan os.Signal, serverErrChan chan error) error {
 	select {
 	case <-exitChan:
 		slog.Info("Shutdown signal received, shutting down server...")
@@ -246,6 +253,11 @@ func handleServerLifecycle(srv *http.Server, exitChan chan os.Signal, serverErrC
 		return fmt.Errorf("HTTP server shutdown failed: %w", err)
 	}
 	slog.Info("HTTP server shut down gracefully")
+
+	if err := store.Close(); err != nil {
+		return fmt.Errorf("store shutdown failed: %w", err)
+	}
+	slog.Info("Store shut down")
 	return nil
 }
 
@@ -7,7 +7,7 @@
 package cloudv1
 
 import (
-	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -29,7 +29,18 @@ type SetRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Max 512KB
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Max 512KB, binary safe
+	Nx    bool   `protobuf:"varint,3,opt,name=nx,proto3" json:"nx,omitempty"`      // Only set the key if it does not exist
+	Xx    bool   `protobuf:"varint,4,opt,name=xx,proto3" json:"xx,omitempty"`      // Only set the key if it exists
+	Get   bool   `protobuf:"varint,5,opt,name=get,proto3" json:"get,omitempty"`    // Return the previous value of the key
+	// The key does not expire if unset
+	//
+	// Types that are assignable to Expiration:
+	//	*SetRequest_Ttl
+	//	*SetRequest_UnixTimeSeconds
+	//	*SetRequest_UnixTimeMilliseconds
+	//	*SetRequest_KeepTtl
+	Expiration isSetRequest_Expiration `protobuf_oneof:"expiration"`
 }
 
 func (x *SetRequest) Reset() {
@@ -71,20 +82,105 @@ func (x *SetRequest) GetKey() string {
 	return ""
 }
 
-func (x *SetRequest) GetValue() string {
+func (x *SetRequest) GetValue() []byte {
 	if x != nil {
 		return x.Value
 	}
-	return ""
+	return nil
+}
+
+func (x *SetRequest) GetNx() bool {
+	if x != nil {
+		return x.Nx
+	}
+	return false
+}
+
+func (x *SetRequest) GetXx() bool {
+	if x != nil {
+		return x.Xx
+	}
+	return false
+}
+
+func (x *SetRequest) GetGet() bool {
+	if x != nil {
+		return x.Get
+	}
+	return false
+}
+
+func (m *SetRequest) GetExpiration() isSetRequest_Expiration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (x *SetRequest) GetTtl() *durationpb.Duration {
+	if x, ok := x.GetExpiration().(*SetRequest_Ttl); ok {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *SetRequest) GetUnixTimeSeconds() int64 {
+	if x, ok := x.GetExpiration().(*SetRequest_UnixTimeSeconds); ok {
+		return x.UnixTimeSeconds
+	}
+	return 0
+}
+
+func (x *SetRequest) GetUnixTimeMilliseconds() int64 {
+	if x, ok := x.GetExpiration().(*SetRequest_UnixTimeMilliseconds); ok {
+		return x.UnixTimeMilliseconds
+	}
+	return 0
+}
+
+func (x *SetRequest) GetKeepTtl() bool {
+	if x, ok := x.GetExpiration().(*SetRequest_KeepTtl); ok {
+		return x.KeepTtl
+	}
+	return false
+}
+
+type isSetRequest_Expiration interface {
+	isSetRequest_Expiration()
+}
+
+type SetRequest_Ttl struct {
+	Ttl *durationpb.Duration `protobuf:"bytes,6,opt,name=ttl,proto3,oneof"`
+}
+
+type SetRequest_UnixTimeSeconds struct {
+	UnixTimeSeconds int64 `protobuf:"varint,7,opt,name=unix_time_seconds,json=unixTimeSeconds,proto3,oneof"`
+}
+
+type SetRequest_UnixTimeMilliseconds struct {
+	UnixTimeMilliseconds int64 `protobuf:"varint,8,opt,name=unix_time_milliseconds,json=unixTimeMilliseconds,proto3,oneof"`
+}
+
+type SetRequest_KeepTtl struct {
+	KeepTtl bool `protobuf:"varint,9,opt,name=keep_ttl,json=keepTtl,proto3,oneof"` // Keep the timeout of an existing key
 }
 
+func (*SetRequest_Ttl) isSetRequest_Expiration() {}
+
+func (*SetRequest_UnixTimeSeconds) isSetRequest_Expiration() {}
+
+func (*SetRequest_UnixTimeMilliseconds) isSetRequest_Expiration() {}
+
+func (*SetRequest_KeepTtl) isSetRequest_Expiration() {}
+
 // SetResponse represents the response from a Set operation
 type SetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Success  bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`        // False if nx or xx prevented the set
+	Previous []byte `protobuf:"bytes,2,opt,name=previous,proto3,oneof" json:"previous,omitempty"` // With get, unset if the key did not exist
 }
 
 func (x *SetResponse) Reset() {
@@ -126,6 +222,13 @@ func (x *SetResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *SetResponse) GetPrevious() []byte {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
 // GetRequest represents the request to retrieve a value by key
 type GetRequest struct {
 	state         protoimpl.MessageState
@@ -228,7 +331,7 @@ type DelRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keys string `protobuf:"bytes,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
 func (x *DelRequest) Reset() {
@@ -263,11 +366,11 @@ func (*DelRequest) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DelRequest) GetKeys() string {
+func (x *DelRequest) GetKeys() []string {
 	if x != nil {
 		return x.Keys
 	}
-	return ""
+	return nil
 }
 
 // DelResponse represents the response from a Del operation
@@ -414,18 +517,18 @@ func (x *IncrResponse) GetValue() int64 {
 	return 0
 }
 
-// ExpireRequest represents the request to set an expiration on a key
-type ExpireRequest struct {
+// IncrByRequest represents the request to increment a key's value by an amount
+type IncrByRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Increment int64  `protobuf:"varint,2,opt,name=increment,proto3" json:"increment,omitempty"`
 }
 
-func (x *ExpireRequest) Reset() {
-	*x = ExpireRequest{}
+func (x *IncrByRequest) Reset() {
+	*x = IncrByRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -433,13 +536,13 @@ func (x *ExpireRequest) Reset() {
 	}
 }
 
-func (x *ExpireRequest) String() string {
+func (x *IncrByRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpireRequest) ProtoMessage() {}
+func (*IncrByRequest) ProtoMessage() {}
 
-func (x *ExpireRequest) ProtoReflect() protoreflect.Message {
+func (x *IncrByRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -451,36 +554,36 @@ func (x *ExpireRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpireRequest.ProtoReflect.Descriptor instead.
-func (*ExpireRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrByRequest.ProtoReflect.Descriptor instead.
+func (*IncrByRequest) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ExpireRequest) GetKey() string {
+func (x *IncrByRequest) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-func (x *ExpireRequest) GetTtl() *durationpb.Duration {
+func (x *IncrByRequest) GetIncrement() int64 {
 	if x != nil {
-		return x.Ttl
+		return x.Increment
 	}
-	return nil
+	return 0
 }
 
-// ExpireResponse represents the response from an Expire operation
-type ExpireResponse struct {
+// IncrByResponse represents the response from an IncrBy operation
+type IncrByResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ExpireResponse) Reset() {
-	*x = ExpireResponse{}
+func (x *IncrByResponse) Reset() {
+	*x = IncrByResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -488,13 +591,13 @@ func (x *ExpireResponse) Reset() {
 	}
 }
 
-func (x *ExpireResponse) String() string {
+func (x *IncrByResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpireResponse) ProtoMessage() {}
+func (*IncrByResponse) ProtoMessage() {}
 
-func (x *ExpireResponse) ProtoReflect() protoreflect.Message {
+func (x *IncrByResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -506,29 +609,29 @@ func (x *ExpireResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpireResponse.ProtoReflect.Descriptor instead.
-func (*ExpireResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrByResponse.ProtoReflect.Descriptor instead.
+func (*IncrByResponse) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ExpireResponse) GetSuccess() bool {
+func (x *IncrByResponse) GetValue() int64 {
 	if x != nil {
-		return x.Success
+		return x.Value
 	}
-	return false
+	return 0
 }
 
-// PingRequest represents the request for a Ping operation
-type PingRequest struct {
+// DecrRequest represents the request to decrement a key's value
+type DecrRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Optional message to echo back, alphanumeric and spaces only
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
+func (x *DecrRequest) Reset() {
+	*x = DecrRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -536,13 +639,13 @@ func (x *PingRequest) Reset() {
 	}
 }
 
-func (x *PingRequest) String() string {
+func (x *DecrRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*DecrRequest) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
+func (x *DecrRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,29 +657,29 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecrRequest.ProtoReflect.Descriptor instead.
+func (*DecrRequest) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PingRequest) GetMessage() string {
+func (x *DecrRequest) GetKey() string {
 	if x != nil {
-		return x.Message
+		return x.Key
 	}
 	return ""
 }
 
-// PingResponse represents the response from a Ping operation
-type PingResponse struct {
+// DecrResponse represents the response from a Decr operation
+type DecrResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "PONG" or the echoed message
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
+func (x *DecrResponse) Reset() {
+	*x = DecrResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -584,13 +687,13 @@ func (x *PingResponse) Reset() {
 	}
 }
 
-func (x *PingResponse) String() string {
+func (x *DecrResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*DecrResponse) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
+func (x *DecrResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -602,29 +705,30 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecrResponse.ProtoReflect.Descriptor instead.
+func (*DecrResponse) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *PingResponse) GetMessage() string {
+func (x *DecrResponse) GetValue() int64 {
 	if x != nil {
-		return x.Message
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-// BackupRequest represents the request to create a backup
-type BackupRequest struct {
+// DecrByRequest represents the request to decrement a key's value by an amount
+type DecrByRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"` // Name of the backup file to create
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Decrement int64  `protobuf:"varint,2,opt,name=decrement,proto3" json:"decrement,omitempty"`
 }
 
-func (x *BackupRequest) Reset() {
-	*x = BackupRequest{}
+func (x *DecrByRequest) Reset() {
+	*x = DecrByRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,13 +736,13 @@ func (x *BackupRequest) Reset() {
 	}
 }
 
-func (x *BackupRequest) String() string {
+func (x *DecrByRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackupRequest) ProtoMessage() {}
+func (*DecrByRequest) ProtoMessage() {}
 
-func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+func (x *DecrByRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -650,30 +754,36 @@ func (x *BackupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
-func (*BackupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecrByRequest.ProtoReflect.Descriptor instead.
+func (*DecrByRequest) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *BackupRequest) GetFilename() string {
+func (x *DecrByRequest) GetKey() string {
 	if x != nil {
-		return x.Filename
+		return x.Key
 	}
 	return ""
 }
 
-// BackupResponse represents the response from a Backup operation
-type BackupResponse struct {
+func (x *DecrByRequest) GetDecrement() int64 {
+	if x != nil {
+		return x.Decrement
+	}
+	return 0
+}
+
+// DecrByResponse represents the response from a DecrBy operation
+type DecrByResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message if backup failed
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *BackupResponse) Reset() {
-	*x = BackupResponse{}
+func (x *DecrByResponse) Reset() {
+	*x = DecrByResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,13 +791,13 @@ func (x *BackupResponse) Reset() {
 	}
 }
 
-func (x *BackupResponse) String() string {
+func (x *DecrByResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackupResponse) ProtoMessage() {}
+func (*DecrByResponse) ProtoMessage() {}
 
-func (x *BackupResponse) ProtoReflect() protoreflect.Message {
+func (x *DecrByResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,36 +809,30 @@ func (x *BackupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
-func (*BackupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecrByResponse.ProtoReflect.Descriptor instead.
+func (*DecrByResponse) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BackupResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *BackupResponse) GetErrorMessage() string {
+func (x *DecrByResponse) GetValue() int64 {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-// RestoreRequest represents the request to restore from a backup
-type RestoreRequest struct {
+// IncrByFloatRequest represents the request to increment a key's floating point value
+type IncrByFloatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"` // Name of the backup file to restore from
+	Key       string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Increment float64 `protobuf:"fixed64,2,opt,name=increment,proto3" json:"increment,omitempty"`
 }
 
-func (x *RestoreRequest) Reset() {
-	*x = RestoreRequest{}
+func (x *IncrByFloatRequest) Reset() {
+	*x = IncrByFloatRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -736,13 +840,13 @@ func (x *RestoreRequest) Reset() {
 	}
 }
 
-func (x *RestoreRequest) String() string {
+func (x *IncrByFloatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreRequest) ProtoMessage() {}
+func (*IncrByFloatRequest) ProtoMessage() {}
 
-func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+func (x *IncrByFloatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -754,30 +858,36 @@ func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
-func (*RestoreRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrByFloatRequest.ProtoReflect.Descriptor instead.
+func (*IncrByFloatRequest) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RestoreRequest) GetFilename() string {
+func (x *IncrByFloatRequest) GetKey() string {
 	if x != nil {
-		return x.Filename
+		return x.Key
 	}
 	return ""
 }
 
-// RestoreResponse represents the response from a Restore operation
-type RestoreResponse struct {
+func (x *IncrByFloatRequest) GetIncrement() float64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+// IncrByFloatResponse represents the response from an IncrByFloat operation
+type IncrByFloatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message if restore failed
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *RestoreResponse) Reset() {
-	*x = RestoreResponse{}
+func (x *IncrByFloatResponse) Reset() {
+	*x = IncrByFloatResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,13 +895,13 @@ func (x *RestoreResponse) Reset() {
 	}
 }
 
-func (x *RestoreResponse) String() string {
+func (x *IncrByFloatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreResponse) ProtoMessage() {}
+func (*IncrByFloatResponse) ProtoMessage() {}
 
-func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+func (x *IncrByFloatResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,37 +913,30 @@ func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
-func (*RestoreResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncrByFloatResponse.ProtoReflect.Descriptor instead.
+func (*IncrByFloatResponse) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RestoreResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *RestoreResponse) GetErrorMessage() string {
+func (x *IncrByFloatResponse) GetValue() float64 {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-// JoinRequest represents the request to join a new node to the cluster
-type JoinRequest struct {
+// ExpireRequest represents the request to set an expiration on a key
+type ExpireRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeId     string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	RemoteAddr string `protobuf:"bytes,2,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	Key string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *JoinRequest) Reset() {
-	*x = JoinRequest{}
+func (x *ExpireRequest) Reset() {
+	*x = ExpireRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -841,13 +944,13 @@ func (x *JoinRequest) Reset() {
 	}
 }
 
-func (x *JoinRequest) String() string {
+func (x *ExpireRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRequest) ProtoMessage() {}
+func (*ExpireRequest) ProtoMessage() {}
 
-func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+func (x *ExpireRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,37 +962,36 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
-func (*JoinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpireRequest.ProtoReflect.Descriptor instead.
+func (*ExpireRequest) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *JoinRequest) GetNodeId() string {
+func (x *ExpireRequest) GetKey() string {
 	if x != nil {
-		return x.NodeId
+		return x.Key
 	}
 	return ""
 }
 
-func (x *JoinRequest) GetRemoteAddr() string {
+func (x *ExpireRequest) GetTtl() *durationpb.Duration {
 	if x != nil {
-		return x.RemoteAddr
+		return x.Ttl
 	}
-	return ""
+	return nil
 }
 
-// JoinResponse represents the response from a Join operation
-type JoinResponse struct {
+// ExpireResponse represents the response from an Expire operation
+type ExpireResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message if join failed
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // False if the key does not exist
 }
 
-func (x *JoinResponse) Reset() {
-	*x = JoinResponse{}
+func (x *ExpireResponse) Reset() {
+	*x = ExpireResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cloud_v1_cloud_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -897,13 +999,13 @@ func (x *JoinResponse) Reset() {
 	}
 }
 
-func (x *JoinResponse) String() string {
+func (x *ExpireResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinResponse) ProtoMessage() {}
+func (*ExpireResponse) ProtoMessage() {}
 
-func (x *JoinResponse) ProtoReflect() protoreflect.Message {
+func (x *ExpireResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cloud_v1_cloud_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -915,231 +1017,17100 @@ func (x *JoinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
-func (*JoinResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExpireResponse.ProtoReflect.Descriptor instead.
+func (*ExpireResponse) Descriptor() ([]byte, []int) {
 	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *JoinResponse) GetSuccess() bool {
+func (x *ExpireResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *JoinResponse) GetErrorMessage() string {
+// ExpireAtRequest represents the request to expire a key at a Unix time in seconds
+type ExpireAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key             string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	UnixTimeSeconds int64  `protobuf:"varint,2,opt,name=unix_time_seconds,json=unixTimeSeconds,proto3" json:"unix_time_seconds,omitempty"`
+}
+
+func (x *ExpireAtRequest) Reset() {
+	*x = ExpireAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpireAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireAtRequest) ProtoMessage() {}
+
+func (x *ExpireAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireAtRequest.ProtoReflect.Descriptor instead.
+func (*ExpireAtRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExpireAtRequest) GetKey() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Key
 	}
 	return ""
 }
 
-var File_cloud_v1_cloud_proto protoreflect.FileDescriptor
+func (x *ExpireAtRequest) GetUnixTimeSeconds() int64 {
+	if x != nil {
+		return x.UnixTimeSeconds
+	}
+	return 0
+}
 
-var file_cloud_v1_cloud_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
-	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x0a, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xfa, 0x42, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02,
-	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
-	0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x7a, 0x06, 0x10, 0x01, 0x18,
-	0x80, 0x80, 0x20, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x30, 0x0a, 0x0b, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x6a,
-	0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2a, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01,
-	0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x7a, 0x04, 0x18, 0x80, 0x80,
-	0x20, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xfa, 0x42, 0x23, 0x92, 0x01, 0x20, 0x08, 0x01, 0x10, 0xe8,
-	0x07, 0x22, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6b, 0x65,
-	0x79, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
-	0x00, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x2b, 0x0a, 0x0b, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
-	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2d, 0x0a, 0x0c,
-	0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x05,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x78, 0x0a, 0x0d, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xfa, 0x42, 0x19, 0x72, 0x17,
-	0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
-	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x03,
-	0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01, 0x2a, 0x00,
-	0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x33, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x6a, 0x02, 0x08,
-	0x01, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x44, 0x0a, 0x0b, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xfa, 0x42, 0x18, 0x72,
-	0x16, 0x18, 0x80, 0x02, 0x32, 0x11, 0x5e, 0x5b, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x5c, 0x70, 0x7b,
-	0x4e, 0x7d, 0x5c, 0x73, 0x5d, 0x2a, 0x24, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x34, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x24, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72, 0x1a,
-	0x18, 0xff, 0x01, 0x32, 0x15, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
-	0x5f, 0x2d, 0x5d, 0x2b, 0x5c, 0x2e, 0x72, 0x64, 0x62, 0x24, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x2d, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80,
-	0x08, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x4d, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3b, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1f, 0xfa, 0x42, 0x1c, 0x72, 0x1a, 0x18, 0xff, 0x01, 0x32, 0x15, 0x5e,
-	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x5c, 0x2e,
-	0x72, 0x64, 0x62, 0x24, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5a,
-	0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x0d, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0b, 0x4a,
-	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xfa, 0x42, 0x18,
-	0x72, 0x16, 0x10, 0x01, 0x18, 0x40, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64,
-	0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xfa, 0x42, 0x1b, 0x72, 0x19, 0x10, 0x01, 0x18, 0xff,
-	0x01, 0x32, 0x12, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x2e, 0x3a,
-	0x5c, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x22, 0x57, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x0d, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x9b, 0x04, 0x0a, 0x0c, 0x52,
-	0x65, 0x64, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x03, 0x53,
-	0x65, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x34, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
-	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12, 0x14,
-	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x04, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x15, 0x2e,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
-	0x0a, 0x06, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x07, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
-	0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x80, 0x01, 0x0a, 0x0c, 0x63, 0x6f, 0x6d,
-	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x43, 0x6c, 0x6f, 0x75, 0x64,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x72, 0x65, 0x64, 0x69, 0x73, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43,
-	0x58, 0x58, 0xaa, 0x02, 0x08, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x08,
-	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x43, 0x6c, 0x6f, 0x75, 0x64,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x09, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+// ExpireAtResponse represents the response from an ExpireAt operation
+type ExpireAtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // False if the key does not exist
 }
 
-var (
-	file_cloud_v1_cloud_proto_rawDescOnce sync.Once
-	file_cloud_v1_cloud_proto_rawDescData = file_cloud_v1_cloud_proto_rawDesc
-)
+func (x *ExpireAtResponse) Reset() {
+	*x = ExpireAtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_cloud_v1_cloud_proto_rawDescGZIP() []byte {
-	file_cloud_v1_cloud_proto_rawDescOnce.Do(func() {
-		file_cloud_v1_cloud_proto_rawDescData = protoimpl.X.CompressGZIP(file_cloud_v1_cloud_proto_rawDescData)
-	})
-	return file_cloud_v1_cloud_proto_rawDescData
+func (x *ExpireAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_cloud_v1_cloud_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_cloud_v1_cloud_proto_goTypes = []any{
-	(*SetRequest)(nil),          // 0: cloud.v1.SetRequest
-	(*SetResponse)(nil),         // 1: cloud.v1.SetResponse
-	(*GetRequest)(nil),          // 2: cloud.v1.GetRequest
-	(*GetResponse)(nil),         // 3: cloud.v1.GetResponse
-	(*DelRequest)(nil),          // 4: cloud.v1.DelRequest
-	(*DelResponse)(nil),         // 5: cloud.v1.DelResponse
-	(*IncrRequest)(nil),         // 6: cloud.v1.IncrRequest
-	(*IncrResponse)(nil),        // 7: cloud.v1.IncrResponse
-	(*ExpireRequest)(nil),       // 8: cloud.v1.ExpireRequest
-	(*ExpireResponse)(nil),      // 9: cloud.v1.ExpireResponse
-	(*PingRequest)(nil),         // 10: cloud.v1.PingRequest
-	(*PingResponse)(nil),        // 11: cloud.v1.PingResponse
-	(*BackupRequest)(nil),       // 12: cloud.v1.BackupRequest
-	(*BackupResponse)(nil),      // 13: cloud.v1.BackupResponse
-	(*RestoreRequest)(nil),      // 14: cloud.v1.RestoreRequest
-	(*RestoreResponse)(nil),     // 15: cloud.v1.RestoreResponse
-	(*JoinRequest)(nil),         // 16: cloud.v1.JoinRequest
-	(*JoinResponse)(nil),        // 17: cloud.v1.JoinResponse
-	(*durationpb.Duration)(nil), // 18: google.protobuf.Duration
+func (*ExpireAtResponse) ProtoMessage() {}
+
+func (x *ExpireAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_cloud_v1_cloud_proto_depIdxs = []int32{
-	18, // 0: cloud.v1.ExpireRequest.ttl:type_name -> google.protobuf.Duration
-	0,  // 1: cloud.v1.RedisService.Set:input_type -> cloud.v1.SetRequest
-	2,  // 2: cloud.v1.RedisService.Get:input_type -> cloud.v1.GetRequest
-	4,  // 3: cloud.v1.RedisService.Del:input_type -> cloud.v1.DelRequest
-	6,  // 4: cloud.v1.RedisService.Incr:input_type -> cloud.v1.IncrRequest
-	8,  // 5: cloud.v1.RedisService.Expire:input_type -> cloud.v1.ExpireRequest
-	10, // 6: cloud.v1.RedisService.Ping:input_type -> cloud.v1.PingRequest
-	12, // 7: cloud.v1.RedisService.Backup:input_type -> cloud.v1.BackupRequest
-	14, // 8: cloud.v1.RedisService.Restore:input_type -> cloud.v1.RestoreRequest
-	16, // 9: cloud.v1.RedisService.Join:input_type -> cloud.v1.JoinRequest
-	1,  // 10: cloud.v1.RedisService.Set:output_type -> cloud.v1.SetResponse
-	3,  // 11: cloud.v1.RedisService.Get:output_type -> cloud.v1.GetResponse
-	5,  // 12: cloud.v1.RedisService.Del:output_type -> cloud.v1.DelResponse
-	7,  // 13: cloud.v1.RedisService.Incr:output_type -> cloud.v1.IncrResponse
-	9,  // 14: cloud.v1.RedisService.Expire:output_type -> cloud.v1.ExpireResponse
-	11, // 15: cloud.v1.RedisService.Ping:output_type -> cloud.v1.PingResponse
-	13, // 16: cloud.v1.RedisService.Backup:output_type -> cloud.v1.BackupResponse
-	15, // 17: cloud.v1.RedisService.Restore:output_type -> cloud.v1.RestoreResponse
-	17, // 18: cloud.v1.RedisService.Join:output_type -> cloud.v1.JoinResponse
-	10, // [10:19] is the sub-list for method output_type
-	1,  // [1:10] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+
+// Deprecated: Use ExpireAtResponse.ProtoReflect.Descriptor instead.
+func (*ExpireAtResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{19}
 }
 
-func init() { file_cloud_v1_cloud_proto_init() }
-func file_cloud_v1_cloud_proto_init() {
-	if File_cloud_v1_cloud_proto != nil {
-		return
+func (x *ExpireAtResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_cloud_v1_cloud_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*SetRequest); i {
+	return false
+}
+
+// PExpireAtRequest represents the request to expire a key at a Unix time in milliseconds
+type PExpireAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key                  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	UnixTimeMilliseconds int64  `protobuf:"varint,2,opt,name=unix_time_milliseconds,json=unixTimeMilliseconds,proto3" json:"unix_time_milliseconds,omitempty"`
+}
+
+func (x *PExpireAtRequest) Reset() {
+	*x = PExpireAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PExpireAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PExpireAtRequest) ProtoMessage() {}
+
+func (x *PExpireAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PExpireAtRequest.ProtoReflect.Descriptor instead.
+func (*PExpireAtRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PExpireAtRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PExpireAtRequest) GetUnixTimeMilliseconds() int64 {
+	if x != nil {
+		return x.UnixTimeMilliseconds
+	}
+	return 0
+}
+
+// PExpireAtResponse represents the response from a PExpireAt operation
+type PExpireAtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // False if the key does not exist
+}
+
+func (x *PExpireAtResponse) Reset() {
+	*x = PExpireAtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PExpireAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PExpireAtResponse) ProtoMessage() {}
+
+func (x *PExpireAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PExpireAtResponse.ProtoReflect.Descriptor instead.
+func (*PExpireAtResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PExpireAtResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// TtlRequest represents the request for the remaining time to live of a key
+type TtlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TtlRequest) Reset() {
+	*x = TtlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TtlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TtlRequest) ProtoMessage() {}
+
+func (x *TtlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TtlRequest.ProtoReflect.Descriptor instead.
+func (*TtlRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TtlRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// TtlResponse represents the response from a Ttl operation
+type TtlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ttl int64 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"` // Seconds left, -1 if the key has no timeout, -2 if it does not exist
+}
+
+func (x *TtlResponse) Reset() {
+	*x = TtlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TtlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TtlResponse) ProtoMessage() {}
+
+func (x *TtlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TtlResponse.ProtoReflect.Descriptor instead.
+func (*TtlResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TtlResponse) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+// PttlRequest represents the request for the remaining time to live of a key
+type PttlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *PttlRequest) Reset() {
+	*x = PttlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttlRequest) ProtoMessage() {}
+
+func (x *PttlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttlRequest.ProtoReflect.Descriptor instead.
+func (*PttlRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PttlRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// PttlResponse represents the response from a Pttl operation
+type PttlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ttl int64 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"` // Milliseconds left, -1 if the key has no timeout, -2 if it does not exist
+}
+
+func (x *PttlResponse) Reset() {
+	*x = PttlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PttlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PttlResponse) ProtoMessage() {}
+
+func (x *PttlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PttlResponse.ProtoReflect.Descriptor instead.
+func (*PttlResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PttlResponse) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+// PersistRequest represents the request to remove the timeout from a key
+type PersistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *PersistRequest) Reset() {
+	*x = PersistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistRequest) ProtoMessage() {}
+
+func (x *PersistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistRequest.ProtoReflect.Descriptor instead.
+func (*PersistRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PersistRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// PersistResponse represents the response from a Persist operation
+type PersistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // False if the key does not exist or had no timeout
+}
+
+func (x *PersistResponse) Reset() {
+	*x = PersistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistResponse) ProtoMessage() {}
+
+func (x *PersistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistResponse.ProtoReflect.Descriptor instead.
+func (*PersistResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PersistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// HSetRequest represents the request to set fields of a hash
+type HSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HSetRequest) Reset() {
+	*x = HSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HSetRequest) ProtoMessage() {}
+
+func (x *HSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HSetRequest.ProtoReflect.Descriptor instead.
+func (*HSetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HSetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HSetRequest) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// HSetResponse represents the response from an HSet operation
+type HSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddedCount int32 `protobuf:"varint,1,opt,name=added_count,json=addedCount,proto3" json:"added_count,omitempty"` // Number of fields that were added
+}
+
+func (x *HSetResponse) Reset() {
+	*x = HSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HSetResponse) ProtoMessage() {}
+
+func (x *HSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HSetResponse.ProtoReflect.Descriptor instead.
+func (*HSetResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HSetResponse) GetAddedCount() int32 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+// HGetRequest represents the request to retrieve the value of a hash field
+type HGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *HGetRequest) Reset() {
+	*x = HGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetRequest) ProtoMessage() {}
+
+func (x *HGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetRequest.ProtoReflect.Descriptor instead.
+func (*HGetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HGetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HGetRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+// HGetResponse represents the response from an HGet operation
+type HGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *HGetResponse) Reset() {
+	*x = HGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetResponse) ProtoMessage() {}
+
+func (x *HGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetResponse.ProtoReflect.Descriptor instead.
+func (*HGetResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HGetResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// HDelRequest represents the request to delete fields from a hash
+type HDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *HDelRequest) Reset() {
+	*x = HDelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HDelRequest) ProtoMessage() {}
+
+func (x *HDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HDelRequest.ProtoReflect.Descriptor instead.
+func (*HDelRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HDelRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HDelRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// HDelResponse represents the response from an HDel operation
+type HDelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedCount int32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+}
+
+func (x *HDelResponse) Reset() {
+	*x = HDelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HDelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HDelResponse) ProtoMessage() {}
+
+func (x *HDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HDelResponse.ProtoReflect.Descriptor instead.
+func (*HDelResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HDelResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+// HGetAllRequest represents the request to retrieve all fields of a hash
+type HGetAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *HGetAllRequest) Reset() {
+	*x = HGetAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetAllRequest) ProtoMessage() {}
+
+func (x *HGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetAllRequest.ProtoReflect.Descriptor instead.
+func (*HGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HGetAllRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// HGetAllResponse represents the response from an HGetAll operation
+type HGetAllResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields map[string]string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HGetAllResponse) Reset() {
+	*x = HGetAllResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HGetAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetAllResponse) ProtoMessage() {}
+
+func (x *HGetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetAllResponse.ProtoReflect.Descriptor instead.
+func (*HGetAllResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HGetAllResponse) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// HIncrByRequest represents the request to increment a hash field by an amount
+type HIncrByRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field     string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Increment int64  `protobuf:"varint,3,opt,name=increment,proto3" json:"increment,omitempty"`
+}
+
+func (x *HIncrByRequest) Reset() {
+	*x = HIncrByRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HIncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HIncrByRequest) ProtoMessage() {}
+
+func (x *HIncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HIncrByRequest.ProtoReflect.Descriptor instead.
+func (*HIncrByRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *HIncrByRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HIncrByRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HIncrByRequest) GetIncrement() int64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+// HIncrByResponse represents the response from an HIncrBy operation
+type HIncrByResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *HIncrByResponse) Reset() {
+	*x = HIncrByResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HIncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HIncrByResponse) ProtoMessage() {}
+
+func (x *HIncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HIncrByResponse.ProtoReflect.Descriptor instead.
+func (*HIncrByResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HIncrByResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// HScanRequest represents the request to iterate over the fields of a hash
+type HScanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // Empty to start a new iteration
+	Match  string `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`   // Optional glob-style pattern fields must match
+	Count  int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`  // Fields to examine, defaults to 10
+}
+
+func (x *HScanRequest) Reset() {
+	*x = HScanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HScanRequest) ProtoMessage() {}
+
+func (x *HScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HScanRequest.ProtoReflect.Descriptor instead.
+func (*HScanRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HScanRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HScanRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *HScanRequest) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *HScanRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// HScanResponse represents the response from an HScan operation
+type HScanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor string            `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // Cursor for the next call, empty when the iteration is complete
+	Fields map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HScanResponse) Reset() {
+	*x = HScanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HScanResponse) ProtoMessage() {}
+
+func (x *HScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HScanResponse.ProtoReflect.Descriptor instead.
+func (*HScanResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HScanResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *HScanResponse) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// LPushRequest represents the request to insert values at the head of a list
+type LPushRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *LPushRequest) Reset() {
+	*x = LPushRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPushRequest) ProtoMessage() {}
+
+func (x *LPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPushRequest.ProtoReflect.Descriptor instead.
+func (*LPushRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LPushRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LPushRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// LPushResponse represents the response from an LPush operation
+type LPushResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"` // Length of the list after the push
+}
+
+func (x *LPushResponse) Reset() {
+	*x = LPushResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LPushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPushResponse) ProtoMessage() {}
+
+func (x *LPushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPushResponse.ProtoReflect.Descriptor instead.
+func (*LPushResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LPushResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// RPushRequest represents the request to insert values at the tail of a list
+type RPushRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *RPushRequest) Reset() {
+	*x = RPushRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPushRequest) ProtoMessage() {}
+
+func (x *RPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPushRequest.ProtoReflect.Descriptor instead.
+func (*RPushRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RPushRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RPushRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// RPushResponse represents the response from an RPush operation
+type RPushResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"` // Length of the list after the push
+}
+
+func (x *RPushResponse) Reset() {
+	*x = RPushResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RPushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPushResponse) ProtoMessage() {}
+
+func (x *RPushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPushResponse.ProtoReflect.Descriptor instead.
+func (*RPushResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RPushResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// LPopRequest represents the request to pop elements from the head of a list
+type LPopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Elements to pop, defaults to 1
+}
+
+func (x *LPopRequest) Reset() {
+	*x = LPopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LPopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPopRequest) ProtoMessage() {}
+
+func (x *LPopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPopRequest.ProtoReflect.Descriptor instead.
+func (*LPopRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LPopRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LPopRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// LPopResponse represents the response from an LPop operation
+type LPopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"` // Empty if the list does not exist
+}
+
+func (x *LPopResponse) Reset() {
+	*x = LPopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LPopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPopResponse) ProtoMessage() {}
+
+func (x *LPopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPopResponse.ProtoReflect.Descriptor instead.
+func (*LPopResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LPopResponse) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// RPopRequest represents the request to pop elements from the tail of a list
+type RPopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Elements to pop, defaults to 1
+}
+
+func (x *RPopRequest) Reset() {
+	*x = RPopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RPopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPopRequest) ProtoMessage() {}
+
+func (x *RPopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPopRequest.ProtoReflect.Descriptor instead.
+func (*RPopRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RPopRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RPopRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// RPopResponse represents the response from an RPop operation
+type RPopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"` // Empty if the list does not exist
+}
+
+func (x *RPopResponse) Reset() {
+	*x = RPopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RPopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPopResponse) ProtoMessage() {}
+
+func (x *RPopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPopResponse.ProtoReflect.Descriptor instead.
+func (*RPopResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RPopResponse) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// LRangeRequest represents the request to retrieve a range of elements from a list
+type LRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // Negative indexes count from the end of the list
+	Stop  int64  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`   // Inclusive
+}
+
+func (x *LRangeRequest) Reset() {
+	*x = LRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LRangeRequest) ProtoMessage() {}
+
+func (x *LRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LRangeRequest.ProtoReflect.Descriptor instead.
+func (*LRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LRangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LRangeRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LRangeRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+// LRangeResponse represents the response from an LRange operation
+type LRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *LRangeResponse) Reset() {
+	*x = LRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LRangeResponse) ProtoMessage() {}
+
+func (x *LRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LRangeResponse.ProtoReflect.Descriptor instead.
+func (*LRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LRangeResponse) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// LLenRequest represents the request for the length of a list
+type LLenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *LLenRequest) Reset() {
+	*x = LLenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLenRequest) ProtoMessage() {}
+
+func (x *LLenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLenRequest.ProtoReflect.Descriptor instead.
+func (*LLenRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LLenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// LLenResponse represents the response from an LLen operation
+type LLenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *LLenResponse) Reset() {
+	*x = LLenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLenResponse) ProtoMessage() {}
+
+func (x *LLenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLenResponse.ProtoReflect.Descriptor instead.
+func (*LLenResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LLenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// LTrimRequest represents the request to trim a list to a range
+type LTrimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // Negative indexes count from the end of the list
+	Stop  int64  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`   // Inclusive
+}
+
+func (x *LTrimRequest) Reset() {
+	*x = LTrimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LTrimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LTrimRequest) ProtoMessage() {}
+
+func (x *LTrimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LTrimRequest.ProtoReflect.Descriptor instead.
+func (*LTrimRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LTrimRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LTrimRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LTrimRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+// LTrimResponse represents the response from an LTrim operation
+type LTrimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *LTrimResponse) Reset() {
+	*x = LTrimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LTrimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LTrimResponse) ProtoMessage() {}
+
+func (x *LTrimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LTrimResponse.ProtoReflect.Descriptor instead.
+func (*LTrimResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LTrimResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// LIndexRequest represents the request to retrieve a list element by index
+type LIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Index int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"` // Negative indexes count from the end of the list
+}
+
+func (x *LIndexRequest) Reset() {
+	*x = LIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LIndexRequest) ProtoMessage() {}
+
+func (x *LIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LIndexRequest.ProtoReflect.Descriptor instead.
+func (*LIndexRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LIndexRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LIndexRequest) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// LIndexResponse represents the response from an LIndex operation
+type LIndexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LIndexResponse) Reset() {
+	*x = LIndexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LIndexResponse) ProtoMessage() {}
+
+func (x *LIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LIndexResponse.ProtoReflect.Descriptor instead.
+func (*LIndexResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LIndexResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// BLPopRequest represents the request to pop from the head of the first non-empty list
+type BLPopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys    []string             `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"` // Zero waits until the request is cancelled
+}
+
+func (x *BLPopRequest) Reset() {
+	*x = BLPopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BLPopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BLPopRequest) ProtoMessage() {}
+
+func (x *BLPopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BLPopRequest.ProtoReflect.Descriptor instead.
+func (*BLPopRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BLPopRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *BLPopRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// BLPopResponse represents the response from a BLPop operation
+type BLPopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Key the element was popped from, empty if the timeout elapsed
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BLPopResponse) Reset() {
+	*x = BLPopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BLPopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BLPopResponse) ProtoMessage() {}
+
+func (x *BLPopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BLPopResponse.ProtoReflect.Descriptor instead.
+func (*BLPopResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BLPopResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BLPopResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// BRPopRequest represents the request to pop from the tail of the first non-empty list
+type BRPopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys    []string             `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"` // Zero waits until the request is cancelled
+}
+
+func (x *BRPopRequest) Reset() {
+	*x = BRPopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BRPopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BRPopRequest) ProtoMessage() {}
+
+func (x *BRPopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BRPopRequest.ProtoReflect.Descriptor instead.
+func (*BRPopRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BRPopRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *BRPopRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// BRPopResponse represents the response from a BRPop operation
+type BRPopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Key the element was popped from, empty if the timeout elapsed
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *BRPopResponse) Reset() {
+	*x = BRPopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BRPopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BRPopResponse) ProtoMessage() {}
+
+func (x *BRPopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BRPopResponse.ProtoReflect.Descriptor instead.
+func (*BRPopResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BRPopResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BRPopResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// SAddRequest represents the request to add members to a set
+type SAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *SAddRequest) Reset() {
+	*x = SAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAddRequest) ProtoMessage() {}
+
+func (x *SAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAddRequest.ProtoReflect.Descriptor instead.
+func (*SAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SAddRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SAddResponse represents the response from an SAdd operation
+type SAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddedCount int64 `protobuf:"varint,1,opt,name=added_count,json=addedCount,proto3" json:"added_count,omitempty"` // Members that were not already in the set
+}
+
+func (x *SAddResponse) Reset() {
+	*x = SAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAddResponse) ProtoMessage() {}
+
+func (x *SAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAddResponse.ProtoReflect.Descriptor instead.
+func (*SAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SAddResponse) GetAddedCount() int64 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+// SRemRequest represents the request to remove members from a set
+type SRemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *SRemRequest) Reset() {
+	*x = SRemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRemRequest) ProtoMessage() {}
+
+func (x *SRemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRemRequest.ProtoReflect.Descriptor instead.
+func (*SRemRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SRemRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SRemRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SRemResponse represents the response from an SRem operation
+type SRemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemovedCount int64 `protobuf:"varint,1,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
+}
+
+func (x *SRemResponse) Reset() {
+	*x = SRemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRemResponse) ProtoMessage() {}
+
+func (x *SRemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRemResponse.ProtoReflect.Descriptor instead.
+func (*SRemResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SRemResponse) GetRemovedCount() int64 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+// SIsMemberRequest represents the request to check set membership
+type SIsMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *SIsMemberRequest) Reset() {
+	*x = SIsMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SIsMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIsMemberRequest) ProtoMessage() {}
+
+func (x *SIsMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIsMemberRequest.ProtoReflect.Descriptor instead.
+func (*SIsMemberRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SIsMemberRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SIsMemberRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+// SIsMemberResponse represents the response from an SIsMember operation
+type SIsMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsMember bool `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+}
+
+func (x *SIsMemberResponse) Reset() {
+	*x = SIsMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SIsMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIsMemberResponse) ProtoMessage() {}
+
+func (x *SIsMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIsMemberResponse.ProtoReflect.Descriptor instead.
+func (*SIsMemberResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SIsMemberResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+// SMembersRequest represents the request to retrieve all members of a set
+type SMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SMembersRequest) Reset() {
+	*x = SMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMembersRequest) ProtoMessage() {}
+
+func (x *SMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMembersRequest.ProtoReflect.Descriptor instead.
+func (*SMembersRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SMembersRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// SMembersResponse represents the response from an SMembers operation
+type SMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // Sorted, empty if the set does not exist
+}
+
+func (x *SMembersResponse) Reset() {
+	*x = SMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMembersResponse) ProtoMessage() {}
+
+func (x *SMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMembersResponse.ProtoReflect.Descriptor instead.
+func (*SMembersResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SMembersResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SCardRequest represents the request for the number of members of a set
+type SCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *SCardRequest) Reset() {
+	*x = SCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCardRequest) ProtoMessage() {}
+
+func (x *SCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCardRequest.ProtoReflect.Descriptor instead.
+func (*SCardRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SCardRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// SCardResponse represents the response from an SCard operation
+type SCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cardinality int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+}
+
+func (x *SCardResponse) Reset() {
+	*x = SCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SCardResponse) ProtoMessage() {}
+
+func (x *SCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SCardResponse.ProtoReflect.Descriptor instead.
+func (*SCardResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SCardResponse) GetCardinality() int64 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+// SPopRequest represents the request to remove random members from a set
+type SPopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Members to pop, defaults to 1
+}
+
+func (x *SPopRequest) Reset() {
+	*x = SPopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SPopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPopRequest) ProtoMessage() {}
+
+func (x *SPopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPopRequest.ProtoReflect.Descriptor instead.
+func (*SPopRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SPopRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SPopRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SPopResponse represents the response from an SPop operation
+type SPopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // Empty if the set does not exist
+}
+
+func (x *SPopResponse) Reset() {
+	*x = SPopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SPopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPopResponse) ProtoMessage() {}
+
+func (x *SPopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPopResponse.ProtoReflect.Descriptor instead.
+func (*SPopResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SPopResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SRandMemberRequest represents the request for random members of a set
+type SRandMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Distinct members if positive, may repeat if negative, defaults to 1
+}
+
+func (x *SRandMemberRequest) Reset() {
+	*x = SRandMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRandMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRandMemberRequest) ProtoMessage() {}
+
+func (x *SRandMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRandMemberRequest.ProtoReflect.Descriptor instead.
+func (*SRandMemberRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SRandMemberRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SRandMemberRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SRandMemberResponse represents the response from an SRandMember operation
+type SRandMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *SRandMemberResponse) Reset() {
+	*x = SRandMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRandMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRandMemberResponse) ProtoMessage() {}
+
+func (x *SRandMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRandMemberResponse.ProtoReflect.Descriptor instead.
+func (*SRandMemberResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SRandMemberResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SInterRequest represents the request for the intersection of sets
+type SInterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SInterRequest) Reset() {
+	*x = SInterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SInterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SInterRequest) ProtoMessage() {}
+
+func (x *SInterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SInterRequest.ProtoReflect.Descriptor instead.
+func (*SInterRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SInterRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SInterResponse represents the response from an SInter operation
+type SInterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // Sorted
+}
+
+func (x *SInterResponse) Reset() {
+	*x = SInterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SInterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SInterResponse) ProtoMessage() {}
+
+func (x *SInterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SInterResponse.ProtoReflect.Descriptor instead.
+func (*SInterResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SInterResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SUnionRequest represents the request for the union of sets
+type SUnionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SUnionRequest) Reset() {
+	*x = SUnionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SUnionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SUnionRequest) ProtoMessage() {}
+
+func (x *SUnionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SUnionRequest.ProtoReflect.Descriptor instead.
+func (*SUnionRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SUnionRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SUnionResponse represents the response from an SUnion operation
+type SUnionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // Sorted
+}
+
+func (x *SUnionResponse) Reset() {
+	*x = SUnionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SUnionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SUnionResponse) ProtoMessage() {}
+
+func (x *SUnionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SUnionResponse.ProtoReflect.Descriptor instead.
+func (*SUnionResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SUnionResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SDiffRequest represents the request for the difference of sets
+type SDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SDiffRequest) Reset() {
+	*x = SDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SDiffRequest) ProtoMessage() {}
+
+func (x *SDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SDiffRequest.ProtoReflect.Descriptor instead.
+func (*SDiffRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SDiffRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SDiffResponse represents the response from an SDiff operation
+type SDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // Sorted
+}
+
+func (x *SDiffResponse) Reset() {
+	*x = SDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SDiffResponse) ProtoMessage() {}
+
+func (x *SDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SDiffResponse.ProtoReflect.Descriptor instead.
+func (*SDiffResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SDiffResponse) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// SInterStoreRequest represents the request to store the intersection of sets
+type SInterStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string   `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys        []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SInterStoreRequest) Reset() {
+	*x = SInterStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SInterStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SInterStoreRequest) ProtoMessage() {}
+
+func (x *SInterStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SInterStoreRequest.ProtoReflect.Descriptor instead.
+func (*SInterStoreRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SInterStoreRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SInterStoreRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SInterStoreResponse represents the response from an SInterStore operation
+type SInterStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cardinality int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"` // Size of the stored set
+}
+
+func (x *SInterStoreResponse) Reset() {
+	*x = SInterStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SInterStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SInterStoreResponse) ProtoMessage() {}
+
+func (x *SInterStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SInterStoreResponse.ProtoReflect.Descriptor instead.
+func (*SInterStoreResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SInterStoreResponse) GetCardinality() int64 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+// SUnionStoreRequest represents the request to store the union of sets
+type SUnionStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string   `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys        []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SUnionStoreRequest) Reset() {
+	*x = SUnionStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SUnionStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SUnionStoreRequest) ProtoMessage() {}
+
+func (x *SUnionStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SUnionStoreRequest.ProtoReflect.Descriptor instead.
+func (*SUnionStoreRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SUnionStoreRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SUnionStoreRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SUnionStoreResponse represents the response from an SUnionStore operation
+type SUnionStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cardinality int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"` // Size of the stored set
+}
+
+func (x *SUnionStoreResponse) Reset() {
+	*x = SUnionStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SUnionStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SUnionStoreResponse) ProtoMessage() {}
+
+func (x *SUnionStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SUnionStoreResponse.ProtoReflect.Descriptor instead.
+func (*SUnionStoreResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SUnionStoreResponse) GetCardinality() int64 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+// SDiffStoreRequest represents the request to store the difference of sets
+type SDiffStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string   `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys        []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SDiffStoreRequest) Reset() {
+	*x = SDiffStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SDiffStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SDiffStoreRequest) ProtoMessage() {}
+
+func (x *SDiffStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SDiffStoreRequest.ProtoReflect.Descriptor instead.
+func (*SDiffStoreRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SDiffStoreRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SDiffStoreRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// SDiffStoreResponse represents the response from an SDiffStore operation
+type SDiffStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cardinality int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"` // Size of the stored set
+}
+
+func (x *SDiffStoreResponse) Reset() {
+	*x = SDiffStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SDiffStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SDiffStoreResponse) ProtoMessage() {}
+
+func (x *SDiffStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SDiffStoreResponse.ProtoReflect.Descriptor instead.
+func (*SDiffStoreResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SDiffStoreResponse) GetCardinality() int64 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+// ZMember is a member of a sorted set together with its score
+type ZMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member string  `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Score  float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ZMember) Reset() {
+	*x = ZMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZMember) ProtoMessage() {}
+
+func (x *ZMember) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZMember.ProtoReflect.Descriptor instead.
+func (*ZMember) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ZMember) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *ZMember) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// ZAddRequest represents the request to add members to a sorted set
+type ZAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string     `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members []*ZMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Nx      bool       `protobuf:"varint,3,opt,name=nx,proto3" json:"nx,omitempty"`     // Only add new members
+	Xx      bool       `protobuf:"varint,4,opt,name=xx,proto3" json:"xx,omitempty"`     // Only update existing members
+	Gt      bool       `protobuf:"varint,5,opt,name=gt,proto3" json:"gt,omitempty"`     // Only update scores that increase
+	Lt      bool       `protobuf:"varint,6,opt,name=lt,proto3" json:"lt,omitempty"`     // Only update scores that decrease
+	Ch      bool       `protobuf:"varint,7,opt,name=ch,proto3" json:"ch,omitempty"`     // Count changed members as well as added ones
+	Incr    bool       `protobuf:"varint,8,opt,name=incr,proto3" json:"incr,omitempty"` // Increment the score of a single member instead of setting it
+}
+
+func (x *ZAddRequest) Reset() {
+	*x = ZAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZAddRequest) ProtoMessage() {}
+
+func (x *ZAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZAddRequest.ProtoReflect.Descriptor instead.
+func (*ZAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ZAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZAddRequest) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ZAddRequest) GetNx() bool {
+	if x != nil {
+		return x.Nx
+	}
+	return false
+}
+
+func (x *ZAddRequest) GetXx() bool {
+	if x != nil {
+		return x.Xx
+	}
+	return false
+}
+
+func (x *ZAddRequest) GetGt() bool {
+	if x != nil {
+		return x.Gt
+	}
+	return false
+}
+
+func (x *ZAddRequest) GetLt() bool {
+	if x != nil {
+		return x.Lt
+	}
+	return false
+}
+
+func (x *ZAddRequest) GetCh() bool {
+	if x != nil {
+		return x.Ch
+	}
+	return false
+}
+
+func (x *ZAddRequest) GetIncr() bool {
+	if x != nil {
+		return x.Incr
+	}
+	return false
+}
+
+// ZAddResponse represents the response from a ZAdd operation
+type ZAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`        // Members added, or added and changed with ch; zero with incr
+	Score *float64 `protobuf:"fixed64,2,opt,name=score,proto3,oneof" json:"score,omitempty"` // New score with incr, unset if the update was skipped
+}
+
+func (x *ZAddResponse) Reset() {
+	*x = ZAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZAddResponse) ProtoMessage() {}
+
+func (x *ZAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZAddResponse.ProtoReflect.Descriptor instead.
+func (*ZAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ZAddResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ZAddResponse) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+// ZRemRequest represents the request to remove members from a sorted set
+type ZRemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *ZRemRequest) Reset() {
+	*x = ZRemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZRemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRemRequest) ProtoMessage() {}
+
+func (x *ZRemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRemRequest.ProtoReflect.Descriptor instead.
+func (*ZRemRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ZRemRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZRemRequest) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// ZRemResponse represents the response from a ZRem operation
+type ZRemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemovedCount int64 `protobuf:"varint,1,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
+}
+
+func (x *ZRemResponse) Reset() {
+	*x = ZRemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZRemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRemResponse) ProtoMessage() {}
+
+func (x *ZRemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRemResponse.ProtoReflect.Descriptor instead.
+func (*ZRemResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ZRemResponse) GetRemovedCount() int64 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+// ZScoreRequest represents the request for the score of a sorted set member
+type ZScoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *ZScoreRequest) Reset() {
+	*x = ZScoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZScoreRequest) ProtoMessage() {}
+
+func (x *ZScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZScoreRequest.ProtoReflect.Descriptor instead.
+func (*ZScoreRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ZScoreRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZScoreRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+// ZScoreResponse represents the response from a ZScore operation
+type ZScoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score float64 `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *ZScoreResponse) Reset() {
+	*x = ZScoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZScoreResponse) ProtoMessage() {}
+
+func (x *ZScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZScoreResponse.ProtoReflect.Descriptor instead.
+func (*ZScoreResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ZScoreResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// ZRankRequest represents the request for the rank of a sorted set member
+type ZRankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	Rev    bool   `protobuf:"varint,3,opt,name=rev,proto3" json:"rev,omitempty"` // Rank from the highest score
+}
+
+func (x *ZRankRequest) Reset() {
+	*x = ZRankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZRankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRankRequest) ProtoMessage() {}
+
+func (x *ZRankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRankRequest.ProtoReflect.Descriptor instead.
+func (*ZRankRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ZRankRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZRankRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *ZRankRequest) GetRev() bool {
+	if x != nil {
+		return x.Rev
+	}
+	return false
+}
+
+// ZRankResponse represents the response from a ZRank operation
+type ZRankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank int64 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"` // 0-based
+}
+
+func (x *ZRankResponse) Reset() {
+	*x = ZRankResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZRankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRankResponse) ProtoMessage() {}
+
+func (x *ZRankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRankResponse.ProtoReflect.Descriptor instead.
+func (*ZRankResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ZRankResponse) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// ZRangeLimit limits a score or lex range query
+type ZRangeLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count  int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Negative returns every remaining member
+}
+
+func (x *ZRangeLimit) Reset() {
+	*x = ZRangeLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZRangeLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRangeLimit) ProtoMessage() {}
+
+func (x *ZRangeLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRangeLimit.ProtoReflect.Descriptor instead.
+func (*ZRangeLimit) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ZRangeLimit) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ZRangeLimit) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ZRangeRequest represents the request to retrieve a range of sorted set members
+type ZRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start   string       `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`                     // Rank, score bound such as "(1.5" or "-inf", or lex bound such as "[a" or "-"
+	Stop    string       `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`                       // Inclusive unless marked exclusive
+	ByScore bool         `protobuf:"varint,4,opt,name=by_score,json=byScore,proto3" json:"by_score,omitempty"` // Treat start and stop as score bounds
+	ByLex   bool         `protobuf:"varint,5,opt,name=by_lex,json=byLex,proto3" json:"by_lex,omitempty"`       // Treat start and stop as lex bounds
+	Rev     bool         `protobuf:"varint,6,opt,name=rev,proto3" json:"rev,omitempty"`                        // Order from the highest score; start is then the upper bound
+	Limit   *ZRangeLimit `protobuf:"bytes,7,opt,name=limit,proto3" json:"limit,omitempty"`                     // Only valid with by_score or by_lex
+}
+
+func (x *ZRangeRequest) Reset() {
+	*x = ZRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRangeRequest) ProtoMessage() {}
+
+func (x *ZRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRangeRequest.ProtoReflect.Descriptor instead.
+func (*ZRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ZRangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZRangeRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ZRangeRequest) GetStop() string {
+	if x != nil {
+		return x.Stop
+	}
+	return ""
+}
+
+func (x *ZRangeRequest) GetByScore() bool {
+	if x != nil {
+		return x.ByScore
+	}
+	return false
+}
+
+func (x *ZRangeRequest) GetByLex() bool {
+	if x != nil {
+		return x.ByLex
+	}
+	return false
+}
+
+func (x *ZRangeRequest) GetRev() bool {
+	if x != nil {
+		return x.Rev
+	}
+	return false
+}
+
+func (x *ZRangeRequest) GetLimit() *ZRangeLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+// ZRangeResponse represents the response from a ZRange operation
+type ZRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ZMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *ZRangeResponse) Reset() {
+	*x = ZRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZRangeResponse) ProtoMessage() {}
+
+func (x *ZRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZRangeResponse.ProtoReflect.Descriptor instead.
+func (*ZRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ZRangeResponse) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// ZCountRequest represents the request to count sorted set members within a score range
+type ZCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Min string `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max string `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *ZCountRequest) Reset() {
+	*x = ZCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZCountRequest) ProtoMessage() {}
+
+func (x *ZCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZCountRequest.ProtoReflect.Descriptor instead.
+func (*ZCountRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ZCountRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZCountRequest) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *ZCountRequest) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+// ZCountResponse represents the response from a ZCount operation
+type ZCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ZCountResponse) Reset() {
+	*x = ZCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZCountResponse) ProtoMessage() {}
+
+func (x *ZCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZCountResponse.ProtoReflect.Descriptor instead.
+func (*ZCountResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ZCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ZPopMinRequest represents the request to pop the lowest scored members of a sorted set
+type ZPopMinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Members to pop, defaults to 1
+}
+
+func (x *ZPopMinRequest) Reset() {
+	*x = ZPopMinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZPopMinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZPopMinRequest) ProtoMessage() {}
+
+func (x *ZPopMinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZPopMinRequest.ProtoReflect.Descriptor instead.
+func (*ZPopMinRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ZPopMinRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZPopMinRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ZPopMinResponse represents the response from a ZPopMin operation
+type ZPopMinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ZMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // Empty if the sorted set does not exist
+}
+
+func (x *ZPopMinResponse) Reset() {
+	*x = ZPopMinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZPopMinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZPopMinResponse) ProtoMessage() {}
+
+func (x *ZPopMinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZPopMinResponse.ProtoReflect.Descriptor instead.
+func (*ZPopMinResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ZPopMinResponse) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// ZPopMaxRequest represents the request to pop the highest scored members of a sorted set
+type ZPopMaxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Members to pop, defaults to 1
+}
+
+func (x *ZPopMaxRequest) Reset() {
+	*x = ZPopMaxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZPopMaxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZPopMaxRequest) ProtoMessage() {}
+
+func (x *ZPopMaxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZPopMaxRequest.ProtoReflect.Descriptor instead.
+func (*ZPopMaxRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ZPopMaxRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ZPopMaxRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ZPopMaxResponse represents the response from a ZPopMax operation
+type ZPopMaxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ZMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // Empty if the sorted set does not exist
+}
+
+func (x *ZPopMaxResponse) Reset() {
+	*x = ZPopMaxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZPopMaxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZPopMaxResponse) ProtoMessage() {}
+
+func (x *ZPopMaxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZPopMaxResponse.ProtoReflect.Descriptor instead.
+func (*ZPopMaxResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ZPopMaxResponse) GetMembers() []*ZMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// StreamEntry is an entry of a stream
+type StreamEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                 // In the "ms-seq" form
+	Fields map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Empty for pending entries that were trimmed from the stream
+}
+
+func (x *StreamEntry) Reset() {
+	*x = StreamEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEntry) ProtoMessage() {}
+
+func (x *StreamEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEntry.ProtoReflect.Descriptor instead.
+func (*StreamEntry) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *StreamEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamEntry) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// XAddRequest represents the request to append an entry to a stream
+type XAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id     string            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"` // "*" or empty to generate, "ms-*" to generate the sequence, or an explicit "ms-seq"
+	Fields map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaxLen *int64            `protobuf:"varint,4,opt,name=max_len,json=maxLen,proto3,oneof" json:"max_len,omitempty"` // Trim the stream to this many entries
+}
+
+func (x *XAddRequest) Reset() {
+	*x = XAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAddRequest) ProtoMessage() {}
+
+func (x *XAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAddRequest.ProtoReflect.Descriptor instead.
+func (*XAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *XAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XAddRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *XAddRequest) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *XAddRequest) GetMaxLen() int64 {
+	if x != nil && x.MaxLen != nil {
+		return *x.MaxLen
+	}
+	return 0
+}
+
+// XAddResponse represents the response from an XAdd operation
+type XAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *XAddResponse) Reset() {
+	*x = XAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAddResponse) ProtoMessage() {}
+
+func (x *XAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAddResponse.ProtoReflect.Descriptor instead.
+func (*XAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *XAddResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// XRangeRequest represents the request to retrieve stream entries within an ID range
+type XRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`  // "-" for the first entry, "(" prefix for an exclusive bound
+	End   string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`      // "+" for the last entry, "(" prefix for an exclusive bound
+	Count int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"` // Zero returns every entry in the range
+}
+
+func (x *XRangeRequest) Reset() {
+	*x = XRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XRangeRequest) ProtoMessage() {}
+
+func (x *XRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XRangeRequest.ProtoReflect.Descriptor instead.
+func (*XRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *XRangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XRangeRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *XRangeRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *XRangeRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// XRangeResponse represents the response from an XRange operation
+type XRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*StreamEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *XRangeResponse) Reset() {
+	*x = XRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XRangeResponse) ProtoMessage() {}
+
+func (x *XRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XRangeResponse.ProtoReflect.Descriptor instead.
+func (*XRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *XRangeResponse) GetEntries() []*StreamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// XRevRangeRequest represents the request to retrieve stream entries within an ID range, newest first
+type XRevRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`      // "+" for the last entry, "(" prefix for an exclusive bound
+	Start string `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`  // "-" for the first entry, "(" prefix for an exclusive bound
+	Count int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"` // Zero returns every entry in the range
+}
+
+func (x *XRevRangeRequest) Reset() {
+	*x = XRevRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XRevRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XRevRangeRequest) ProtoMessage() {}
+
+func (x *XRevRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XRevRangeRequest.ProtoReflect.Descriptor instead.
+func (*XRevRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *XRevRangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XRevRangeRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *XRevRangeRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *XRevRangeRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// XRevRangeResponse represents the response from an XRevRange operation
+type XRevRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*StreamEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *XRevRangeResponse) Reset() {
+	*x = XRevRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XRevRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XRevRangeResponse) ProtoMessage() {}
+
+func (x *XRevRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XRevRangeResponse.ProtoReflect.Descriptor instead.
+func (*XRevRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *XRevRangeResponse) GetEntries() []*StreamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// XLenRequest represents the request for the number of entries in a stream
+type XLenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *XLenRequest) Reset() {
+	*x = XLenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XLenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XLenRequest) ProtoMessage() {}
+
+func (x *XLenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XLenRequest.ProtoReflect.Descriptor instead.
+func (*XLenRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *XLenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// XLenResponse represents the response from an XLen operation
+type XLenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *XLenResponse) Reset() {
+	*x = XLenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XLenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XLenResponse) ProtoMessage() {}
+
+func (x *XLenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XLenResponse.ProtoReflect.Descriptor instead.
+func (*XLenResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *XLenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// XTrimRequest represents the request to remove the oldest entries of a stream
+type XTrimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are assignable to Strategy:
+	//	*XTrimRequest_MaxLen
+	//	*XTrimRequest_MinId
+	Strategy isXTrimRequest_Strategy `protobuf_oneof:"strategy"`
+}
+
+func (x *XTrimRequest) Reset() {
+	*x = XTrimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XTrimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XTrimRequest) ProtoMessage() {}
+
+func (x *XTrimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XTrimRequest.ProtoReflect.Descriptor instead.
+func (*XTrimRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *XTrimRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (m *XTrimRequest) GetStrategy() isXTrimRequest_Strategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return nil
+}
+
+func (x *XTrimRequest) GetMaxLen() int64 {
+	if x, ok := x.GetStrategy().(*XTrimRequest_MaxLen); ok {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *XTrimRequest) GetMinId() string {
+	if x, ok := x.GetStrategy().(*XTrimRequest_MinId); ok {
+		return x.MinId
+	}
+	return ""
+}
+
+type isXTrimRequest_Strategy interface {
+	isXTrimRequest_Strategy()
+}
+
+type XTrimRequest_MaxLen struct {
+	MaxLen int64 `protobuf:"varint,2,opt,name=max_len,json=maxLen,proto3,oneof"` // Keep at most this many entries
+}
+
+type XTrimRequest_MinId struct {
+	MinId string `protobuf:"bytes,3,opt,name=min_id,json=minId,proto3,oneof"` // Remove entries with smaller IDs
+}
+
+func (*XTrimRequest_MaxLen) isXTrimRequest_Strategy() {}
+
+func (*XTrimRequest_MinId) isXTrimRequest_Strategy() {}
+
+// XTrimResponse represents the response from an XTrim operation
+type XTrimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemovedCount int64 `protobuf:"varint,1,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
+}
+
+func (x *XTrimResponse) Reset() {
+	*x = XTrimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XTrimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XTrimResponse) ProtoMessage() {}
+
+func (x *XTrimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XTrimResponse.ProtoReflect.Descriptor instead.
+func (*XTrimResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *XTrimResponse) GetRemovedCount() int64 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+// XGroupCreateRequest represents the request to create a consumer group on a stream
+type XGroupCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group    string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Id       string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`              // Deliver entries after this ID, "$" or empty for new entries only
+	Mkstream bool   `protobuf:"varint,4,opt,name=mkstream,proto3" json:"mkstream,omitempty"` // Create an empty stream if the key does not exist
+}
+
+func (x *XGroupCreateRequest) Reset() {
+	*x = XGroupCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XGroupCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XGroupCreateRequest) ProtoMessage() {}
+
+func (x *XGroupCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XGroupCreateRequest.ProtoReflect.Descriptor instead.
+func (*XGroupCreateRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *XGroupCreateRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XGroupCreateRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XGroupCreateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *XGroupCreateRequest) GetMkstream() bool {
+	if x != nil {
+		return x.Mkstream
+	}
+	return false
+}
+
+// XGroupCreateResponse represents the response from an XGroupCreate operation
+type XGroupCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *XGroupCreateResponse) Reset() {
+	*x = XGroupCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XGroupCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XGroupCreateResponse) ProtoMessage() {}
+
+func (x *XGroupCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XGroupCreateResponse.ProtoReflect.Descriptor instead.
+func (*XGroupCreateResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{116}
+}
+
+// XReadGroupRequest represents the request to read stream entries for a consumer of a group
+type XReadGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group    string               `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Consumer string               `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Id       string               `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`        // ">" or empty for new entries, otherwise the consumer's pending entries after this ID
+	Count    int32                `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"` // Zero returns every available entry
+	Noack    bool                 `protobuf:"varint,6,opt,name=noack,proto3" json:"noack,omitempty"` // Do not track the delivered entries as pending
+	Block    *durationpb.Duration `protobuf:"bytes,7,opt,name=block,proto3" json:"block,omitempty"`  // Wait for new entries, zero waits until the request is cancelled
+}
+
+func (x *XReadGroupRequest) Reset() {
+	*x = XReadGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XReadGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XReadGroupRequest) ProtoMessage() {}
+
+func (x *XReadGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XReadGroupRequest.ProtoReflect.Descriptor instead.
+func (*XReadGroupRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *XReadGroupRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XReadGroupRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XReadGroupRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XReadGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *XReadGroupRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *XReadGroupRequest) GetNoack() bool {
+	if x != nil {
+		return x.Noack
+	}
+	return false
+}
+
+func (x *XReadGroupRequest) GetBlock() *durationpb.Duration {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+// XReadGroupResponse represents the response from an XReadGroup operation
+type XReadGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*StreamEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Empty if the block timeout elapsed
+}
+
+func (x *XReadGroupResponse) Reset() {
+	*x = XReadGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XReadGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XReadGroupResponse) ProtoMessage() {}
+
+func (x *XReadGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XReadGroupResponse.ProtoReflect.Descriptor instead.
+func (*XReadGroupResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *XReadGroupResponse) GetEntries() []*StreamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// XAckRequest represents the request to acknowledge entries delivered to a consumer group
+type XAckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group string   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Ids   []string `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *XAckRequest) Reset() {
+	*x = XAckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XAckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAckRequest) ProtoMessage() {}
+
+func (x *XAckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAckRequest.ProtoReflect.Descriptor instead.
+func (*XAckRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *XAckRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XAckRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XAckRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// XAckResponse represents the response from an XAck operation
+type XAckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AckedCount int64 `protobuf:"varint,1,opt,name=acked_count,json=ackedCount,proto3" json:"acked_count,omitempty"`
+}
+
+func (x *XAckResponse) Reset() {
+	*x = XAckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XAckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XAckResponse) ProtoMessage() {}
+
+func (x *XAckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XAckResponse.ProtoReflect.Descriptor instead.
+func (*XAckResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *XAckResponse) GetAckedCount() int64 {
+	if x != nil {
+		return x.AckedCount
+	}
+	return 0
+}
+
+// XPendingRequest represents the request to inspect the pending entries of a consumer group
+type XPendingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group    string               `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Start    string               `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`                    // Defaults to "-"
+	End      string               `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`                        // Defaults to "+"
+	Count    int32                `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`                   // Entries to list, zero returns only the summary
+	Consumer string               `protobuf:"bytes,6,opt,name=consumer,proto3" json:"consumer,omitempty"`              // Only list the entries of this consumer
+	MinIdle  *durationpb.Duration `protobuf:"bytes,7,opt,name=min_idle,json=minIdle,proto3" json:"min_idle,omitempty"` // Only list entries idle for at least this long
+}
+
+func (x *XPendingRequest) Reset() {
+	*x = XPendingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XPendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPendingRequest) ProtoMessage() {}
+
+func (x *XPendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XPendingRequest.ProtoReflect.Descriptor instead.
+func (*XPendingRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *XPendingRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XPendingRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XPendingRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *XPendingRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *XPendingRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *XPendingRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XPendingRequest) GetMinIdle() *durationpb.Duration {
+	if x != nil {
+		return x.MinIdle
+	}
+	return nil
+}
+
+// PendingEntry is a stream entry delivered to a consumer but not yet acknowledged
+type PendingEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Consumer      string               `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Idle          *durationpb.Duration `protobuf:"bytes,3,opt,name=idle,proto3" json:"idle,omitempty"` // Time since the entry was last delivered
+	DeliveryCount int64                `protobuf:"varint,4,opt,name=delivery_count,json=deliveryCount,proto3" json:"delivery_count,omitempty"`
+}
+
+func (x *PendingEntry) Reset() {
+	*x = PendingEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingEntry) ProtoMessage() {}
+
+func (x *PendingEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingEntry.ProtoReflect.Descriptor instead.
+func (*PendingEntry) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *PendingEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PendingEntry) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *PendingEntry) GetIdle() *durationpb.Duration {
+	if x != nil {
+		return x.Idle
+	}
+	return nil
+}
+
+func (x *PendingEntry) GetDeliveryCount() int64 {
+	if x != nil {
+		return x.DeliveryCount
+	}
+	return 0
+}
+
+// XPendingResponse represents the response from an XPending operation
+type XPendingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count     int64            `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // Pending entries in the group
+	LowestId  string           `protobuf:"bytes,2,opt,name=lowest_id,json=lowestId,proto3" json:"lowest_id,omitempty"`
+	HighestId string           `protobuf:"bytes,3,opt,name=highest_id,json=highestId,proto3" json:"highest_id,omitempty"`
+	Consumers map[string]int64 `protobuf:"bytes,4,rep,name=consumers,proto3" json:"consumers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Pending entries per consumer
+	Entries   []*PendingEntry  `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *XPendingResponse) Reset() {
+	*x = XPendingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XPendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPendingResponse) ProtoMessage() {}
+
+func (x *XPendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XPendingResponse.ProtoReflect.Descriptor instead.
+func (*XPendingResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *XPendingResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *XPendingResponse) GetLowestId() string {
+	if x != nil {
+		return x.LowestId
+	}
+	return ""
+}
+
+func (x *XPendingResponse) GetHighestId() string {
+	if x != nil {
+		return x.HighestId
+	}
+	return ""
+}
+
+func (x *XPendingResponse) GetConsumers() map[string]int64 {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+func (x *XPendingResponse) GetEntries() []*PendingEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// XClaimRequest represents the request to transfer idle pending entries to another consumer
+type XClaimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Group    string               `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Consumer string               `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	MinIdle  *durationpb.Duration `protobuf:"bytes,4,opt,name=min_idle,json=minIdle,proto3" json:"min_idle,omitempty"`
+	Ids      []string             `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *XClaimRequest) Reset() {
+	*x = XClaimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XClaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XClaimRequest) ProtoMessage() {}
+
+func (x *XClaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XClaimRequest.ProtoReflect.Descriptor instead.
+func (*XClaimRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *XClaimRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *XClaimRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *XClaimRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *XClaimRequest) GetMinIdle() *durationpb.Duration {
+	if x != nil {
+		return x.MinIdle
+	}
+	return nil
+}
+
+func (x *XClaimRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// XClaimResponse represents the response from an XClaim operation
+type XClaimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*StreamEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Entries that were claimed
+}
+
+func (x *XClaimResponse) Reset() {
+	*x = XClaimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *XClaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XClaimResponse) ProtoMessage() {}
+
+func (x *XClaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XClaimResponse.ProtoReflect.Descriptor instead.
+func (*XClaimResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *XClaimResponse) GetEntries() []*StreamEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// SetBitRequest represents the request to set or clear a bit of a string value
+type SetBitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Bit 0 is the most significant bit of the first byte
+	Value  int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetBitRequest) Reset() {
+	*x = SetBitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBitRequest) ProtoMessage() {}
+
+func (x *SetBitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBitRequest.ProtoReflect.Descriptor instead.
+func (*SetBitRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SetBitRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetBitRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SetBitRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// SetBitResponse represents the response from a SetBit operation
+type SetBitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Original int32 `protobuf:"varint,1,opt,name=original,proto3" json:"original,omitempty"` // Previous value of the bit
+}
+
+func (x *SetBitResponse) Reset() {
+	*x = SetBitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBitResponse) ProtoMessage() {}
+
+func (x *SetBitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBitResponse.ProtoReflect.Descriptor instead.
+func (*SetBitResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SetBitResponse) GetOriginal() int32 {
+	if x != nil {
+		return x.Original
+	}
+	return 0
+}
+
+// GetBitRequest represents the request to retrieve a bit of a string value
+type GetBitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetBitRequest) Reset() {
+	*x = GetBitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBitRequest) ProtoMessage() {}
+
+func (x *GetBitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBitRequest.ProtoReflect.Descriptor instead.
+func (*GetBitRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetBitRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetBitRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// GetBitResponse represents the response from a GetBit operation
+type GetBitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"` // Zero past the end of the value
+}
+
+func (x *GetBitResponse) Reset() {
+	*x = GetBitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBitResponse) ProtoMessage() {}
+
+func (x *GetBitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBitResponse.ProtoReflect.Descriptor instead.
+func (*GetBitResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetBitResponse) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// BitCountRequest represents the request to count the set bits of a string value
+type BitCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start *int64 `protobuf:"varint,2,opt,name=start,proto3,oneof" json:"start,omitempty"`        // Defaults to the start of the value, negative offsets count from the end
+	End   *int64 `protobuf:"varint,3,opt,name=end,proto3,oneof" json:"end,omitempty"`            // Inclusive, defaults to the end of the value
+	ByBit bool   `protobuf:"varint,4,opt,name=by_bit,json=byBit,proto3" json:"by_bit,omitempty"` // Treat start and end as bit offsets rather than byte offsets
+}
+
+func (x *BitCountRequest) Reset() {
+	*x = BitCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BitCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitCountRequest) ProtoMessage() {}
+
+func (x *BitCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitCountRequest.ProtoReflect.Descriptor instead.
+func (*BitCountRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *BitCountRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BitCountRequest) GetStart() int64 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *BitCountRequest) GetEnd() int64 {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return 0
+}
+
+func (x *BitCountRequest) GetByBit() bool {
+	if x != nil {
+		return x.ByBit
+	}
+	return false
+}
+
+// BitCountResponse represents the response from a BitCount operation
+type BitCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BitCountResponse) Reset() {
+	*x = BitCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BitCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitCountResponse) ProtoMessage() {}
+
+func (x *BitCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitCountResponse.ProtoReflect.Descriptor instead.
+func (*BitCountResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *BitCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// BitPosRequest represents the request to find the first set or clear bit of a string value
+type BitPosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Bit   int32  `protobuf:"varint,2,opt,name=bit,proto3" json:"bit,omitempty"`
+	Start *int64 `protobuf:"varint,3,opt,name=start,proto3,oneof" json:"start,omitempty"`        // Defaults to the start of the value, negative offsets count from the end
+	End   *int64 `protobuf:"varint,4,opt,name=end,proto3,oneof" json:"end,omitempty"`            // Inclusive, defaults to the end of the value
+	ByBit bool   `protobuf:"varint,5,opt,name=by_bit,json=byBit,proto3" json:"by_bit,omitempty"` // Treat start and end as bit offsets rather than byte offsets
+}
+
+func (x *BitPosRequest) Reset() {
+	*x = BitPosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BitPosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitPosRequest) ProtoMessage() {}
+
+func (x *BitPosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitPosRequest.ProtoReflect.Descriptor instead.
+func (*BitPosRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *BitPosRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BitPosRequest) GetBit() int32 {
+	if x != nil {
+		return x.Bit
+	}
+	return 0
+}
+
+func (x *BitPosRequest) GetStart() int64 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *BitPosRequest) GetEnd() int64 {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return 0
+}
+
+func (x *BitPosRequest) GetByBit() bool {
+	if x != nil {
+		return x.ByBit
+	}
+	return false
+}
+
+// BitPosResponse represents the response from a BitPos operation
+type BitPosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int64 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"` // -1 if no such bit was found
+}
+
+func (x *BitPosResponse) Reset() {
+	*x = BitPosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BitPosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitPosResponse) ProtoMessage() {}
+
+func (x *BitPosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitPosResponse.ProtoReflect.Descriptor instead.
+func (*BitPosResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *BitPosResponse) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// BitOpRequest represents the request to store a bitwise operation between string values
+type BitOpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation   string   `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Destination string   `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys        []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"` // NOT takes a single key
+}
+
+func (x *BitOpRequest) Reset() {
+	*x = BitOpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BitOpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitOpRequest) ProtoMessage() {}
+
+func (x *BitOpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitOpRequest.ProtoReflect.Descriptor instead.
+func (*BitOpRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *BitOpRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *BitOpRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *BitOpRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// BitOpResponse represents the response from a BitOp operation
+type BitOpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"` // Length of the stored value
+}
+
+func (x *BitOpResponse) Reset() {
+	*x = BitOpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BitOpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitOpResponse) ProtoMessage() {}
+
+func (x *BitOpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitOpResponse.ProtoReflect.Descriptor instead.
+func (*BitOpResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *BitOpResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// PFAddRequest represents the request to add elements to a HyperLogLog
+type PFAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Elements []string `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"` // May be empty to only create the key
+}
+
+func (x *PFAddRequest) Reset() {
+	*x = PFAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFAddRequest) ProtoMessage() {}
+
+func (x *PFAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFAddRequest.ProtoReflect.Descriptor instead.
+func (*PFAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *PFAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PFAddRequest) GetElements() []string {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+// PFAddResponse represents the response from a PFAdd operation
+type PFAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changed bool `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"` // Whether the estimated cardinality may have changed
+}
+
+func (x *PFAddResponse) Reset() {
+	*x = PFAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFAddResponse) ProtoMessage() {}
+
+func (x *PFAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFAddResponse.ProtoReflect.Descriptor instead.
+func (*PFAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *PFAddResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+// PFCountRequest represents the request to estimate the distinct elements of HyperLogLogs
+type PFCountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"` // The estimate is for the union of the keys
+}
+
+func (x *PFCountRequest) Reset() {
+	*x = PFCountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFCountRequest) ProtoMessage() {}
+
+func (x *PFCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFCountRequest.ProtoReflect.Descriptor instead.
+func (*PFCountRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *PFCountRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// PFCountResponse represents the response from a PFCount operation
+type PFCountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PFCountResponse) Reset() {
+	*x = PFCountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFCountResponse) ProtoMessage() {}
+
+func (x *PFCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFCountResponse.ProtoReflect.Descriptor instead.
+func (*PFCountResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *PFCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PFMergeRequest represents the request to merge HyperLogLogs into a destination key
+type PFMergeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string   `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Keys        []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *PFMergeRequest) Reset() {
+	*x = PFMergeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFMergeRequest) ProtoMessage() {}
+
+func (x *PFMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFMergeRequest.ProtoReflect.Descriptor instead.
+func (*PFMergeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *PFMergeRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *PFMergeRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// PFMergeResponse represents the response from a PFMerge operation
+type PFMergeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *PFMergeResponse) Reset() {
+	*x = PFMergeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PFMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PFMergeResponse) ProtoMessage() {}
+
+func (x *PFMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PFMergeResponse.ProtoReflect.Descriptor instead.
+func (*PFMergeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *PFMergeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GeoPoint is a longitude and latitude pair
+type GeoPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Longitude float64 `protobuf:"fixed64,1,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+// GeoLocation is a member of a geo index and its coordinates
+type GeoLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member string    `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Point  *GeoPoint `protobuf:"bytes,2,opt,name=point,proto3" json:"point,omitempty"`
+}
+
+func (x *GeoLocation) Reset() {
+	*x = GeoLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoLocation) ProtoMessage() {}
+
+func (x *GeoLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoLocation.ProtoReflect.Descriptor instead.
+func (*GeoLocation) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GeoLocation) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *GeoLocation) GetPoint() *GeoPoint {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+// GeoAddRequest represents the request to add members to a geo index
+type GeoAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Locations []*GeoLocation `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
+	Nx        bool           `protobuf:"varint,3,opt,name=nx,proto3" json:"nx,omitempty"` // Only add new members
+	Xx        bool           `protobuf:"varint,4,opt,name=xx,proto3" json:"xx,omitempty"` // Only update existing members
+	Ch        bool           `protobuf:"varint,5,opt,name=ch,proto3" json:"ch,omitempty"` // Count moved members as well as added ones
+}
+
+func (x *GeoAddRequest) Reset() {
+	*x = GeoAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoAddRequest) ProtoMessage() {}
+
+func (x *GeoAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoAddRequest.ProtoReflect.Descriptor instead.
+func (*GeoAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GeoAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoAddRequest) GetLocations() []*GeoLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *GeoAddRequest) GetNx() bool {
+	if x != nil {
+		return x.Nx
+	}
+	return false
+}
+
+func (x *GeoAddRequest) GetXx() bool {
+	if x != nil {
+		return x.Xx
+	}
+	return false
+}
+
+func (x *GeoAddRequest) GetCh() bool {
+	if x != nil {
+		return x.Ch
+	}
+	return false
+}
+
+// GeoAddResponse represents the response from a GeoAdd operation
+type GeoAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // Members added, or added and moved with ch
+}
+
+func (x *GeoAddResponse) Reset() {
+	*x = GeoAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoAddResponse) ProtoMessage() {}
+
+func (x *GeoAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoAddResponse.ProtoReflect.Descriptor instead.
+func (*GeoAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GeoAddResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GeoDistRequest represents the request for the distance between two members of a geo index
+type GeoDistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Member1 string `protobuf:"bytes,2,opt,name=member1,proto3" json:"member1,omitempty"`
+	Member2 string `protobuf:"bytes,3,opt,name=member2,proto3" json:"member2,omitempty"`
+	Unit    string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"` // Defaults to meters
+}
+
+func (x *GeoDistRequest) Reset() {
+	*x = GeoDistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoDistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoDistRequest) ProtoMessage() {}
+
+func (x *GeoDistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoDistRequest.ProtoReflect.Descriptor instead.
+func (*GeoDistRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GeoDistRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoDistRequest) GetMember1() string {
+	if x != nil {
+		return x.Member1
+	}
+	return ""
+}
+
+func (x *GeoDistRequest) GetMember2() string {
+	if x != nil {
+		return x.Member2
+	}
+	return ""
+}
+
+func (x *GeoDistRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+// GeoDistResponse represents the response from a GeoDist operation
+type GeoDistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Distance float64 `protobuf:"fixed64,1,opt,name=distance,proto3" json:"distance,omitempty"`
+}
+
+func (x *GeoDistResponse) Reset() {
+	*x = GeoDistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoDistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoDistResponse) ProtoMessage() {}
+
+func (x *GeoDistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoDistResponse.ProtoReflect.Descriptor instead.
+func (*GeoDistResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GeoDistResponse) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// GeoBox is the size of a search box
+type GeoBox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width  float64 `protobuf:"fixed64,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height float64 `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *GeoBox) Reset() {
+	*x = GeoBox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoBox) ProtoMessage() {}
+
+func (x *GeoBox) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoBox.ProtoReflect.Descriptor instead.
+func (*GeoBox) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GeoBox) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *GeoBox) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// GeoSearchRequest represents the request to find the members of a geo index within an area
+type GeoSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are assignable to From:
+	//	*GeoSearchRequest_FromMember
+	//	*GeoSearchRequest_FromPoint
+	From isGeoSearchRequest_From `protobuf_oneof:"from"`
+	// Types that are assignable to By:
+	//	*GeoSearchRequest_ByRadius
+	//	*GeoSearchRequest_ByBox
+	By    isGeoSearchRequest_By `protobuf_oneof:"by"`
+	Unit  string                `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`    // Defaults to meters
+	Asc   bool                  `protobuf:"varint,7,opt,name=asc,proto3" json:"asc,omitempty"`     // Sort by distance, nearest first; implied by count without any
+	Desc  bool                  `protobuf:"varint,8,opt,name=desc,proto3" json:"desc,omitempty"`   // Sort by distance, farthest first
+	Count int32                 `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"` // Zero returns every member found
+	Any   bool                  `protobuf:"varint,10,opt,name=any,proto3" json:"any,omitempty"`    // Return the first count members found rather than the nearest
+}
+
+func (x *GeoSearchRequest) Reset() {
+	*x = GeoSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoSearchRequest) ProtoMessage() {}
+
+func (x *GeoSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoSearchRequest.ProtoReflect.Descriptor instead.
+func (*GeoSearchRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GeoSearchRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (m *GeoSearchRequest) GetFrom() isGeoSearchRequest_From {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (x *GeoSearchRequest) GetFromMember() string {
+	if x, ok := x.GetFrom().(*GeoSearchRequest_FromMember); ok {
+		return x.FromMember
+	}
+	return ""
+}
+
+func (x *GeoSearchRequest) GetFromPoint() *GeoPoint {
+	if x, ok := x.GetFrom().(*GeoSearchRequest_FromPoint); ok {
+		return x.FromPoint
+	}
+	return nil
+}
+
+func (m *GeoSearchRequest) GetBy() isGeoSearchRequest_By {
+	if m != nil {
+		return m.By
+	}
+	return nil
+}
+
+func (x *GeoSearchRequest) GetByRadius() float64 {
+	if x, ok := x.GetBy().(*GeoSearchRequest_ByRadius); ok {
+		return x.ByRadius
+	}
+	return 0
+}
+
+func (x *GeoSearchRequest) GetByBox() *GeoBox {
+	if x, ok := x.GetBy().(*GeoSearchRequest_ByBox); ok {
+		return x.ByBox
+	}
+	return nil
+}
+
+func (x *GeoSearchRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *GeoSearchRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
+}
+
+func (x *GeoSearchRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *GeoSearchRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GeoSearchRequest) GetAny() bool {
+	if x != nil {
+		return x.Any
+	}
+	return false
+}
+
+type isGeoSearchRequest_From interface {
+	isGeoSearchRequest_From()
+}
+
+type GeoSearchRequest_FromMember struct {
+	FromMember string `protobuf:"bytes,2,opt,name=from_member,json=fromMember,proto3,oneof"` // Center the search on a member
+}
+
+type GeoSearchRequest_FromPoint struct {
+	FromPoint *GeoPoint `protobuf:"bytes,3,opt,name=from_point,json=fromPoint,proto3,oneof"` // Center the search on coordinates
+}
+
+func (*GeoSearchRequest_FromMember) isGeoSearchRequest_From() {}
+
+func (*GeoSearchRequest_FromPoint) isGeoSearchRequest_From() {}
+
+type isGeoSearchRequest_By interface {
+	isGeoSearchRequest_By()
+}
+
+type GeoSearchRequest_ByRadius struct {
+	ByRadius float64 `protobuf:"fixed64,4,opt,name=by_radius,json=byRadius,proto3,oneof"`
+}
+
+type GeoSearchRequest_ByBox struct {
+	ByBox *GeoBox `protobuf:"bytes,5,opt,name=by_box,json=byBox,proto3,oneof"`
+}
+
+func (*GeoSearchRequest_ByRadius) isGeoSearchRequest_By() {}
+
+func (*GeoSearchRequest_ByBox) isGeoSearchRequest_By() {}
+
+// GeoResult is a member found by a geo search
+type GeoResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Member   string    `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Point    *GeoPoint `protobuf:"bytes,2,opt,name=point,proto3" json:"point,omitempty"`
+	Distance float64   `protobuf:"fixed64,3,opt,name=distance,proto3" json:"distance,omitempty"` // From the center of the search, in the unit of the search
+	Hash     uint64    `protobuf:"varint,4,opt,name=hash,proto3" json:"hash,omitempty"`          // Geohash score of the member
+}
+
+func (x *GeoResult) Reset() {
+	*x = GeoResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoResult) ProtoMessage() {}
+
+func (x *GeoResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoResult.ProtoReflect.Descriptor instead.
+func (*GeoResult) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GeoResult) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *GeoResult) GetPoint() *GeoPoint {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *GeoResult) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *GeoResult) GetHash() uint64 {
+	if x != nil {
+		return x.Hash
+	}
+	return 0
+}
+
+// GeoSearchResponse represents the response from a GeoSearch operation
+type GeoSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*GeoResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GeoSearchResponse) Reset() {
+	*x = GeoSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoSearchResponse) ProtoMessage() {}
+
+func (x *GeoSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoSearchResponse.ProtoReflect.Descriptor instead.
+func (*GeoSearchResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GeoSearchResponse) GetResults() []*GeoResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// JSONSetRequest represents the request to set a value in a JSON document
+type JSONSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path  string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`   // JSONPath, defaults to the root $
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // JSON text, max 512KB
+	Nx    bool   `protobuf:"varint,4,opt,name=nx,proto3" json:"nx,omitempty"`      // Only set missing values
+	Xx    bool   `protobuf:"varint,5,opt,name=xx,proto3" json:"xx,omitempty"`      // Only replace existing values
+}
+
+func (x *JSONSetRequest) Reset() {
+	*x = JSONSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONSetRequest) ProtoMessage() {}
+
+func (x *JSONSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONSetRequest.ProtoReflect.Descriptor instead.
+func (*JSONSetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *JSONSetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONSetRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONSetRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *JSONSetRequest) GetNx() bool {
+	if x != nil {
+		return x.Nx
+	}
+	return false
+}
+
+func (x *JSONSetRequest) GetXx() bool {
+	if x != nil {
+		return x.Xx
+	}
+	return false
+}
+
+// JSONSetResponse represents the response from a JSONSet operation
+type JSONSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // False if nx or xx prevented the set
+}
+
+func (x *JSONSetResponse) Reset() {
+	*x = JSONSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONSetResponse) ProtoMessage() {}
+
+func (x *JSONSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONSetResponse.ProtoReflect.Descriptor instead.
+func (*JSONSetResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *JSONSetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// JSONGetRequest represents the request to get values from a JSON document
+type JSONGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Paths []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"` // Defaults to the root $
+}
+
+func (x *JSONGetRequest) Reset() {
+	*x = JSONGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONGetRequest) ProtoMessage() {}
+
+func (x *JSONGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONGetRequest.ProtoReflect.Descriptor instead.
+func (*JSONGetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *JSONGetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONGetRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+// JSONGetResponse represents the response from a JSONGet operation
+type JSONGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // JSON array of matches, or an object of arrays keyed by path for several paths
+}
+
+func (x *JSONGetResponse) Reset() {
+	*x = JSONGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONGetResponse) ProtoMessage() {}
+
+func (x *JSONGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONGetResponse.ProtoReflect.Descriptor instead.
+func (*JSONGetResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *JSONGetResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// JSONDelRequest represents the request to delete values from a JSON document
+type JSONDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"` // Defaults to the root $, which deletes the key
+}
+
+func (x *JSONDelRequest) Reset() {
+	*x = JSONDelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONDelRequest) ProtoMessage() {}
+
+func (x *JSONDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONDelRequest.ProtoReflect.Descriptor instead.
+func (*JSONDelRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *JSONDelRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONDelRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// JSONDelResponse represents the response from a JSONDel operation
+type JSONDelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedCount int64 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+}
+
+func (x *JSONDelResponse) Reset() {
+	*x = JSONDelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONDelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONDelResponse) ProtoMessage() {}
+
+func (x *JSONDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONDelResponse.ProtoReflect.Descriptor instead.
+func (*JSONDelResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *JSONDelResponse) GetDeletedCount() int64 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+// JSONNumIncrByRequest represents the request to increment numbers in a JSON document
+type JSONNumIncrByRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path  string  `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *JSONNumIncrByRequest) Reset() {
+	*x = JSONNumIncrByRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONNumIncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONNumIncrByRequest) ProtoMessage() {}
+
+func (x *JSONNumIncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONNumIncrByRequest.ProtoReflect.Descriptor instead.
+func (*JSONNumIncrByRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *JSONNumIncrByRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONNumIncrByRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONNumIncrByRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// JSONNumIncrByResponse represents the response from a JSONNumIncrBy operation
+type JSONNumIncrByResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values string `protobuf:"bytes,1,opt,name=values,proto3" json:"values,omitempty"` // JSON array of new values, null where the path matched a non-number
+}
+
+func (x *JSONNumIncrByResponse) Reset() {
+	*x = JSONNumIncrByResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONNumIncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONNumIncrByResponse) ProtoMessage() {}
+
+func (x *JSONNumIncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONNumIncrByResponse.ProtoReflect.Descriptor instead.
+func (*JSONNumIncrByResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *JSONNumIncrByResponse) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+// JSONArrAppendRequest represents the request to append to arrays in a JSON document
+type JSONArrAppendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path   string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"` // JSON texts
+}
+
+func (x *JSONArrAppendRequest) Reset() {
+	*x = JSONArrAppendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONArrAppendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONArrAppendRequest) ProtoMessage() {}
+
+func (x *JSONArrAppendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONArrAppendRequest.ProtoReflect.Descriptor instead.
+func (*JSONArrAppendRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *JSONArrAppendRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *JSONArrAppendRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONArrAppendRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// JSONArrAppendResponse represents the response from a JSONArrAppend operation
+type JSONArrAppendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lengths []int64 `protobuf:"varint,1,rep,packed,name=lengths,proto3" json:"lengths,omitempty"` // New length of each matched array, -1 where the path matched a non-array
+}
+
+func (x *JSONArrAppendResponse) Reset() {
+	*x = JSONArrAppendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONArrAppendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONArrAppendResponse) ProtoMessage() {}
+
+func (x *JSONArrAppendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONArrAppendResponse.ProtoReflect.Descriptor instead.
+func (*JSONArrAppendResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *JSONArrAppendResponse) GetLengths() []int64 {
+	if x != nil {
+		return x.Lengths
+	}
+	return nil
+}
+
+// BFReserveRequest represents the request to create a Bloom filter
+type BFReserveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ErrorRate  float64 `protobuf:"fixed64,2,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`   // False positive rate
+	Capacity   int64   `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`                       // Items before the filter scales
+	Expansion  int32   `protobuf:"varint,4,opt,name=expansion,proto3" json:"expansion,omitempty"`                     // Capacity growth of each new layer, defaults to 2
+	NonScaling bool    `protobuf:"varint,5,opt,name=non_scaling,json=nonScaling,proto3" json:"non_scaling,omitempty"` // Fail adds once the filter is full instead of adding a layer
+}
+
+func (x *BFReserveRequest) Reset() {
+	*x = BFReserveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFReserveRequest) ProtoMessage() {}
+
+func (x *BFReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFReserveRequest.ProtoReflect.Descriptor instead.
+func (*BFReserveRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *BFReserveRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFReserveRequest) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *BFReserveRequest) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *BFReserveRequest) GetExpansion() int32 {
+	if x != nil {
+		return x.Expansion
+	}
+	return 0
+}
+
+func (x *BFReserveRequest) GetNonScaling() bool {
+	if x != nil {
+		return x.NonScaling
+	}
+	return false
+}
+
+// BFReserveResponse represents the response from a BFReserve operation
+type BFReserveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *BFReserveResponse) Reset() {
+	*x = BFReserveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFReserveResponse) ProtoMessage() {}
+
+func (x *BFReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFReserveResponse.ProtoReflect.Descriptor instead.
+func (*BFReserveResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *BFReserveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// BFAddRequest represents the request to add items to a Bloom filter
+type BFAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Items []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BFAddRequest) Reset() {
+	*x = BFAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFAddRequest) ProtoMessage() {}
+
+func (x *BFAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFAddRequest.ProtoReflect.Descriptor instead.
+func (*BFAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *BFAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFAddRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// BFAddResponse represents the response from a BFAdd operation
+type BFAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Added []bool `protobuf:"varint,1,rep,packed,name=added,proto3" json:"added,omitempty"` // False for items that may already have been in the filter
+}
+
+func (x *BFAddResponse) Reset() {
+	*x = BFAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFAddResponse) ProtoMessage() {}
+
+func (x *BFAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFAddResponse.ProtoReflect.Descriptor instead.
+func (*BFAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *BFAddResponse) GetAdded() []bool {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+// BFExistsRequest represents the request to check items against a Bloom filter
+type BFExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Items []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BFExistsRequest) Reset() {
+	*x = BFExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFExistsRequest) ProtoMessage() {}
+
+func (x *BFExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFExistsRequest.ProtoReflect.Descriptor instead.
+func (*BFExistsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *BFExistsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BFExistsRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// BFExistsResponse represents the response from a BFExists operation
+type BFExistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists []bool `protobuf:"varint,1,rep,packed,name=exists,proto3" json:"exists,omitempty"` // True for items that may be in the filter
+}
+
+func (x *BFExistsResponse) Reset() {
+	*x = BFExistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BFExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BFExistsResponse) ProtoMessage() {}
+
+func (x *BFExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BFExistsResponse.ProtoReflect.Descriptor instead.
+func (*BFExistsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *BFExistsResponse) GetExists() []bool {
+	if x != nil {
+		return x.Exists
+	}
+	return nil
+}
+
+// CFAddRequest represents the request to add items to a Cuckoo filter
+type CFAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Items []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CFAddRequest) Reset() {
+	*x = CFAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFAddRequest) ProtoMessage() {}
+
+func (x *CFAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFAddRequest.ProtoReflect.Descriptor instead.
+func (*CFAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *CFAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFAddRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// CFAddResponse represents the response from a CFAdd operation
+type CFAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *CFAddResponse) Reset() {
+	*x = CFAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFAddResponse) ProtoMessage() {}
+
+func (x *CFAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFAddResponse.ProtoReflect.Descriptor instead.
+func (*CFAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *CFAddResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// CFDelRequest represents the request to delete an item from a Cuckoo filter
+type CFDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Item string `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CFDelRequest) Reset() {
+	*x = CFDelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFDelRequest) ProtoMessage() {}
+
+func (x *CFDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFDelRequest.ProtoReflect.Descriptor instead.
+func (*CFDelRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *CFDelRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFDelRequest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+// CFDelResponse represents the response from a CFDel operation
+type CFDelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted bool `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *CFDelResponse) Reset() {
+	*x = CFDelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFDelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFDelResponse) ProtoMessage() {}
+
+func (x *CFDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFDelResponse.ProtoReflect.Descriptor instead.
+func (*CFDelResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *CFDelResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// CFExistsRequest represents the request to check items against a Cuckoo filter
+type CFExistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Items []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CFExistsRequest) Reset() {
+	*x = CFExistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFExistsRequest) ProtoMessage() {}
+
+func (x *CFExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFExistsRequest.ProtoReflect.Descriptor instead.
+func (*CFExistsRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *CFExistsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CFExistsRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// CFExistsResponse represents the response from a CFExists operation
+type CFExistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists []bool `protobuf:"varint,1,rep,packed,name=exists,proto3" json:"exists,omitempty"` // True for items that may be in the filter
+}
+
+func (x *CFExistsResponse) Reset() {
+	*x = CFExistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CFExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CFExistsResponse) ProtoMessage() {}
+
+func (x *CFExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CFExistsResponse.ProtoReflect.Descriptor instead.
+func (*CFExistsResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *CFExistsResponse) GetExists() []bool {
+	if x != nil {
+		return x.Exists
+	}
+	return nil
+}
+
+// CMSInitByDimRequest represents the request to create a Count-Min Sketch
+type CMSInitByDimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Width uint32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"` // Counters per row
+	Depth uint32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"` // Number of rows
+}
+
+func (x *CMSInitByDimRequest) Reset() {
+	*x = CMSInitByDimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CMSInitByDimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSInitByDimRequest) ProtoMessage() {}
+
+func (x *CMSInitByDimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSInitByDimRequest.ProtoReflect.Descriptor instead.
+func (*CMSInitByDimRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *CMSInitByDimRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CMSInitByDimRequest) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CMSInitByDimRequest) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// CMSInitByDimResponse represents the response from a CMSInitByDim operation
+type CMSInitByDimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *CMSInitByDimResponse) Reset() {
+	*x = CMSInitByDimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CMSInitByDimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSInitByDimResponse) ProtoMessage() {}
+
+func (x *CMSInitByDimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSInitByDimResponse.ProtoReflect.Descriptor instead.
+func (*CMSInitByDimResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *CMSInitByDimResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// CMSIncrement is an item and the amount to increment its count by
+type CMSIncrement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item      string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Increment uint64 `protobuf:"varint,2,opt,name=increment,proto3" json:"increment,omitempty"`
+}
+
+func (x *CMSIncrement) Reset() {
+	*x = CMSIncrement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CMSIncrement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSIncrement) ProtoMessage() {}
+
+func (x *CMSIncrement) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSIncrement.ProtoReflect.Descriptor instead.
+func (*CMSIncrement) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *CMSIncrement) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *CMSIncrement) GetIncrement() uint64 {
+	if x != nil {
+		return x.Increment
+	}
+	return 0
+}
+
+// CMSIncrByRequest represents the request to increment counts in a Count-Min Sketch
+type CMSIncrByRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key        string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Increments []*CMSIncrement `protobuf:"bytes,2,rep,name=increments,proto3" json:"increments,omitempty"`
+}
+
+func (x *CMSIncrByRequest) Reset() {
+	*x = CMSIncrByRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CMSIncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSIncrByRequest) ProtoMessage() {}
+
+func (x *CMSIncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSIncrByRequest.ProtoReflect.Descriptor instead.
+func (*CMSIncrByRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *CMSIncrByRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CMSIncrByRequest) GetIncrements() []*CMSIncrement {
+	if x != nil {
+		return x.Increments
+	}
+	return nil
+}
+
+// CMSIncrByResponse represents the response from a CMSIncrBy operation
+type CMSIncrByResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts []uint64 `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty"` // Estimated count of each item after the increment
+}
+
+func (x *CMSIncrByResponse) Reset() {
+	*x = CMSIncrByResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CMSIncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSIncrByResponse) ProtoMessage() {}
+
+func (x *CMSIncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSIncrByResponse.ProtoReflect.Descriptor instead.
+func (*CMSIncrByResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *CMSIncrByResponse) GetCounts() []uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// CMSQueryRequest represents the request for estimated counts from a Count-Min Sketch
+type CMSQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Items []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CMSQueryRequest) Reset() {
+	*x = CMSQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CMSQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSQueryRequest) ProtoMessage() {}
+
+func (x *CMSQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSQueryRequest.ProtoReflect.Descriptor instead.
+func (*CMSQueryRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *CMSQueryRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CMSQueryRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// CMSQueryResponse represents the response from a CMSQuery operation
+type CMSQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counts []uint64 `protobuf:"varint,1,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *CMSQueryResponse) Reset() {
+	*x = CMSQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CMSQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSQueryResponse) ProtoMessage() {}
+
+func (x *CMSQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSQueryResponse.ProtoReflect.Descriptor instead.
+func (*CMSQueryResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *CMSQueryResponse) GetCounts() []uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// TopKReserveRequest represents the request to create a Top-K sketch
+type TopKReserveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Topk  int32   `protobuf:"varint,2,opt,name=topk,proto3" json:"topk,omitempty"`    // Number of items to track
+	Width int32   `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`  // Buckets per row, defaults to 8
+	Depth int32   `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`  // Number of rows, defaults to 7
+	Decay float64 `protobuf:"fixed64,5,opt,name=decay,proto3" json:"decay,omitempty"` // Decay base, defaults to 0.9
+}
+
+func (x *TopKReserveRequest) Reset() {
+	*x = TopKReserveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKReserveRequest) ProtoMessage() {}
+
+func (x *TopKReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKReserveRequest.ProtoReflect.Descriptor instead.
+func (*TopKReserveRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *TopKReserveRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TopKReserveRequest) GetTopk() int32 {
+	if x != nil {
+		return x.Topk
+	}
+	return 0
+}
+
+func (x *TopKReserveRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *TopKReserveRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *TopKReserveRequest) GetDecay() float64 {
+	if x != nil {
+		return x.Decay
+	}
+	return 0
+}
+
+// TopKReserveResponse represents the response from a TopKReserve operation
+type TopKReserveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *TopKReserveResponse) Reset() {
+	*x = TopKReserveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKReserveResponse) ProtoMessage() {}
+
+func (x *TopKReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKReserveResponse.ProtoReflect.Descriptor instead.
+func (*TopKReserveResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *TopKReserveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// TopKAddRequest represents the request to add items to a Top-K sketch
+type TopKAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Items []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *TopKAddRequest) Reset() {
+	*x = TopKAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKAddRequest) ProtoMessage() {}
+
+func (x *TopKAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKAddRequest.ProtoReflect.Descriptor instead.
+func (*TopKAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *TopKAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TopKAddRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// TopKAddResponse represents the response from a TopKAdd operation
+type TopKAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expelled []string `protobuf:"bytes,1,rep,name=expelled,proto3" json:"expelled,omitempty"` // Item expelled from the top k by each added item, empty if none
+}
+
+func (x *TopKAddResponse) Reset() {
+	*x = TopKAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKAddResponse) ProtoMessage() {}
+
+func (x *TopKAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKAddResponse.ProtoReflect.Descriptor instead.
+func (*TopKAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *TopKAddResponse) GetExpelled() []string {
+	if x != nil {
+		return x.Expelled
+	}
+	return nil
+}
+
+// TopKQueryRequest represents the request to check items against the top k of a sketch
+type TopKQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Items []string `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *TopKQueryRequest) Reset() {
+	*x = TopKQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKQueryRequest) ProtoMessage() {}
+
+func (x *TopKQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKQueryRequest.ProtoReflect.Descriptor instead.
+func (*TopKQueryRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *TopKQueryRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TopKQueryRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// TopKQueryResponse represents the response from a TopKQuery operation
+type TopKQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Found []bool `protobuf:"varint,1,rep,packed,name=found,proto3" json:"found,omitempty"`
+}
+
+func (x *TopKQueryResponse) Reset() {
+	*x = TopKQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKQueryResponse) ProtoMessage() {}
+
+func (x *TopKQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKQueryResponse.ProtoReflect.Descriptor instead.
+func (*TopKQueryResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *TopKQueryResponse) GetFound() []bool {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
+// TopKListRequest represents the request for the top k items of a sketch
+type TopKListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *TopKListRequest) Reset() {
+	*x = TopKListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKListRequest) ProtoMessage() {}
+
+func (x *TopKListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKListRequest.ProtoReflect.Descriptor instead.
+func (*TopKListRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *TopKListRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// TopKItem is an item of a Top-K sketch and its estimated count
+type TopKItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item  string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TopKItem) Reset() {
+	*x = TopKItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKItem) ProtoMessage() {}
+
+func (x *TopKItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKItem.ProtoReflect.Descriptor instead.
+func (*TopKItem) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *TopKItem) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *TopKItem) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// TopKListResponse represents the response from a TopKList operation
+type TopKListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*TopKItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Highest count first
+}
+
+func (x *TopKListResponse) Reset() {
+	*x = TopKListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopKListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKListResponse) ProtoMessage() {}
+
+func (x *TopKListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKListResponse.ProtoReflect.Descriptor instead.
+func (*TopKListResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *TopKListResponse) GetItems() []*TopKItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// TDigestCreateRequest represents the request to create a t-digest
+type TDigestCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key         string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Compression float64 `protobuf:"fixed64,2,opt,name=compression,proto3" json:"compression,omitempty"` // Defaults to 100
+}
+
+func (x *TDigestCreateRequest) Reset() {
+	*x = TDigestCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDigestCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDigestCreateRequest) ProtoMessage() {}
+
+func (x *TDigestCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDigestCreateRequest.ProtoReflect.Descriptor instead.
+func (*TDigestCreateRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *TDigestCreateRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TDigestCreateRequest) GetCompression() float64 {
+	if x != nil {
+		return x.Compression
+	}
+	return 0
+}
+
+// TDigestCreateResponse represents the response from a TDigestCreate operation
+type TDigestCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *TDigestCreateResponse) Reset() {
+	*x = TDigestCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDigestCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDigestCreateResponse) ProtoMessage() {}
+
+func (x *TDigestCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDigestCreateResponse.ProtoReflect.Descriptor instead.
+func (*TDigestCreateResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *TDigestCreateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// TDigestAddRequest represents the request to add samples to a t-digest
+type TDigestAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *TDigestAddRequest) Reset() {
+	*x = TDigestAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDigestAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDigestAddRequest) ProtoMessage() {}
+
+func (x *TDigestAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDigestAddRequest.ProtoReflect.Descriptor instead.
+func (*TDigestAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *TDigestAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TDigestAddRequest) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// TDigestAddResponse represents the response from a TDigestAdd operation
+type TDigestAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *TDigestAddResponse) Reset() {
+	*x = TDigestAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDigestAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDigestAddResponse) ProtoMessage() {}
+
+func (x *TDigestAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDigestAddResponse.ProtoReflect.Descriptor instead.
+func (*TDigestAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *TDigestAddResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// TDigestQuantileRequest represents the request for quantiles of a t-digest
+type TDigestQuantileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Quantiles []float64 `protobuf:"fixed64,2,rep,packed,name=quantiles,proto3" json:"quantiles,omitempty"`
+}
+
+func (x *TDigestQuantileRequest) Reset() {
+	*x = TDigestQuantileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDigestQuantileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDigestQuantileRequest) ProtoMessage() {}
+
+func (x *TDigestQuantileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDigestQuantileRequest.ProtoReflect.Descriptor instead.
+func (*TDigestQuantileRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *TDigestQuantileRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TDigestQuantileRequest) GetQuantiles() []float64 {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+// TDigestQuantileResponse represents the response from a TDigestQuantile operation
+type TDigestQuantileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"` // NaN if the digest is empty
+}
+
+func (x *TDigestQuantileResponse) Reset() {
+	*x = TDigestQuantileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDigestQuantileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDigestQuantileResponse) ProtoMessage() {}
+
+func (x *TDigestQuantileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDigestQuantileResponse.ProtoReflect.Descriptor instead.
+func (*TDigestQuantileResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *TDigestQuantileResponse) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// TDigestCDFRequest represents the request for the cumulative distribution of a t-digest
+type TDigestCDFRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *TDigestCDFRequest) Reset() {
+	*x = TDigestCDFRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDigestCDFRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDigestCDFRequest) ProtoMessage() {}
+
+func (x *TDigestCDFRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDigestCDFRequest.ProtoReflect.Descriptor instead.
+func (*TDigestCDFRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *TDigestCDFRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TDigestCDFRequest) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// TDigestCDFResponse represents the response from a TDigestCDF operation
+type TDigestCDFResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fractions []float64 `protobuf:"fixed64,1,rep,packed,name=fractions,proto3" json:"fractions,omitempty"` // NaN if the digest is empty
+}
+
+func (x *TDigestCDFResponse) Reset() {
+	*x = TDigestCDFResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TDigestCDFResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TDigestCDFResponse) ProtoMessage() {}
+
+func (x *TDigestCDFResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TDigestCDFResponse.ProtoReflect.Descriptor instead.
+func (*TDigestCDFResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *TDigestCDFResponse) GetFractions() []float64 {
+	if x != nil {
+		return x.Fractions
+	}
+	return nil
+}
+
+// TSAddRequest represents the request to add a sample to a time series
+type TSAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key         string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Timestamp   *int64            `protobuf:"varint,2,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"` // Unix milliseconds, defaults to the time of the write
+	Value       float64           `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	RetentionMs int64             `protobuf:"varint,4,opt,name=retention_ms,json=retentionMs,proto3" json:"retention_ms,omitempty"`                                                           // Only used when creating the series, 0 keeps samples forever
+	Labels      map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Only used when creating the series
+}
+
+func (x *TSAddRequest) Reset() {
+	*x = TSAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSAddRequest) ProtoMessage() {}
+
+func (x *TSAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSAddRequest.ProtoReflect.Descriptor instead.
+func (*TSAddRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *TSAddRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSAddRequest) GetTimestamp() int64 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *TSAddRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TSAddRequest) GetRetentionMs() int64 {
+	if x != nil {
+		return x.RetentionMs
+	}
+	return 0
+}
+
+func (x *TSAddRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// TSAddResponse represents the response from a TSAdd operation
+type TSAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *TSAddResponse) Reset() {
+	*x = TSAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSAddResponse) ProtoMessage() {}
+
+func (x *TSAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSAddResponse.ProtoReflect.Descriptor instead.
+func (*TSAddResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *TSAddResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// TSAggregation summarizes the samples of each time bucket of a range
+type TSAggregation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	BucketMs int64  `protobuf:"varint,2,opt,name=bucket_ms,json=bucketMs,proto3" json:"bucket_ms,omitempty"` // Buckets are aligned to multiples of this duration
+}
+
+func (x *TSAggregation) Reset() {
+	*x = TSAggregation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSAggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSAggregation) ProtoMessage() {}
+
+func (x *TSAggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSAggregation.ProtoReflect.Descriptor instead.
+func (*TSAggregation) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *TSAggregation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TSAggregation) GetBucketMs() int64 {
+	if x != nil {
+		return x.BucketMs
+	}
+	return 0
+}
+
+// TSSample is the value of a time series at a timestamp
+type TSSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Unix milliseconds, the start of the bucket for aggregations
+	Value     float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TSSample) Reset() {
+	*x = TSSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSSample) ProtoMessage() {}
+
+func (x *TSSample) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSSample.ProtoReflect.Descriptor instead.
+func (*TSSample) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *TSSample) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TSSample) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// TSRangeRequest represents the request for the samples of a time series
+type TSRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key         string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	From        *int64         `protobuf:"varint,2,opt,name=from,proto3,oneof" json:"from,omitempty"`        // Inclusive, defaults to the oldest sample
+	To          *int64         `protobuf:"varint,3,opt,name=to,proto3,oneof" json:"to,omitempty"`            // Inclusive, defaults to the newest sample
+	Aggregation *TSAggregation `protobuf:"bytes,4,opt,name=aggregation,proto3" json:"aggregation,omitempty"` // Returns raw samples if unset
+}
+
+func (x *TSRangeRequest) Reset() {
+	*x = TSRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSRangeRequest) ProtoMessage() {}
+
+func (x *TSRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSRangeRequest.ProtoReflect.Descriptor instead.
+func (*TSRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *TSRangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSRangeRequest) GetFrom() int64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *TSRangeRequest) GetTo() int64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+func (x *TSRangeRequest) GetAggregation() *TSAggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return nil
+}
+
+// TSRangeResponse represents the response from a TSRange operation
+type TSRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples []*TSSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *TSRangeResponse) Reset() {
+	*x = TSRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSRangeResponse) ProtoMessage() {}
+
+func (x *TSRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSRangeResponse.ProtoReflect.Descriptor instead.
+func (*TSRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *TSRangeResponse) GetSamples() []*TSSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+// TSMRangeRequest represents the request for the samples of the time series matching label filters
+type TSMRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *int64 `protobuf:"varint,1,opt,name=from,proto3,oneof" json:"from,omitempty"` // Inclusive, defaults to the oldest sample
+	To   *int64 `protobuf:"varint,2,opt,name=to,proto3,oneof" json:"to,omitempty"`     // Inclusive, defaults to the newest sample
+	// Filters of the form label=value, label!=value, label=(v1,v2) or label!=(v1,v2).
+	// label= matches series without the label and label!= series with it.
+	Filters     []string       `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	Aggregation *TSAggregation `protobuf:"bytes,4,opt,name=aggregation,proto3" json:"aggregation,omitempty"` // Returns raw samples if unset
+}
+
+func (x *TSMRangeRequest) Reset() {
+	*x = TSMRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSMRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSMRangeRequest) ProtoMessage() {}
+
+func (x *TSMRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSMRangeRequest.ProtoReflect.Descriptor instead.
+func (*TSMRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *TSMRangeRequest) GetFrom() int64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *TSMRangeRequest) GetTo() int64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+func (x *TSMRangeRequest) GetFilters() []string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *TSMRangeRequest) GetAggregation() *TSAggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return nil
+}
+
+// TSSeries is a time series and its samples within a range
+type TSSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Labels  map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Samples []*TSSample       `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *TSSeries) Reset() {
+	*x = TSSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSSeries) ProtoMessage() {}
+
+func (x *TSSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSSeries.ProtoReflect.Descriptor instead.
+func (*TSSeries) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *TSSeries) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TSSeries) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *TSSeries) GetSamples() []*TSSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+// TSMRangeResponse represents the response from a TSMRange operation
+type TSMRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Series []*TSSeries `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"` // Ordered by key
+}
+
+func (x *TSMRangeResponse) Reset() {
+	*x = TSMRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TSMRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TSMRangeResponse) ProtoMessage() {}
+
+func (x *TSMRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TSMRangeResponse.ProtoReflect.Descriptor instead.
+func (*TSMRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *TSMRangeResponse) GetSeries() []*TSSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// AppendRequest represents the request to append a value to a string
+type AppendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Max 512KB, binary safe
+}
+
+func (x *AppendRequest) Reset() {
+	*x = AppendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[207]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendRequest) ProtoMessage() {}
+
+func (x *AppendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[207]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendRequest.ProtoReflect.Descriptor instead.
+func (*AppendRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *AppendRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AppendRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// AppendResponse represents the response from an Append operation
+type AppendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"` // Length of the string after the append
+}
+
+func (x *AppendResponse) Reset() {
+	*x = AppendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[208]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendResponse) ProtoMessage() {}
+
+func (x *AppendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[208]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendResponse.ProtoReflect.Descriptor instead.
+func (*AppendResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *AppendResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// GetRangeRequest represents the request for a substring of a string
+type GetRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // Negative offsets count from the end of the string
+	End   int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`     // Inclusive
+}
+
+func (x *GetRangeRequest) Reset() {
+	*x = GetRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[209]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRangeRequest) ProtoMessage() {}
+
+func (x *GetRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[209]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *GetRangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetRangeRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetRangeRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// GetRangeResponse represents the response from a GetRange operation
+type GetRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // Empty if the key does not exist
+}
+
+func (x *GetRangeResponse) Reset() {
+	*x = GetRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[210]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRangeResponse) ProtoMessage() {}
+
+func (x *GetRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[210]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *GetRangeResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// SetRangeRequest represents the request to overwrite part of a string
+type SetRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // The string is padded with zero bytes up to the offset
+	Value  []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`    // Max 512KB, binary safe
+}
+
+func (x *SetRangeRequest) Reset() {
+	*x = SetRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[211]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRangeRequest) ProtoMessage() {}
+
+func (x *SetRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[211]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRangeRequest.ProtoReflect.Descriptor instead.
+func (*SetRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *SetRangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetRangeRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SetRangeRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// SetRangeResponse represents the response from a SetRange operation
+type SetRangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"` // Length of the string after the update
+}
+
+func (x *SetRangeResponse) Reset() {
+	*x = SetRangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[212]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRangeResponse) ProtoMessage() {}
+
+func (x *SetRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[212]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRangeResponse.ProtoReflect.Descriptor instead.
+func (*SetRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *SetRangeResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// StrLenRequest represents the request for the length of a string
+type StrLenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *StrLenRequest) Reset() {
+	*x = StrLenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[213]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrLenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrLenRequest) ProtoMessage() {}
+
+func (x *StrLenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[213]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrLenRequest.ProtoReflect.Descriptor instead.
+func (*StrLenRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *StrLenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// StrLenResponse represents the response from a StrLen operation
+type StrLenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int64 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"` // 0 if the key does not exist
+}
+
+func (x *StrLenResponse) Reset() {
+	*x = StrLenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[214]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrLenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrLenResponse) ProtoMessage() {}
+
+func (x *StrLenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[214]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrLenResponse.ProtoReflect.Descriptor instead.
+func (*StrLenResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *StrLenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// GetDelRequest represents the request to get the value of a key and delete it
+type GetDelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *GetDelRequest) Reset() {
+	*x = GetDelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[215]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDelRequest) ProtoMessage() {}
+
+func (x *GetDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[215]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDelRequest.ProtoReflect.Descriptor instead.
+func (*GetDelRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *GetDelRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// GetDelResponse represents the response from a GetDel operation
+type GetDelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GetDelResponse) Reset() {
+	*x = GetDelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[216]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDelResponse) ProtoMessage() {}
+
+func (x *GetDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[216]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDelResponse.ProtoReflect.Descriptor instead.
+func (*GetDelResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *GetDelResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// GetExRequest represents the request to get the value of a key and update its timeout
+type GetExRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Leaves the timeout unchanged if unset
+	//
+	// Types that are assignable to Expiration:
+	//	*GetExRequest_Ttl
+	//	*GetExRequest_UnixTimeSeconds
+	//	*GetExRequest_UnixTimeMilliseconds
+	//	*GetExRequest_Persist
+	Expiration isGetExRequest_Expiration `protobuf_oneof:"expiration"`
+}
+
+func (x *GetExRequest) Reset() {
+	*x = GetExRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[217]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExRequest) ProtoMessage() {}
+
+func (x *GetExRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[217]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExRequest.ProtoReflect.Descriptor instead.
+func (*GetExRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *GetExRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (m *GetExRequest) GetExpiration() isGetExRequest_Expiration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (x *GetExRequest) GetTtl() *durationpb.Duration {
+	if x, ok := x.GetExpiration().(*GetExRequest_Ttl); ok {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *GetExRequest) GetUnixTimeSeconds() int64 {
+	if x, ok := x.GetExpiration().(*GetExRequest_UnixTimeSeconds); ok {
+		return x.UnixTimeSeconds
+	}
+	return 0
+}
+
+func (x *GetExRequest) GetUnixTimeMilliseconds() int64 {
+	if x, ok := x.GetExpiration().(*GetExRequest_UnixTimeMilliseconds); ok {
+		return x.UnixTimeMilliseconds
+	}
+	return 0
+}
+
+func (x *GetExRequest) GetPersist() bool {
+	if x, ok := x.GetExpiration().(*GetExRequest_Persist); ok {
+		return x.Persist
+	}
+	return false
+}
+
+type isGetExRequest_Expiration interface {
+	isGetExRequest_Expiration()
+}
+
+type GetExRequest_Ttl struct {
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3,oneof"`
+}
+
+type GetExRequest_UnixTimeSeconds struct {
+	UnixTimeSeconds int64 `protobuf:"varint,3,opt,name=unix_time_seconds,json=unixTimeSeconds,proto3,oneof"`
+}
+
+type GetExRequest_UnixTimeMilliseconds struct {
+	UnixTimeMilliseconds int64 `protobuf:"varint,4,opt,name=unix_time_milliseconds,json=unixTimeMilliseconds,proto3,oneof"`
+}
+
+type GetExRequest_Persist struct {
+	Persist bool `protobuf:"varint,5,opt,name=persist,proto3,oneof"` // Remove the timeout
+}
+
+func (*GetExRequest_Ttl) isGetExRequest_Expiration() {}
+
+func (*GetExRequest_UnixTimeSeconds) isGetExRequest_Expiration() {}
+
+func (*GetExRequest_UnixTimeMilliseconds) isGetExRequest_Expiration() {}
+
+func (*GetExRequest_Persist) isGetExRequest_Expiration() {}
+
+// GetExResponse represents the response from a GetEx operation
+type GetExResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GetExResponse) Reset() {
+	*x = GetExResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[218]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExResponse) ProtoMessage() {}
+
+func (x *GetExResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[218]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExResponse.ProtoReflect.Descriptor instead.
+func (*GetExResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *GetExResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// MGetRequest represents the request for the values of several keys
+type MGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *MGetRequest) Reset() {
+	*x = MGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[219]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetRequest) ProtoMessage() {}
+
+func (x *MGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[219]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetRequest.ProtoReflect.Descriptor instead.
+func (*MGetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *MGetRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// MGetValue is the value of a key returned by MGet
+type MGetValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Found bool   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"` // False if the key does not exist or does not hold a string
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MGetValue) Reset() {
+	*x = MGetValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[220]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MGetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetValue) ProtoMessage() {}
+
+func (x *MGetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[220]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetValue.ProtoReflect.Descriptor instead.
+func (*MGetValue) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *MGetValue) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *MGetValue) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// MGetResponse represents the response from an MGet operation
+type MGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*MGetValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"` // In the order of the keys
+}
+
+func (x *MGetResponse) Reset() {
+	*x = MGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[221]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetResponse) ProtoMessage() {}
+
+func (x *MGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[221]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetResponse.ProtoReflect.Descriptor instead.
+func (*MGetResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *MGetResponse) GetValues() []*MGetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// KeyValue is a key and the value to store at it
+type KeyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Max 512KB, binary safe
+}
+
+func (x *KeyValue) Reset() {
+	*x = KeyValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[222]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyValue) ProtoMessage() {}
+
+func (x *KeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[222]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyValue.ProtoReflect.Descriptor instead.
+func (*KeyValue) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *KeyValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyValue) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// MSetRequest represents the request to set several keys at once
+type MSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*KeyValue `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"` // If a key is given more than once, the last value wins
+}
+
+func (x *MSetRequest) Reset() {
+	*x = MSetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[223]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetRequest) ProtoMessage() {}
+
+func (x *MSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[223]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetRequest.ProtoReflect.Descriptor instead.
+func (*MSetRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *MSetRequest) GetPairs() []*KeyValue {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// MSetResponse represents the response from an MSet operation
+type MSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *MSetResponse) Reset() {
+	*x = MSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[224]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetResponse) ProtoMessage() {}
+
+func (x *MSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[224]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetResponse.ProtoReflect.Descriptor instead.
+func (*MSetResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *MSetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// MSetNXRequest represents the request to set several keys at once if none of them exists
+type MSetNXRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairs []*KeyValue `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+}
+
+func (x *MSetNXRequest) Reset() {
+	*x = MSetNXRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[225]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSetNXRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetNXRequest) ProtoMessage() {}
+
+func (x *MSetNXRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[225]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetNXRequest.ProtoReflect.Descriptor instead.
+func (*MSetNXRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *MSetNXRequest) GetPairs() []*KeyValue {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// MSetNXResponse represents the response from an MSetNX operation
+type MSetNXResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // False if any of the keys existed, in which case none was set
+}
+
+func (x *MSetNXResponse) Reset() {
+	*x = MSetNXResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[226]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSetNXResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetNXResponse) ProtoMessage() {}
+
+func (x *MSetNXResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[226]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetNXResponse.ProtoReflect.Descriptor instead.
+func (*MSetNXResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *MSetNXResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// PingRequest represents the request for a Ping operation
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // Optional message to echo back, alphanumeric and spaces only
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[227]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[227]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *PingRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// PingResponse represents the response from a Ping operation
+type PingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "PONG" or the echoed message
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[228]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[228]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *PingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// BackupRequest represents the request to create a backup
+type BackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"` // Name of the backup file to create
+}
+
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[229]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupRequest) ProtoMessage() {}
+
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[229]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *BackupRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// BackupResponse represents the response from a Backup operation
+type BackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message if backup failed
+	KeyCount     uint64 `protobuf:"varint,3,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`            // Number of keys written to the backup
+	RaftIndex    uint64 `protobuf:"varint,4,opt,name=raft_index,json=raftIndex,proto3" json:"raft_index,omitempty"`         // Raft index the backup was taken at
+	RaftTerm     uint64 `protobuf:"varint,5,opt,name=raft_term,json=raftTerm,proto3" json:"raft_term,omitempty"`            // Raft term the backup was taken at
+}
+
+func (x *BackupResponse) Reset() {
+	*x = BackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[230]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupResponse) ProtoMessage() {}
+
+func (x *BackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[230]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *BackupResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BackupResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *BackupResponse) GetKeyCount() uint64 {
+	if x != nil {
+		return x.KeyCount
+	}
+	return 0
+}
+
+func (x *BackupResponse) GetRaftIndex() uint64 {
+	if x != nil {
+		return x.RaftIndex
+	}
+	return 0
+}
+
+func (x *BackupResponse) GetRaftTerm() uint64 {
+	if x != nil {
+		return x.RaftTerm
+	}
+	return 0
+}
+
+// RestoreRequest represents the request to restore from a backup
+type RestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"` // Name of the backup file to restore from
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[231]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[231]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *RestoreRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// RestoreResponse represents the response from a Restore operation
+type RestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message if restore failed
+	KeyCount     uint64 `protobuf:"varint,3,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`            // Number of keys loaded from the backup
+}
+
+func (x *RestoreResponse) Reset() {
+	*x = RestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[232]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreResponse) ProtoMessage() {}
+
+func (x *RestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[232]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreResponse.ProtoReflect.Descriptor instead.
+func (*RestoreResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *RestoreResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RestoreResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RestoreResponse) GetKeyCount() uint64 {
+	if x != nil {
+		return x.KeyCount
+	}
+	return 0
+}
+
+// BackupStreamRequest represents the request to stream a backup to the client
+type BackupStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BackupStreamRequest) Reset() {
+	*x = BackupStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[233]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupStreamRequest) ProtoMessage() {}
+
+func (x *BackupStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[233]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupStreamRequest.ProtoReflect.Descriptor instead.
+func (*BackupStreamRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{233}
+}
+
+// BackupStreamResponse carries one chunk of a streamed backup
+type BackupStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"` // Max 64KB
+}
+
+func (x *BackupStreamResponse) Reset() {
+	*x = BackupStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[234]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupStreamResponse) ProtoMessage() {}
+
+func (x *BackupStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[234]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupStreamResponse.ProtoReflect.Descriptor instead.
+func (*BackupStreamResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *BackupStreamResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// RestoreStreamRequest carries one chunk of a backup streamed by the client
+type RestoreStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"` // Max 64KB
+}
+
+func (x *RestoreStreamRequest) Reset() {
+	*x = RestoreStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[235]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStreamRequest) ProtoMessage() {}
+
+func (x *RestoreStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[235]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStreamRequest.ProtoReflect.Descriptor instead.
+func (*RestoreStreamRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *RestoreStreamRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// RestoreStreamResponse represents the response from a RestoreStream operation
+type RestoreStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message if restore failed
+	KeyCount     uint64 `protobuf:"varint,3,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`            // Number of keys loaded from the backup
+}
+
+func (x *RestoreStreamResponse) Reset() {
+	*x = RestoreStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[236]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreStreamResponse) ProtoMessage() {}
+
+func (x *RestoreStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[236]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreStreamResponse.ProtoReflect.Descriptor instead.
+func (*RestoreStreamResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *RestoreStreamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RestoreStreamResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RestoreStreamResponse) GetKeyCount() uint64 {
+	if x != nil {
+		return x.KeyCount
+	}
+	return 0
+}
+
+// JoinRequest represents the request to join a new node to the cluster
+type JoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId     string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	RemoteAddr string `protobuf:"bytes,2,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[237]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[237]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *JoinRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+// JoinResponse represents the response from a Join operation
+type JoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"` // Error message if join failed
+}
+
+func (x *JoinResponse) Reset() {
+	*x = JoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cloud_v1_cloud_proto_msgTypes[238]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinResponse) ProtoMessage() {}
+
+func (x *JoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cloud_v1_cloud_proto_msgTypes[238]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinResponse.ProtoReflect.Descriptor instead.
+func (*JoinResponse) Descriptor() ([]byte, []int) {
+	return file_cloud_v1_cloud_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *JoinResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *JoinResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+var File_cloud_v1_cloud_proto protoreflect.FileDescriptor
+
+var file_cloud_v1_cloud_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x02,
+	0x0a, 0x0a, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xba, 0x48, 0x06,
+	0x7a, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x6e, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6e, 0x78, 0x12, 0x0e, 0x0a,
+	0x02, 0x78, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x78, 0x78, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x67, 0x65, 0x74, 0x12,
+	0x37, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0xaa, 0x01, 0x02, 0x2a,
+	0x00, 0x48, 0x00, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x35, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x0f,
+	0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x3f, 0x0a, 0x16, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x14, 0x75, 0x6e, 0x69, 0x78,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x24, 0x0a, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x07, 0xba, 0x48, 0x04, 0x6a, 0x02, 0x08, 0x01, 0x48, 0x00, 0x52, 0x07, 0x6b,
+	0x65, 0x65, 0x70, 0x54, 0x74, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xba, 0x48, 0x06, 0x7a, 0x04, 0x18, 0x80, 0x80, 0x20,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x26, 0xba, 0x48, 0x23, 0x92, 0x01, 0x20, 0x08, 0x01, 0x10, 0xe8, 0x07,
+	0x22, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00,
+	0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b,
+	0x0a, 0x0b, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x24, 0x0a, 0x0c, 0x49,
+	0x6e, 0x63, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x26,
+	0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2b, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x22, 0x24, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x44, 0x65, 0x63,
+	0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x72, 0x42, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x50,
+	0x0a, 0x12, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x2b, 0x0a, 0x13, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x79, 0x0a,
+	0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19,
+	0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38,
+	0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xaa, 0x01,
+	0x02, 0x2a, 0x00, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x2a, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x76, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x78, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0f, 0x75, 0x6e, 0x69,
+	0x78, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2c, 0x0a, 0x10,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x50,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48,
+	0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x3d, 0x0a, 0x16, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x14, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x2d,
+	0x0a, 0x11, 0x50, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2a, 0x0a,
+	0x0a, 0x54, 0x74, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x31, 0x0a, 0x0b, 0x54, 0x74, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0xba, 0x48, 0x0d, 0x22, 0x0b, 0x28, 0xfe, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x2b, 0x0a, 0x0b,
+	0x50, 0x74, 0x74, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x0c, 0x50, 0x74, 0x74,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x74, 0x74, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0xba, 0x48, 0x0d, 0x22, 0x0b, 0x28, 0xfe, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x22, 0x40, 0x0a,
+	0x0e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48,
+	0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
+	0x2b, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xd1, 0x01, 0x0a,
+	0x0b, 0x48, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x57, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x1c, 0xba, 0x48, 0x19, 0x9a, 0x01, 0x16, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x2a, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x38, 0x0a, 0x0c, 0x48, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0a,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x0b, 0x48, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x2f, 0x0a, 0x0c, 0x48, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x18,
+	0x80, 0x80, 0x20, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6b, 0x0a, 0x0b, 0x48, 0x44,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f,
+	0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0x92, 0x01,
+	0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x3c, 0x0a, 0x0c, 0x48, 0x44, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x0e, 0x48, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x48, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x48, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32,
+	0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b,
+	0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x0f, 0x48, 0x49, 0x6e, 0x63, 0x72, 0x42,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x90, 0x01, 0x0a, 0x0c, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x1e, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x6b, 0x0a, 0x0c, 0x4c, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8,
+	0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x30, 0x0a, 0x0d, 0x4c, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x22, 0x6b, 0x0a, 0x0c, 0x52, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07,
+	0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x22, 0x30, 0x0a, 0x0d, 0x52, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x22, 0x5f, 0x0a, 0x0b, 0x4c, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x0c, 0x4c, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x0b, 0x52,
+	0x50, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01,
+	0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05,
+	0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x0c,
+	0x52, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x0d, 0x4c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22, 0x28, 0x0a,
+	0x0e, 0x4c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x0b, 0x4c, 0x4c, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x2f, 0x0a, 0x0c, 0x4c, 0x4c, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x68, 0x0a, 0x0c, 0x4c, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x22,
+	0x32, 0x0a, 0x0d, 0x4c, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x07, 0xba, 0x48, 0x04, 0x6a, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x43, 0x0a, 0x0d, 0x4c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x31, 0x0a, 0x0e, 0x4c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
+	0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0c,
+	0x42, 0x4c, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x26, 0xba, 0x48, 0x23, 0x92,
+	0x01, 0x20, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x37, 0x0a, 0x0d, 0x42, 0x4c, 0x50, 0x6f, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x89, 0x01, 0x0a, 0x0c, 0x42, 0x52, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x26, 0xba, 0x48, 0x23, 0x92, 0x01, 0x20, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x19, 0x72, 0x17,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x3d, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0xaa, 0x01,
+	0x02, 0x32, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x37, 0x0a, 0x0d,
+	0x42, 0x52, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x0b, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10,
+	0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28,
+	0x00, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6c, 0x0a,
+	0x0b, 0x53, 0x52, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba,
+	0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80,
+	0x80, 0x20, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x0c, 0x53,
+	0x52, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x10, 0x53, 0x49, 0x73,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06,
+	0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x30,
+	0x0a, 0x11, 0x53, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x2f, 0x0a, 0x0f, 0x53, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x2c, 0x0a, 0x10, 0x53, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
+	0x2c, 0x0a, 0x0c, 0x53, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3a, 0x0a,
+	0x0d, 0x53, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x63, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x5f, 0x0a, 0x0b, 0x53, 0x50, 0x6f,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
+	0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8,
+	0x07, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x28, 0x0a, 0x0c, 0x53, 0x50,
+	0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x22, 0x5d, 0x0a, 0x12, 0x53, 0x52, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0xba, 0x48, 0x10, 0x1a, 0x0e, 0x18, 0xe8,
+	0x07, 0x28, 0x98, 0xf8, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x53, 0x52, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0x92, 0x01, 0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07,
+	0x22, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22,
+	0x2a, 0x0a, 0x0e, 0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x53,
+	0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0x92,
+	0x01, 0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x55, 0x6e, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x53, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x14, 0xba, 0x48, 0x11, 0x92, 0x01, 0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x29, 0x0a, 0x0d,
+	0x53, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x7e, 0x0a, 0x12, 0x53, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11,
+	0x92, 0x01, 0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x40, 0x0a, 0x13, 0x53, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x63, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x7e, 0x0a, 0x12, 0x53, 0x55, 0x6e,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xba,
+	0x48, 0x11, 0x92, 0x01, 0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x18, 0x80, 0x02, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x40, 0x0a, 0x13, 0x53, 0x55, 0x6e,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b,
+	0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x7d, 0x0a, 0x11, 0x53,
+	0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
+	0x5d, 0x2b, 0x24, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14,
+	0xba, 0x48, 0x11, 0x92, 0x01, 0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x3f, 0x0a, 0x12, 0x53, 0x44,
+	0x69, 0x66, 0x66, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b,
+	0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x42, 0x0a, 0x07, 0x5a,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80,
+	0x20, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0xdb, 0x01, 0x0a, 0x0b, 0x5a, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48,
+	0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x38, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6e, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x78, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x67, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x67, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x6c, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x63,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x69, 0x6e, 0x63, 0x72, 0x22, 0x52, 0x0a,
+	0x0c, 0x5a, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x6c, 0x0a, 0x0b, 0x5a, 0x52, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba,
+	0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x06,
+	0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
+	0x3c, 0x0a, 0x0c, 0x5a, 0x52, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52,
+	0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x50, 0x0a,
+	0x0d, 0x5a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48,
+	0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0x26, 0x0a, 0x0e, 0x5a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x0c, 0x5a, 0x52, 0x61, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20,
+	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x76, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x65, 0x76, 0x22, 0x2c, 0x0a, 0x0d, 0x5a, 0x52,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x28, 0x00, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x44, 0x0a, 0x0b, 0x5a, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe2,
+	0x01, 0x0a, 0x0d, 0x5a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba,
+	0x48, 0x08, 0x72, 0x06, 0x10, 0x01, 0x18, 0x81, 0x80, 0x20, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x1f, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0b, 0xba, 0x48, 0x08, 0x72, 0x06, 0x10, 0x01, 0x18, 0x81, 0x80, 0x20, 0x52, 0x04, 0x73, 0x74,
+	0x6f, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x62, 0x79, 0x5f, 0x6c, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62,
+	0x79, 0x4c, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x72, 0x65, 0x76, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x5a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x3d, 0x0a, 0x0e, 0x5a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x5a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x22, 0x67, 0x0a, 0x0d, 0x5a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1b, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x1b,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06,
+	0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x2f, 0x0a, 0x0e, 0x5a,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x0e,
+	0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19,
+	0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x3e, 0x0a, 0x0f, 0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x5a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x22, 0x62, 0x0a, 0x0e, 0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x0f, 0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x61, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a,
+	0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x94, 0x02, 0x0a, 0x0b, 0x58,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01,
+	0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x13, 0xba, 0x48, 0x10, 0x9a, 0x01, 0x0d, 0x08, 0x01,
+	0x10, 0xe8, 0x07, 0x2a, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52,
+	0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65,
+	0x6e, 0x22, 0x1e, 0x0a, 0x0c, 0x58, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x58, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1b, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x41, 0x0a, 0x0e, 0x58, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x58, 0x52, 0x65, 0x76, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x11, 0x58, 0x52, 0x65, 0x76, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2b, 0x0a,
+	0x0b, 0x58, 0x4c, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2f, 0x0a, 0x0c, 0x58, 0x4c,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x28, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x99, 0x01, 0x0a, 0x0c,
+	0x58, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e,
+	0x12, 0x22, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x48, 0x00, 0x52, 0x05, 0x6d,
+	0x69, 0x6e, 0x49, 0x64, 0x42, 0x11, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x3d, 0x0a, 0x0d, 0x58, 0x54, 0x72, 0x69, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x13, 0x58, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19,
+	0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba,
+	0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x6b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d,
+	0x6b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x16, 0x0a, 0x14, 0x58, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xbd, 0x02, 0x0a, 0x11, 0x58, 0x52, 0x65, 0x61, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19,
+	0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x1a, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6e,
+	0x6f, 0x61, 0x63, 0x6b, 0x12, 0x39, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x45, 0x0a, 0x12, 0x58, 0x52, 0x65, 0x61, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0b, 0x58, 0x41, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32,
+	0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b,
+	0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
+	0x5d, 0x2b, 0x24, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08,
+	0x01, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0x38, 0x0a, 0x0c, 0x58, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x0b, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52,
+	0x0a, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x0f,
+	0x58, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x1d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x18, 0x40, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18,
+	0x90, 0x4e, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x6c,
+	0x65, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x2d,
+	0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa6, 0x02, 0x0a, 0x10, 0x58, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28,
+	0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x77,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x58, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x94, 0x02,
+	0x0a, 0x0d, 0x58, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48,
+	0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x32, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
+	0x5d, 0x2b, 0x24, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x3e, 0x0a,
+	0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0xaa,
+	0x01, 0x02, 0x32, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x25, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92,
+	0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x0e, 0x58, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x42,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f,
+	0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xba, 0x48, 0x09, 0x22, 0x07,
+	0x18, 0xff, 0xff, 0xff, 0x01, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09,
+	0xba, 0x48, 0x06, 0x1a, 0x04, 0x30, 0x00, 0x30, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x2c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x22, 0x53,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xba,
+	0x48, 0x09, 0x22, 0x07, 0x18, 0xff, 0xff, 0xff, 0x01, 0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x26, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0f,
+	0x42, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x19, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x06, 0x62, 0x79, 0x5f, 0x62, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x62, 0x79, 0x42, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x64, 0x22, 0x31, 0x0a, 0x10, 0x42, 0x69, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x0d,
+	0x42, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x03, 0x62,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x30,
+	0x00, 0x30, 0x01, 0x52, 0x03, 0x62, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x01, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x79,
+	0x5f, 0x62, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x79, 0x42, 0x69,
+	0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x65, 0x6e, 0x64, 0x22, 0x2c, 0x0a, 0x0e, 0x42, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x42, 0x69, 0x74, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xba, 0x48, 0x15, 0x72, 0x13, 0x52, 0x03, 0x41, 0x4e,
+	0x44, 0x52, 0x02, 0x4f, 0x52, 0x52, 0x03, 0x58, 0x4f, 0x52, 0x52, 0x03, 0x4e, 0x4f, 0x54, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0x92, 0x01, 0x0e,
+	0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x22, 0x30, 0x0a, 0x0d, 0x42, 0x69, 0x74, 0x4f, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x6d, 0x0a, 0x0c, 0x50, 0x46, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32,
+	0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b,
+	0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0xba, 0x48, 0x0e, 0x92, 0x01, 0x0b,
+	0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x08, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x50, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x22, 0x3a, 0x0a, 0x0e, 0x50, 0x46, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x14, 0xba, 0x48, 0x11, 0x92, 0x01, 0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x30, 0x0a, 0x0f,
+	0x50, 0x46, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x78,
+	0x0a, 0x0e, 0x50, 0x46, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
+	0x5d, 0x2b, 0x24, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12,
+	0xba, 0x48, 0x0f, 0x92, 0x01, 0x0c, 0x10, 0xe8, 0x07, 0x22, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x50, 0x46, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x76, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x35, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x80, 0x66, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0xc0, 0x52, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12,
+	0x12, 0x19, 0x00, 0x7f, 0xa5, 0xb1, 0x45, 0x43, 0x55, 0x40, 0x29, 0x00, 0x7f, 0xa5, 0xb1, 0x45,
+	0x43, 0x55, 0xc0, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x62, 0x0a,
+	0x0b, 0x47, 0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48,
+	0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x30, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x22, 0xb1, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x6f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0xba,
+	0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x02, 0x6e, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x78, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x02, 0x78, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x02, 0x63, 0x68, 0x22, 0x2f, 0x0a, 0x0e, 0x47, 0x65, 0x6f, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x6f, 0x44, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x18,
+	0x80, 0x80, 0x20, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x31, 0x12, 0x23, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x32, 0x12, 0x2a, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x16, 0xba, 0x48, 0x13, 0x72, 0x11, 0x52, 0x00, 0x52, 0x01, 0x6d, 0x52, 0x02, 0x6b, 0x6d, 0x52,
+	0x02, 0x66, 0x74, 0x52, 0x02, 0x6d, 0x69, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x2d, 0x0a,
+	0x0f, 0x47, 0x65, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x56, 0x0a, 0x06,
+	0x47, 0x65, 0x6f, 0x42, 0x6f, 0x78, 0x12, 0x24, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x12, 0x09, 0x21, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xba, 0x48,
+	0x0b, 0x12, 0x09, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x8f, 0x03, 0x0a, 0x10, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18,
+	0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48,
+	0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x62, 0x79,
+	0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xba,
+	0x48, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x01, 0x52,
+	0x08, 0x62, 0x79, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x62, 0x79, 0x5f,
+	0x62, 0x6f, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x42, 0x6f, 0x78, 0x48, 0x01, 0x52, 0x05, 0x62,
+	0x79, 0x42, 0x6f, 0x78, 0x12, 0x2a, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x16, 0xba, 0x48, 0x13, 0x72, 0x11, 0x52, 0x00, 0x52, 0x01, 0x6d, 0x52, 0x02,
+	0x6b, 0x6d, 0x52, 0x02, 0x66, 0x74, 0x52, 0x02, 0x6d, 0x69, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61,
+	0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0x90, 0x4e, 0x28,
+	0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x42, 0x0d, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x42, 0x0b, 0x0a, 0x02, 0x62, 0x79, 0x12,
+	0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0x7d, 0x0a, 0x09, 0x47, 0x65, 0x6f, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x42, 0x0a, 0x11, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0e, 0x4a, 0x53,
+	0x4f, 0x4e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72,
+	0x03, 0x18, 0x80, 0x08, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x72, 0x06,
+	0x10, 0x01, 0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x6e, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6e, 0x78, 0x12, 0x0e, 0x0a,
+	0x02, 0x78, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x78, 0x78, 0x22, 0x2b, 0x0a,
+	0x0f, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x55, 0x0a, 0x0e, 0x4a, 0x53,
+	0x4f, 0x4e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x48, 0x0c, 0x92, 0x01,
+	0x09, 0x10, 0x64, 0x22, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x22, 0x27, 0x0a, 0x0f, 0x4a, 0x53, 0x4f, 0x4e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4c, 0x0a, 0x0e, 0x4a, 0x53,
+	0x4f, 0x4e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x08, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3f, 0x0a, 0x0f, 0x4a, 0x53, 0x4f, 0x4e,
+	0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0d, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x68, 0x0a, 0x14, 0x4a, 0x53, 0x4f,
+	0x4e, 0x4e, 0x75, 0x6d, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x1c, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x4a, 0x53, 0x4f, 0x4e, 0x4e, 0x75, 0x6d, 0x49, 0x6e,
+	0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x4a, 0x53, 0x4f, 0x4e, 0x41, 0x72, 0x72,
+	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x08, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x15, 0xba, 0x48, 0x12, 0x92, 0x01,
+	0x0f, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x08, 0x72, 0x06, 0x10, 0x01, 0x18, 0x80, 0x80, 0x20,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x4a, 0x53, 0x4f, 0x4e,
+	0x41, 0x72, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x07, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x10,
+	0x42, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba,
+	0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x36, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0xf0, 0x3f, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0c, 0xba, 0x48, 0x09, 0x22,
+	0x07, 0x18, 0x80, 0xc2, 0xd7, 0x2f, 0x28, 0x01, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x29, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x1a, 0x06, 0x18, 0x80, 0x80, 0x02,
+	0x28, 0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x6e, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x2d,
+	0x0a, 0x11, 0x42, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x69, 0x0a,
+	0x0c, 0x42, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72,
+	0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48,
+	0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80,
+	0x20, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x42, 0x46, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x22,
+	0x5a, 0x0a, 0x0f, 0x42, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04,
+	0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2a, 0x0a, 0x10, 0x42,
+	0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52,
+	0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x69, 0x0a, 0x0c, 0x43, 0x46, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02,
+	0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
+	0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01,
+	0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4b, 0x0a,
+	0x0c, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
+	0x18, 0x80, 0x80, 0x20, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x46,
+	0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x5a, 0x0a, 0x0f, 0x43, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10,
+	0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x2a, 0x0a, 0x10, 0x43, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x89, 0x01,
+	0x0a, 0x13, 0x43, 0x4d, 0x53, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x79, 0x44, 0x69, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x2a, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28,
+	0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xba, 0x48, 0x06, 0x2a, 0x04, 0x18, 0x40,
+	0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x30, 0x0a, 0x14, 0x43, 0x4d, 0x53,
+	0x49, 0x6e, 0x69, 0x74, 0x42, 0x79, 0x44, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4b, 0x0a, 0x0c, 0x43,
+	0x4d, 0x53, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
+	0x18, 0x80, 0x80, 0x20, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x10, 0x43, 0x4d, 0x53, 0x49,
+	0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x0a, 0x69, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4d, 0x53, 0x49, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01,
+	0x10, 0xe8, 0x07, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x2b, 0x0a, 0x11, 0x43, 0x4d, 0x53, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0f,
+	0x43, 0x4d, 0x53, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48,
+	0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80,
+	0x20, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2a, 0x0a, 0x10, 0x43, 0x4d, 0x53, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10,
+	0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x04, 0x74,
+	0x6f, 0x70, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05,
+	0x18, 0xe8, 0x07, 0x28, 0x01, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x6b, 0x12, 0x21, 0x0a, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x1a,
+	0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1f,
+	0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12,
+	0x2d, 0x0a, 0x05, 0x64, 0x65, 0x63, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17,
+	0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x05, 0x64, 0x65, 0x63, 0x61, 0x79, 0x22, 0x2f,
+	0x0a, 0x13, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x59, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x4b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13,
+	0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18,
+	0x80, 0x80, 0x20, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2d, 0x0a, 0x0f, 0x54, 0x6f,
+	0x70, 0x4b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x10, 0x54, 0x6f, 0x70,
+	0x4b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92,
+	0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x06, 0x72, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x29, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x4b, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0x2f, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x4b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x34, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x4b, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x4b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x14, 0x54, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48,
+	0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x88, 0xc3, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a, 0x15, 0x54, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x56, 0x0a,
+	0x11, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x23, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01,
+	0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0x90, 0x4e, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x77, 0x0a, 0x16, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3f, 0x0a,
+	0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01,
+	0x42, 0x21, 0xba, 0x48, 0x1e, 0x92, 0x01, 0x1b, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x14, 0x12,
+	0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x52, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x31,
+	0x0a, 0x17, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x22, 0x56, 0x0a, 0x11, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x43, 0x44, 0x46, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x01, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8,
+	0x07, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x54, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x43, 0x44, 0x46, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdd, 0x02,
+	0x0a, 0x0c, 0x54, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19,
+	0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x2a, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52,
+	0x0b, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x66, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x2a, 0xba, 0x48, 0x27, 0x9a, 0x01, 0x24, 0x10, 0x64, 0x22, 0x19, 0x72, 0x17, 0x10, 0x01,
+	0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x2a, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2d, 0x0a,
+	0x0d, 0x54, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6b, 0x0a, 0x0d,
+	0x54, 0x53, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0xba, 0x48, 0x1d,
+	0x72, 0x1b, 0x52, 0x03, 0x61, 0x76, 0x67, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x08, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x73, 0x22, 0x3e, 0x0a, 0x08, 0x54, 0x53, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x54, 0x53,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x74, 0x6f, 0x22, 0x3f, 0x0a, 0x0f, 0x54, 0x53, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x53, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0f, 0x54, 0x53, 0x4d, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
+	0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08,
+	0x01, 0x10, 0x64, 0x22, 0x07, 0x72, 0x05, 0x10, 0x02, 0x18, 0x80, 0x08, 0x52, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f,
+	0x22, 0xbd, 0x01, 0x0a, 0x08, 0x54, 0x53, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x36, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x3e, 0x0a, 0x10, 0x54, 0x53, 0x4d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x53, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x60, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x09, 0xba, 0x48, 0x06, 0x7a, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x57, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x89, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b,
+	0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0x22, 0x08, 0x10, 0x80, 0x80, 0x80, 0x80, 0x02,
+	0x28, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xba, 0x48, 0x06, 0x7a, 0x04,
+	0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2a, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x2d, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x4c, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x4c, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x22, 0x3f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c,
+	0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x22, 0x26, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba, 0x48, 0x19, 0x72, 0x17, 0x10, 0x01,
+	0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0xaa, 0x01, 0x02, 0x2a, 0x00, 0x48, 0x00, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x12, 0x35, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x6e, 0x69, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x16, 0x75, 0x6e,
+	0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x14, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x6a, 0x02, 0x08, 0x01, 0x48, 0x00, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x42, 0x0c, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x37, 0x0a, 0x0b, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x14, 0xba, 0x48, 0x11, 0x92, 0x01, 0x0e, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x37,
+	0x0a, 0x09, 0x4d, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3b, 0x0a, 0x0c, 0x4d, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x2e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xba,
+	0x48, 0x19, 0x72, 0x17, 0x10, 0x01, 0x18, 0x80, 0x02, 0x32, 0x10, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x7a, 0x04, 0x18, 0x80, 0x80, 0x20, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x44, 0x0a, 0x0b, 0x4d, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x35, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07,
+	0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x22, 0x31, 0x0a, 0x0c, 0x4d, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x07, 0xba, 0x48, 0x04, 0x6a, 0x02, 0x08,
+	0x01, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x46, 0x0a, 0x0d, 0x4d, 0x53,
+	0x65, 0x74, 0x4e, 0x58, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x70,
+	0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b,
+	0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52, 0x05, 0x70, 0x61, 0x69,
+	0x72, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x4d, 0x53, 0x65, 0x74, 0x4e, 0x58, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x44,
+	0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
+	0xba, 0x48, 0x18, 0x72, 0x16, 0x18, 0x80, 0x02, 0x32, 0x11, 0x5e, 0x5b, 0x5c, 0x70, 0x7b, 0x4c,
+	0x7d, 0x5c, 0x70, 0x7b, 0x4e, 0x7d, 0x5c, 0x73, 0x5d, 0x2a, 0x24, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80,
+	0x02, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x0d, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xba,
+	0x48, 0x1c, 0x72, 0x1a, 0x18, 0xff, 0x01, 0x32, 0x15, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
+	0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x5c, 0x2e, 0x72, 0x64, 0x62, 0x24, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x61, 0x66, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x61, 0x66, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x22, 0x4d, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3b, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1f, 0xba, 0x48, 0x1c, 0x72, 0x1a, 0x18, 0xff, 0x01, 0x32, 0x15, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x5c, 0x2e, 0x72, 0x64,
+	0x62, 0x24, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x77, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x0d, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6b, 0x65, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x14,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x7a, 0x06, 0x10, 0x01, 0x18, 0x80, 0x80, 0x04,
+	0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x39, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b,
+	0xba, 0x48, 0x08, 0x7a, 0x06, 0x10, 0x01, 0x18, 0x80, 0x80, 0x04, 0x52, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x22, 0x7d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x84, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x34, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0x72, 0x16, 0x10, 0x01, 0x18, 0x40, 0x32, 0x10, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52,
+	0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xba, 0x48,
+	0x1b, 0x72, 0x19, 0x10, 0x01, 0x18, 0xff, 0x01, 0x32, 0x12, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
+	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x2e, 0x3a, 0x5c, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0a, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x22, 0x57, 0x0a, 0x0c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x2d, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x08, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x32, 0x98, 0x39, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x34, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
+	0x14, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x03, 0x44, 0x65, 0x6c, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x49, 0x6e, 0x63, 0x72, 0x12, 0x15, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x63, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x06, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72,
+	0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04,
+	0x44, 0x65, 0x63, 0x72, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x63, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x44, 0x65, 0x63, 0x72, 0x42, 0x79, 0x12,
+	0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x42,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x63, 0x72, 0x42, 0x79, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63,
+	0x72, 0x42, 0x79, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x19, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x50, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x41, 0x74, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x03, 0x54, 0x74, 0x6c, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x74, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x74, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x50, 0x74, 0x74, 0x6c, 0x12, 0x15, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x74, 0x74, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x74, 0x74, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x04, 0x48, 0x53, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x48, 0x47, 0x65, 0x74,
+	0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x04, 0x48, 0x44, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x44, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x48, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07,
+	0x48, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x49, 0x6e,
+	0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x05, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x4c, 0x50,
+	0x75, 0x73, 0x68, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x52, 0x50, 0x75, 0x73, 0x68, 0x12,
+	0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x50, 0x75, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x50, 0x6f, 0x70, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x50, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x52,
+	0x50, 0x6f, 0x70, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x50, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x4c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x4c, 0x4c, 0x65, 0x6e, 0x12, 0x15, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05,
+	0x4c, 0x54, 0x72, 0x69, 0x6d, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x4c, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x42, 0x4c, 0x50, 0x6f, 0x70,
+	0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x4c, 0x50, 0x6f,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x4c, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x42, 0x52, 0x50, 0x6f, 0x70, 0x12, 0x16, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x52, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x52, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x04, 0x53, 0x41, 0x64, 0x64, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x53, 0x52, 0x65, 0x6d,
+	0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x52, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x52, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x09, 0x53, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x49, 0x73, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x49, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x05, 0x53, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x53, 0x50,
+	0x6f, 0x70, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x50,
+	0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x50, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x53, 0x52, 0x61, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x52,
+	0x61, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x52, 0x61, 0x6e,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x06, 0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x06, 0x53, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x05, 0x53, 0x44, 0x69, 0x66, 0x66, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x53,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x53, 0x55, 0x6e,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x44, 0x69, 0x66, 0x66,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x44,
+	0x69, 0x66, 0x66, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x5a, 0x41, 0x64, 0x64, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x5a,
+	0x52, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a,
+	0x52, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x52, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x5a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x17,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x5a, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x5a, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x5a, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x06, 0x5a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x06, 0x5a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x07, 0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x50,
+	0x6f, 0x70, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x07, 0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x61, 0x78, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x5a, 0x50, 0x6f, 0x70, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x04, 0x58, 0x41, 0x64, 0x64, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x58, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x58, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x58, 0x52, 0x65,
+	0x76, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x58, 0x52, 0x65, 0x76, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x52,
+	0x65, 0x76, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x04, 0x58, 0x4c, 0x65, 0x6e, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x4c, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x4c, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x58, 0x54,
+	0x72, 0x69, 0x6d, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58,
+	0x54, 0x72, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x54, 0x72, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x58, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x58, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x58, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x58, 0x52, 0x65, 0x61, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x58, 0x52, 0x65, 0x61, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x52,
+	0x65, 0x61, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x58, 0x41, 0x63, 0x6b, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x41, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x58,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x58, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x06, 0x58, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x58,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x42, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x42, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x06, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x08, 0x42, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x42, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x12, 0x17, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x05, 0x42, 0x69, 0x74, 0x4f, 0x70, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x4f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69,
+	0x74, 0x4f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x05, 0x50, 0x46, 0x41, 0x64, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x46, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x50, 0x46, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x46, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x46, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x50,
+	0x46, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x46, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x46, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x06, 0x47, 0x65, 0x6f, 0x41, 0x64, 0x64, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07,
+	0x47, 0x65, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x44, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f,
+	0x44, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x09, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x65,
+	0x74, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f,
+	0x4e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x4a, 0x53, 0x4f, 0x4e,
+	0x47, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
+	0x53, 0x4f, 0x4e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x4a, 0x53,
+	0x4f, 0x4e, 0x44, 0x65, 0x6c, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x44,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d,
+	0x4a, 0x53, 0x4f, 0x4e, 0x4e, 0x75, 0x6d, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x12, 0x1e, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x4e, 0x75, 0x6d,
+	0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x4e, 0x75, 0x6d,
+	0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x0d, 0x4a, 0x53, 0x4f, 0x4e, 0x41, 0x72, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f,
+	0x4e, 0x41, 0x72, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x53, 0x4f,
+	0x4e, 0x41, 0x72, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x42, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x46, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x46, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05,
+	0x42, 0x46, 0x41, 0x64, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x42, 0x46, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x46, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x05, 0x43, 0x46, 0x41, 0x64, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x46, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x46, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x43, 0x46, 0x44,
+	0x65, 0x6c, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x46,
+	0x44, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x46, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x43, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x46, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x46, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x43, 0x4d,
+	0x53, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x79, 0x44, 0x69, 0x6d, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4d, 0x53, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x79, 0x44,
+	0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4d, 0x53, 0x49, 0x6e, 0x69, 0x74, 0x42, 0x79, 0x44, 0x69,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x43,
+	0x4d, 0x53, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4d, 0x53, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x4d, 0x53, 0x49, 0x6e, 0x63, 0x72, 0x42, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x43, 0x4d, 0x53, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4d, 0x53, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x4d, 0x53, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x4b,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x54, 0x6f, 0x70, 0x4b, 0x41, 0x64,
+	0x64, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70,
+	0x4b, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x4b,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70,
+	0x4b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x4b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x54, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x0a, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x43, 0x44, 0x46, 0x12, 0x1b, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x43,
+	0x44, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x43, 0x44, 0x46, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x54, 0x53, 0x41,
+	0x64, 0x64, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x54, 0x53, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x54, 0x53, 0x4d, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x53, 0x4d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x53, 0x4d, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06,
+	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x4c, 0x65, 0x6e, 0x12,
+	0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x4c, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x4c, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x12, 0x17,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x45, 0x78, 0x12, 0x16, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x04, 0x4d, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x04, 0x4d, 0x53, 0x65, 0x74,
+	0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x06, 0x4d, 0x53, 0x65, 0x74, 0x4e, 0x58, 0x12, 0x17, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x53, 0x65, 0x74, 0x4e, 0x58, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x53, 0x65, 0x74, 0x4e, 0x58, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1e,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x28, 0x01, 0x12, 0x37, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x80, 0x01, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x72, 0x65, 0x64,
+	0x69, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x43, 0x58, 0x58, 0xaa, 0x02, 0x08, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x08, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x14, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x09, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cloud_v1_cloud_proto_rawDescOnce sync.Once
+	file_cloud_v1_cloud_proto_rawDescData = file_cloud_v1_cloud_proto_rawDesc
+)
+
+func file_cloud_v1_cloud_proto_rawDescGZIP() []byte {
+	file_cloud_v1_cloud_proto_rawDescOnce.Do(func() {
+		file_cloud_v1_cloud_proto_rawDescData = protoimpl.X.CompressGZIP(file_cloud_v1_cloud_proto_rawDescData)
+	})
+	return file_cloud_v1_cloud_proto_rawDescData
+}
+
+var file_cloud_v1_cloud_proto_msgTypes = make([]protoimpl.MessageInfo, 247)
+var file_cloud_v1_cloud_proto_goTypes = []any{
+	(*SetRequest)(nil),              // 0: cloud.v1.SetRequest
+	(*SetResponse)(nil),             // 1: cloud.v1.SetResponse
+	(*GetRequest)(nil),              // 2: cloud.v1.GetRequest
+	(*GetResponse)(nil),             // 3: cloud.v1.GetResponse
+	(*DelRequest)(nil),              // 4: cloud.v1.DelRequest
+	(*DelResponse)(nil),             // 5: cloud.v1.DelResponse
+	(*IncrRequest)(nil),             // 6: cloud.v1.IncrRequest
+	(*IncrResponse)(nil),            // 7: cloud.v1.IncrResponse
+	(*IncrByRequest)(nil),           // 8: cloud.v1.IncrByRequest
+	(*IncrByResponse)(nil),          // 9: cloud.v1.IncrByResponse
+	(*DecrRequest)(nil),             // 10: cloud.v1.DecrRequest
+	(*DecrResponse)(nil),            // 11: cloud.v1.DecrResponse
+	(*DecrByRequest)(nil),           // 12: cloud.v1.DecrByRequest
+	(*DecrByResponse)(nil),          // 13: cloud.v1.DecrByResponse
+	(*IncrByFloatRequest)(nil),      // 14: cloud.v1.IncrByFloatRequest
+	(*IncrByFloatResponse)(nil),     // 15: cloud.v1.IncrByFloatResponse
+	(*ExpireRequest)(nil),           // 16: cloud.v1.ExpireRequest
+	(*ExpireResponse)(nil),          // 17: cloud.v1.ExpireResponse
+	(*ExpireAtRequest)(nil),         // 18: cloud.v1.ExpireAtRequest
+	(*ExpireAtResponse)(nil),        // 19: cloud.v1.ExpireAtResponse
+	(*PExpireAtRequest)(nil),        // 20: cloud.v1.PExpireAtRequest
+	(*PExpireAtResponse)(nil),       // 21: cloud.v1.PExpireAtResponse
+	(*TtlRequest)(nil),              // 22: cloud.v1.TtlRequest
+	(*TtlResponse)(nil),             // 23: cloud.v1.TtlResponse
+	(*PttlRequest)(nil),             // 24: cloud.v1.PttlRequest
+	(*PttlResponse)(nil),            // 25: cloud.v1.PttlResponse
+	(*PersistRequest)(nil),          // 26: cloud.v1.PersistRequest
+	(*PersistResponse)(nil),         // 27: cloud.v1.PersistResponse
+	(*HSetRequest)(nil),             // 28: cloud.v1.HSetRequest
+	(*HSetResponse)(nil),            // 29: cloud.v1.HSetResponse
+	(*HGetRequest)(nil),             // 30: cloud.v1.HGetRequest
+	(*HGetResponse)(nil),            // 31: cloud.v1.HGetResponse
+	(*HDelRequest)(nil),             // 32: cloud.v1.HDelRequest
+	(*HDelResponse)(nil),            // 33: cloud.v1.HDelResponse
+	(*HGetAllRequest)(nil),          // 34: cloud.v1.HGetAllRequest
+	(*HGetAllResponse)(nil),         // 35: cloud.v1.HGetAllResponse
+	(*HIncrByRequest)(nil),          // 36: cloud.v1.HIncrByRequest
+	(*HIncrByResponse)(nil),         // 37: cloud.v1.HIncrByResponse
+	(*HScanRequest)(nil),            // 38: cloud.v1.HScanRequest
+	(*HScanResponse)(nil),           // 39: cloud.v1.HScanResponse
+	(*LPushRequest)(nil),            // 40: cloud.v1.LPushRequest
+	(*LPushResponse)(nil),           // 41: cloud.v1.LPushResponse
+	(*RPushRequest)(nil),            // 42: cloud.v1.RPushRequest
+	(*RPushResponse)(nil),           // 43: cloud.v1.RPushResponse
+	(*LPopRequest)(nil),             // 44: cloud.v1.LPopRequest
+	(*LPopResponse)(nil),            // 45: cloud.v1.LPopResponse
+	(*RPopRequest)(nil),             // 46: cloud.v1.RPopRequest
+	(*RPopResponse)(nil),            // 47: cloud.v1.RPopResponse
+	(*LRangeRequest)(nil),           // 48: cloud.v1.LRangeRequest
+	(*LRangeResponse)(nil),          // 49: cloud.v1.LRangeResponse
+	(*LLenRequest)(nil),             // 50: cloud.v1.LLenRequest
+	(*LLenResponse)(nil),            // 51: cloud.v1.LLenResponse
+	(*LTrimRequest)(nil),            // 52: cloud.v1.LTrimRequest
+	(*LTrimResponse)(nil),           // 53: cloud.v1.LTrimResponse
+	(*LIndexRequest)(nil),           // 54: cloud.v1.LIndexRequest
+	(*LIndexResponse)(nil),          // 55: cloud.v1.LIndexResponse
+	(*BLPopRequest)(nil),            // 56: cloud.v1.BLPopRequest
+	(*BLPopResponse)(nil),           // 57: cloud.v1.BLPopResponse
+	(*BRPopRequest)(nil),            // 58: cloud.v1.BRPopRequest
+	(*BRPopResponse)(nil),           // 59: cloud.v1.BRPopResponse
+	(*SAddRequest)(nil),             // 60: cloud.v1.SAddRequest
+	(*SAddResponse)(nil),            // 61: cloud.v1.SAddResponse
+	(*SRemRequest)(nil),             // 62: cloud.v1.SRemRequest
+	(*SRemResponse)(nil),            // 63: cloud.v1.SRemResponse
+	(*SIsMemberRequest)(nil),        // 64: cloud.v1.SIsMemberRequest
+	(*SIsMemberResponse)(nil),       // 65: cloud.v1.SIsMemberResponse
+	(*SMembersRequest)(nil),         // 66: cloud.v1.SMembersRequest
+	(*SMembersResponse)(nil),        // 67: cloud.v1.SMembersResponse
+	(*SCardRequest)(nil),            // 68: cloud.v1.SCardRequest
+	(*SCardResponse)(nil),           // 69: cloud.v1.SCardResponse
+	(*SPopRequest)(nil),             // 70: cloud.v1.SPopRequest
+	(*SPopResponse)(nil),            // 71: cloud.v1.SPopResponse
+	(*SRandMemberRequest)(nil),      // 72: cloud.v1.SRandMemberRequest
+	(*SRandMemberResponse)(nil),     // 73: cloud.v1.SRandMemberResponse
+	(*SInterRequest)(nil),           // 74: cloud.v1.SInterRequest
+	(*SInterResponse)(nil),          // 75: cloud.v1.SInterResponse
+	(*SUnionRequest)(nil),           // 76: cloud.v1.SUnionRequest
+	(*SUnionResponse)(nil),          // 77: cloud.v1.SUnionResponse
+	(*SDiffRequest)(nil),            // 78: cloud.v1.SDiffRequest
+	(*SDiffResponse)(nil),           // 79: cloud.v1.SDiffResponse
+	(*SInterStoreRequest)(nil),      // 80: cloud.v1.SInterStoreRequest
+	(*SInterStoreResponse)(nil),     // 81: cloud.v1.SInterStoreResponse
+	(*SUnionStoreRequest)(nil),      // 82: cloud.v1.SUnionStoreRequest
+	(*SUnionStoreResponse)(nil),     // 83: cloud.v1.SUnionStoreResponse
+	(*SDiffStoreRequest)(nil),       // 84: cloud.v1.SDiffStoreRequest
+	(*SDiffStoreResponse)(nil),      // 85: cloud.v1.SDiffStoreResponse
+	(*ZMember)(nil),                 // 86: cloud.v1.ZMember
+	(*ZAddRequest)(nil),             // 87: cloud.v1.ZAddRequest
+	(*ZAddResponse)(nil),            // 88: cloud.v1.ZAddResponse
+	(*ZRemRequest)(nil),             // 89: cloud.v1.ZRemRequest
+	(*ZRemResponse)(nil),            // 90: cloud.v1.ZRemResponse
+	(*ZScoreRequest)(nil),           // 91: cloud.v1.ZScoreRequest
+	(*ZScoreResponse)(nil),          // 92: cloud.v1.ZScoreResponse
+	(*ZRankRequest)(nil),            // 93: cloud.v1.ZRankRequest
+	(*ZRankResponse)(nil),           // 94: cloud.v1.ZRankResponse
+	(*ZRangeLimit)(nil),             // 95: cloud.v1.ZRangeLimit
+	(*ZRangeRequest)(nil),           // 96: cloud.v1.ZRangeRequest
+	(*ZRangeResponse)(nil),          // 97: cloud.v1.ZRangeResponse
+	(*ZCountRequest)(nil),           // 98: cloud.v1.ZCountRequest
+	(*ZCountResponse)(nil),          // 99: cloud.v1.ZCountResponse
+	(*ZPopMinRequest)(nil),          // 100: cloud.v1.ZPopMinRequest
+	(*ZPopMinResponse)(nil),         // 101: cloud.v1.ZPopMinResponse
+	(*ZPopMaxRequest)(nil),          // 102: cloud.v1.ZPopMaxRequest
+	(*ZPopMaxResponse)(nil),         // 103: cloud.v1.ZPopMaxResponse
+	(*StreamEntry)(nil),             // 104: cloud.v1.StreamEntry
+	(*XAddRequest)(nil),             // 105: cloud.v1.XAddRequest
+	(*XAddResponse)(nil),            // 106: cloud.v1.XAddResponse
+	(*XRangeRequest)(nil),           // 107: cloud.v1.XRangeRequest
+	(*XRangeResponse)(nil),          // 108: cloud.v1.XRangeResponse
+	(*XRevRangeRequest)(nil),        // 109: cloud.v1.XRevRangeRequest
+	(*XRevRangeResponse)(nil),       // 110: cloud.v1.XRevRangeResponse
+	(*XLenRequest)(nil),             // 111: cloud.v1.XLenRequest
+	(*XLenResponse)(nil),            // 112: cloud.v1.XLenResponse
+	(*XTrimRequest)(nil),            // 113: cloud.v1.XTrimRequest
+	(*XTrimResponse)(nil),           // 114: cloud.v1.XTrimResponse
+	(*XGroupCreateRequest)(nil),     // 115: cloud.v1.XGroupCreateRequest
+	(*XGroupCreateResponse)(nil),    // 116: cloud.v1.XGroupCreateResponse
+	(*XReadGroupRequest)(nil),       // 117: cloud.v1.XReadGroupRequest
+	(*XReadGroupResponse)(nil),      // 118: cloud.v1.XReadGroupResponse
+	(*XAckRequest)(nil),             // 119: cloud.v1.XAckRequest
+	(*XAckResponse)(nil),            // 120: cloud.v1.XAckResponse
+	(*XPendingRequest)(nil),         // 121: cloud.v1.XPendingRequest
+	(*PendingEntry)(nil),            // 122: cloud.v1.PendingEntry
+	(*XPendingResponse)(nil),        // 123: cloud.v1.XPendingResponse
+	(*XClaimRequest)(nil),           // 124: cloud.v1.XClaimRequest
+	(*XClaimResponse)(nil),          // 125: cloud.v1.XClaimResponse
+	(*SetBitRequest)(nil),           // 126: cloud.v1.SetBitRequest
+	(*SetBitResponse)(nil),          // 127: cloud.v1.SetBitResponse
+	(*GetBitRequest)(nil),           // 128: cloud.v1.GetBitRequest
+	(*GetBitResponse)(nil),          // 129: cloud.v1.GetBitResponse
+	(*BitCountRequest)(nil),         // 130: cloud.v1.BitCountRequest
+	(*BitCountResponse)(nil),        // 131: cloud.v1.BitCountResponse
+	(*BitPosRequest)(nil),           // 132: cloud.v1.BitPosRequest
+	(*BitPosResponse)(nil),          // 133: cloud.v1.BitPosResponse
+	(*BitOpRequest)(nil),            // 134: cloud.v1.BitOpRequest
+	(*BitOpResponse)(nil),           // 135: cloud.v1.BitOpResponse
+	(*PFAddRequest)(nil),            // 136: cloud.v1.PFAddRequest
+	(*PFAddResponse)(nil),           // 137: cloud.v1.PFAddResponse
+	(*PFCountRequest)(nil),          // 138: cloud.v1.PFCountRequest
+	(*PFCountResponse)(nil),         // 139: cloud.v1.PFCountResponse
+	(*PFMergeRequest)(nil),          // 140: cloud.v1.PFMergeRequest
+	(*PFMergeResponse)(nil),         // 141: cloud.v1.PFMergeResponse
+	(*GeoPoint)(nil),                // 142: cloud.v1.GeoPoint
+	(*GeoLocation)(nil),             // 143: cloud.v1.GeoLocation
+	(*GeoAddRequest)(nil),           // 144: cloud.v1.GeoAddRequest
+	(*GeoAddResponse)(nil),          // 145: cloud.v1.GeoAddResponse
+	(*GeoDistRequest)(nil),          // 146: cloud.v1.GeoDistRequest
+	(*GeoDistResponse)(nil),         // 147: cloud.v1.GeoDistResponse
+	(*GeoBox)(nil),                  // 148: cloud.v1.GeoBox
+	(*GeoSearchRequest)(nil),        // 149: cloud.v1.GeoSearchRequest
+	(*GeoResult)(nil),               // 150: cloud.v1.GeoResult
+	(*GeoSearchResponse)(nil),       // 151: cloud.v1.GeoSearchResponse
+	(*JSONSetRequest)(nil),          // 152: cloud.v1.JSONSetRequest
+	(*JSONSetResponse)(nil),         // 153: cloud.v1.JSONSetResponse
+	(*JSONGetRequest)(nil),          // 154: cloud.v1.JSONGetRequest
+	(*JSONGetResponse)(nil),         // 155: cloud.v1.JSONGetResponse
+	(*JSONDelRequest)(nil),          // 156: cloud.v1.JSONDelRequest
+	(*JSONDelResponse)(nil),         // 157: cloud.v1.JSONDelResponse
+	(*JSONNumIncrByRequest)(nil),    // 158: cloud.v1.JSONNumIncrByRequest
+	(*JSONNumIncrByResponse)(nil),   // 159: cloud.v1.JSONNumIncrByResponse
+	(*JSONArrAppendRequest)(nil),    // 160: cloud.v1.JSONArrAppendRequest
+	(*JSONArrAppendResponse)(nil),   // 161: cloud.v1.JSONArrAppendResponse
+	(*BFReserveRequest)(nil),        // 162: cloud.v1.BFReserveRequest
+	(*BFReserveResponse)(nil),       // 163: cloud.v1.BFReserveResponse
+	(*BFAddRequest)(nil),            // 164: cloud.v1.BFAddRequest
+	(*BFAddResponse)(nil),           // 165: cloud.v1.BFAddResponse
+	(*BFExistsRequest)(nil),         // 166: cloud.v1.BFExistsRequest
+	(*BFExistsResponse)(nil),        // 167: cloud.v1.BFExistsResponse
+	(*CFAddRequest)(nil),            // 168: cloud.v1.CFAddRequest
+	(*CFAddResponse)(nil),           // 169: cloud.v1.CFAddResponse
+	(*CFDelRequest)(nil),            // 170: cloud.v1.CFDelRequest
+	(*CFDelResponse)(nil),           // 171: cloud.v1.CFDelResponse
+	(*CFExistsRequest)(nil),         // 172: cloud.v1.CFExistsRequest
+	(*CFExistsResponse)(nil),        // 173: cloud.v1.CFExistsResponse
+	(*CMSInitByDimRequest)(nil),     // 174: cloud.v1.CMSInitByDimRequest
+	(*CMSInitByDimResponse)(nil),    // 175: cloud.v1.CMSInitByDimResponse
+	(*CMSIncrement)(nil),            // 176: cloud.v1.CMSIncrement
+	(*CMSIncrByRequest)(nil),        // 177: cloud.v1.CMSIncrByRequest
+	(*CMSIncrByResponse)(nil),       // 178: cloud.v1.CMSIncrByResponse
+	(*CMSQueryRequest)(nil),         // 179: cloud.v1.CMSQueryRequest
+	(*CMSQueryResponse)(nil),        // 180: cloud.v1.CMSQueryResponse
+	(*TopKReserveRequest)(nil),      // 181: cloud.v1.TopKReserveRequest
+	(*TopKReserveResponse)(nil),     // 182: cloud.v1.TopKReserveResponse
+	(*TopKAddRequest)(nil),          // 183: cloud.v1.TopKAddRequest
+	(*TopKAddResponse)(nil),         // 184: cloud.v1.TopKAddResponse
+	(*TopKQueryRequest)(nil),        // 185: cloud.v1.TopKQueryRequest
+	(*TopKQueryResponse)(nil),       // 186: cloud.v1.TopKQueryResponse
+	(*TopKListRequest)(nil),         // 187: cloud.v1.TopKListRequest
+	(*TopKItem)(nil),                // 188: cloud.v1.TopKItem
+	(*TopKListResponse)(nil),        // 189: cloud.v1.TopKListResponse
+	(*TDigestCreateRequest)(nil),    // 190: cloud.v1.TDigestCreateRequest
+	(*TDigestCreateResponse)(nil),   // 191: cloud.v1.TDigestCreateResponse
+	(*TDigestAddRequest)(nil),       // 192: cloud.v1.TDigestAddRequest
+	(*TDigestAddResponse)(nil),      // 193: cloud.v1.TDigestAddResponse
+	(*TDigestQuantileRequest)(nil),  // 194: cloud.v1.TDigestQuantileRequest
+	(*TDigestQuantileResponse)(nil), // 195: cloud.v1.TDigestQuantileResponse
+	(*TDigestCDFRequest)(nil),       // 196: cloud.v1.TDigestCDFRequest
+	(*TDigestCDFResponse)(nil),      // 197: cloud.v1.TDigestCDFResponse
+	(*TSAddRequest)(nil),            // 198: cloud.v1.TSAddRequest
+	(*TSAddResponse)(nil),           // 199: cloud.v1.TSAddResponse
+	(*TSAggregation)(nil),           // 200: cloud.v1.TSAggregation
+	(*TSSample)(nil),                // 201: cloud.v1.TSSample
+	(*TSRangeRequest)(nil),          // 202: cloud.v1.TSRangeRequest
+	(*TSRangeResponse)(nil),         // 203: cloud.v1.TSRangeResponse
+	(*TSMRangeRequest)(nil),         // 204: cloud.v1.TSMRangeRequest
+	(*TSSeries)(nil),                // 205: cloud.v1.TSSeries
+	(*TSMRangeResponse)(nil),        // 206: cloud.v1.TSMRangeResponse
+	(*AppendRequest)(nil),           // 207: cloud.v1.AppendRequest
+	(*AppendResponse)(nil),          // 208: cloud.v1.AppendResponse
+	(*GetRangeRequest)(nil),         // 209: cloud.v1.GetRangeRequest
+	(*GetRangeResponse)(nil),        // 210: cloud.v1.GetRangeResponse
+	(*SetRangeRequest)(nil),         // 211: cloud.v1.SetRangeRequest
+	(*SetRangeResponse)(nil),        // 212: cloud.v1.SetRangeResponse
+	(*StrLenRequest)(nil),           // 213: cloud.v1.StrLenRequest
+	(*StrLenResponse)(nil),          // 214: cloud.v1.StrLenResponse
+	(*GetDelRequest)(nil),           // 215: cloud.v1.GetDelRequest
+	(*GetDelResponse)(nil),          // 216: cloud.v1.GetDelResponse
+	(*GetExRequest)(nil),            // 217: cloud.v1.GetExRequest
+	(*GetExResponse)(nil),           // 218: cloud.v1.GetExResponse
+	(*MGetRequest)(nil),             // 219: cloud.v1.MGetRequest
+	(*MGetValue)(nil),               // 220: cloud.v1.MGetValue
+	(*MGetResponse)(nil),            // 221: cloud.v1.MGetResponse
+	(*KeyValue)(nil),                // 222: cloud.v1.KeyValue
+	(*MSetRequest)(nil),             // 223: cloud.v1.MSetRequest
+	(*MSetResponse)(nil),            // 224: cloud.v1.MSetResponse
+	(*MSetNXRequest)(nil),           // 225: cloud.v1.MSetNXRequest
+	(*MSetNXResponse)(nil),          // 226: cloud.v1.MSetNXResponse
+	(*PingRequest)(nil),             // 227: cloud.v1.PingRequest
+	(*PingResponse)(nil),            // 228: cloud.v1.PingResponse
+	(*BackupRequest)(nil),           // 229: cloud.v1.BackupRequest
+	(*BackupResponse)(nil),          // 230: cloud.v1.BackupResponse
+	(*RestoreRequest)(nil),          // 231: cloud.v1.RestoreRequest
+	(*RestoreResponse)(nil),         // 232: cloud.v1.RestoreResponse
+	(*BackupStreamRequest)(nil),     // 233: cloud.v1.BackupStreamRequest
+	(*BackupStreamResponse)(nil),    // 234: cloud.v1.BackupStreamResponse
+	(*RestoreStreamRequest)(nil),    // 235: cloud.v1.RestoreStreamRequest
+	(*RestoreStreamResponse)(nil),   // 236: cloud.v1.RestoreStreamResponse
+	(*JoinRequest)(nil),             // 237: cloud.v1.JoinRequest
+	(*JoinResponse)(nil),            // 238: cloud.v1.JoinResponse
+	nil,                             // 239: cloud.v1.HSetRequest.FieldsEntry
+	nil,                             // 240: cloud.v1.HGetAllResponse.FieldsEntry
+	nil,                             // 241: cloud.v1.HScanResponse.FieldsEntry
+	nil,                             // 242: cloud.v1.StreamEntry.FieldsEntry
+	nil,                             // 243: cloud.v1.XAddRequest.FieldsEntry
+	nil,                             // 244: cloud.v1.XPendingResponse.ConsumersEntry
+	nil,                             // 245: cloud.v1.TSAddRequest.LabelsEntry
+	nil,                             // 246: cloud.v1.TSSeries.LabelsEntry
+	(*durationpb.Duration)(nil),     // 247: google.protobuf.Duration
+}
+var file_cloud_v1_cloud_proto_depIdxs = []int32{
+	247, // 0: cloud.v1.SetRequest.ttl:type_name -> google.protobuf.Duration
+	247, // 1: cloud.v1.ExpireRequest.ttl:type_name -> google.protobuf.Duration
+	239, // 2: cloud.v1.HSetRequest.fields:type_name -> cloud.v1.HSetRequest.FieldsEntry
+	240, // 3: cloud.v1.HGetAllResponse.fields:type_name -> cloud.v1.HGetAllResponse.FieldsEntry
+	241, // 4: cloud.v1.HScanResponse.fields:type_name -> cloud.v1.HScanResponse.FieldsEntry
+	247, // 5: cloud.v1.BLPopRequest.timeout:type_name -> google.protobuf.Duration
+	247, // 6: cloud.v1.BRPopRequest.timeout:type_name -> google.protobuf.Duration
+	86,  // 7: cloud.v1.ZAddRequest.members:type_name -> cloud.v1.ZMember
+	95,  // 8: cloud.v1.ZRangeRequest.limit:type_name -> cloud.v1.ZRangeLimit
+	86,  // 9: cloud.v1.ZRangeResponse.members:type_name -> cloud.v1.ZMember
+	86,  // 10: cloud.v1.ZPopMinResponse.members:type_name -> cloud.v1.ZMember
+	86,  // 11: cloud.v1.ZPopMaxResponse.members:type_name -> cloud.v1.ZMember
+	242, // 12: cloud.v1.StreamEntry.fields:type_name -> cloud.v1.StreamEntry.FieldsEntry
+	243, // 13: cloud.v1.XAddRequest.fields:type_name -> cloud.v1.XAddRequest.FieldsEntry
+	104, // 14: cloud.v1.XRangeResponse.entries:type_name -> cloud.v1.StreamEntry
+	104, // 15: cloud.v1.XRevRangeResponse.entries:type_name -> cloud.v1.StreamEntry
+	247, // 16: cloud.v1.XReadGroupRequest.block:type_name -> google.protobuf.Duration
+	104, // 17: cloud.v1.XReadGroupResponse.entries:type_name -> cloud.v1.StreamEntry
+	247, // 18: cloud.v1.XPendingRequest.min_idle:type_name -> google.protobuf.Duration
+	247, // 19: cloud.v1.PendingEntry.idle:type_name -> google.protobuf.Duration
+	244, // 20: cloud.v1.XPendingResponse.consumers:type_name -> cloud.v1.XPendingResponse.ConsumersEntry
+	122, // 21: cloud.v1.XPendingResponse.entries:type_name -> cloud.v1.PendingEntry
+	247, // 22: cloud.v1.XClaimRequest.min_idle:type_name -> google.protobuf.Duration
+	104, // 23: cloud.v1.XClaimResponse.entries:type_name -> cloud.v1.StreamEntry
+	142, // 24: cloud.v1.GeoLocation.point:type_name -> cloud.v1.GeoPoint
+	143, // 25: cloud.v1.GeoAddRequest.locations:type_name -> cloud.v1.GeoLocation
+	142, // 26: cloud.v1.GeoSearchRequest.from_point:type_name -> cloud.v1.GeoPoint
+	148, // 27: cloud.v1.GeoSearchRequest.by_box:type_name -> cloud.v1.GeoBox
+	142, // 28: cloud.v1.GeoResult.point:type_name -> cloud.v1.GeoPoint
+	150, // 29: cloud.v1.GeoSearchResponse.results:type_name -> cloud.v1.GeoResult
+	176, // 30: cloud.v1.CMSIncrByRequest.increments:type_name -> cloud.v1.CMSIncrement
+	188, // 31: cloud.v1.TopKListResponse.items:type_name -> cloud.v1.TopKItem
+	245, // 32: cloud.v1.TSAddRequest.labels:type_name -> cloud.v1.TSAddRequest.LabelsEntry
+	200, // 33: cloud.v1.TSRangeRequest.aggregation:type_name -> cloud.v1.TSAggregation
+	201, // 34: cloud.v1.TSRangeResponse.samples:type_name -> cloud.v1.TSSample
+	200, // 35: cloud.v1.TSMRangeRequest.aggregation:type_name -> cloud.v1.TSAggregation
+	246, // 36: cloud.v1.TSSeries.labels:type_name -> cloud.v1.TSSeries.LabelsEntry
+	201, // 37: cloud.v1.TSSeries.samples:type_name -> cloud.v1.TSSample
+	205, // 38: cloud.v1.TSMRangeResponse.series:type_name -> cloud.v1.TSSeries
+	247, // 39: cloud.v1.GetExRequest.ttl:type_name -> google.protobuf.Duration
+	220, // 40: cloud.v1.MGetResponse.values:type_name -> cloud.v1.MGetValue
+	222, // 41: cloud.v1.MSetRequest.pairs:type_name -> cloud.v1.KeyValue
+	222, // 42: cloud.v1.MSetNXRequest.pairs:type_name -> cloud.v1.KeyValue
+	0,   // 43: cloud.v1.RedisService.Set:input_type -> cloud.v1.SetRequest
+	2,   // 44: cloud.v1.RedisService.Get:input_type -> cloud.v1.GetRequest
+	4,   // 45: cloud.v1.RedisService.Del:input_type -> cloud.v1.DelRequest
+	6,   // 46: cloud.v1.RedisService.Incr:input_type -> cloud.v1.IncrRequest
+	8,   // 47: cloud.v1.RedisService.IncrBy:input_type -> cloud.v1.IncrByRequest
+	10,  // 48: cloud.v1.RedisService.Decr:input_type -> cloud.v1.DecrRequest
+	12,  // 49: cloud.v1.RedisService.DecrBy:input_type -> cloud.v1.DecrByRequest
+	14,  // 50: cloud.v1.RedisService.IncrByFloat:input_type -> cloud.v1.IncrByFloatRequest
+	16,  // 51: cloud.v1.RedisService.Expire:input_type -> cloud.v1.ExpireRequest
+	18,  // 52: cloud.v1.RedisService.ExpireAt:input_type -> cloud.v1.ExpireAtRequest
+	20,  // 53: cloud.v1.RedisService.PExpireAt:input_type -> cloud.v1.PExpireAtRequest
+	22,  // 54: cloud.v1.RedisService.Ttl:input_type -> cloud.v1.TtlRequest
+	24,  // 55: cloud.v1.RedisService.Pttl:input_type -> cloud.v1.PttlRequest
+	26,  // 56: cloud.v1.RedisService.Persist:input_type -> cloud.v1.PersistRequest
+	28,  // 57: cloud.v1.RedisService.HSet:input_type -> cloud.v1.HSetRequest
+	30,  // 58: cloud.v1.RedisService.HGet:input_type -> cloud.v1.HGetRequest
+	32,  // 59: cloud.v1.RedisService.HDel:input_type -> cloud.v1.HDelRequest
+	34,  // 60: cloud.v1.RedisService.HGetAll:input_type -> cloud.v1.HGetAllRequest
+	36,  // 61: cloud.v1.RedisService.HIncrBy:input_type -> cloud.v1.HIncrByRequest
+	38,  // 62: cloud.v1.RedisService.HScan:input_type -> cloud.v1.HScanRequest
+	40,  // 63: cloud.v1.RedisService.LPush:input_type -> cloud.v1.LPushRequest
+	42,  // 64: cloud.v1.RedisService.RPush:input_type -> cloud.v1.RPushRequest
+	44,  // 65: cloud.v1.RedisService.LPop:input_type -> cloud.v1.LPopRequest
+	46,  // 66: cloud.v1.RedisService.RPop:input_type -> cloud.v1.RPopRequest
+	48,  // 67: cloud.v1.RedisService.LRange:input_type -> cloud.v1.LRangeRequest
+	50,  // 68: cloud.v1.RedisService.LLen:input_type -> cloud.v1.LLenRequest
+	52,  // 69: cloud.v1.RedisService.LTrim:input_type -> cloud.v1.LTrimRequest
+	54,  // 70: cloud.v1.RedisService.LIndex:input_type -> cloud.v1.LIndexRequest
+	56,  // 71: cloud.v1.RedisService.BLPop:input_type -> cloud.v1.BLPopRequest
+	58,  // 72: cloud.v1.RedisService.BRPop:input_type -> cloud.v1.BRPopRequest
+	60,  // 73: cloud.v1.RedisService.SAdd:input_type -> cloud.v1.SAddRequest
+	62,  // 74: cloud.v1.RedisService.SRem:input_type -> cloud.v1.SRemRequest
+	64,  // 75: cloud.v1.RedisService.SIsMember:input_type -> cloud.v1.SIsMemberRequest
+	66,  // 76: cloud.v1.RedisService.SMembers:input_type -> cloud.v1.SMembersRequest
+	68,  // 77: cloud.v1.RedisService.SCard:input_type -> cloud.v1.SCardRequest
+	70,  // 78: cloud.v1.RedisService.SPop:input_type -> cloud.v1.SPopRequest
+	72,  // 79: cloud.v1.RedisService.SRandMember:input_type -> cloud.v1.SRandMemberRequest
+	74,  // 80: cloud.v1.RedisService.SInter:input_type -> cloud.v1.SInterRequest
+	76,  // 81: cloud.v1.RedisService.SUnion:input_type -> cloud.v1.SUnionRequest
+	78,  // 82: cloud.v1.RedisService.SDiff:input_type -> cloud.v1.SDiffRequest
+	80,  // 83: cloud.v1.RedisService.SInterStore:input_type -> cloud.v1.SInterStoreRequest
+	82,  // 84: cloud.v1.RedisService.SUnionStore:input_type -> cloud.v1.SUnionStoreRequest
+	84,  // 85: cloud.v1.RedisService.SDiffStore:input_type -> cloud.v1.SDiffStoreRequest
+	87,  // 86: cloud.v1.RedisService.ZAdd:input_type -> cloud.v1.ZAddRequest
+	89,  // 87: cloud.v1.RedisService.ZRem:input_type -> cloud.v1.ZRemRequest
+	91,  // 88: cloud.v1.RedisService.ZScore:input_type -> cloud.v1.ZScoreRequest
+	93,  // 89: cloud.v1.RedisService.ZRank:input_type -> cloud.v1.ZRankRequest
+	96,  // 90: cloud.v1.RedisService.ZRange:input_type -> cloud.v1.ZRangeRequest
+	98,  // 91: cloud.v1.RedisService.ZCount:input_type -> cloud.v1.ZCountRequest
+	100, // 92: cloud.v1.RedisService.ZPopMin:input_type -> cloud.v1.ZPopMinRequest
+	102, // 93: cloud.v1.RedisService.ZPopMax:input_type -> cloud.v1.ZPopMaxRequest
+	105, // 94: cloud.v1.RedisService.XAdd:input_type -> cloud.v1.XAddRequest
+	107, // 95: cloud.v1.RedisService.XRange:input_type -> cloud.v1.XRangeRequest
+	109, // 96: cloud.v1.RedisService.XRevRange:input_type -> cloud.v1.XRevRangeRequest
+	111, // 97: cloud.v1.RedisService.XLen:input_type -> cloud.v1.XLenRequest
+	113, // 98: cloud.v1.RedisService.XTrim:input_type -> cloud.v1.XTrimRequest
+	115, // 99: cloud.v1.RedisService.XGroupCreate:input_type -> cloud.v1.XGroupCreateRequest
+	117, // 100: cloud.v1.RedisService.XReadGroup:input_type -> cloud.v1.XReadGroupRequest
+	119, // 101: cloud.v1.RedisService.XAck:input_type -> cloud.v1.XAckRequest
+	121, // 102: cloud.v1.RedisService.XPending:input_type -> cloud.v1.XPendingRequest
+	124, // 103: cloud.v1.RedisService.XClaim:input_type -> cloud.v1.XClaimRequest
+	126, // 104: cloud.v1.RedisService.SetBit:input_type -> cloud.v1.SetBitRequest
+	128, // 105: cloud.v1.RedisService.GetBit:input_type -> cloud.v1.GetBitRequest
+	130, // 106: cloud.v1.RedisService.BitCount:input_type -> cloud.v1.BitCountRequest
+	132, // 107: cloud.v1.RedisService.BitPos:input_type -> cloud.v1.BitPosRequest
+	134, // 108: cloud.v1.RedisService.BitOp:input_type -> cloud.v1.BitOpRequest
+	136, // 109: cloud.v1.RedisService.PFAdd:input_type -> cloud.v1.PFAddRequest
+	138, // 110: cloud.v1.RedisService.PFCount:input_type -> cloud.v1.PFCountRequest
+	140, // 111: cloud.v1.RedisService.PFMerge:input_type -> cloud.v1.PFMergeRequest
+	144, // 112: cloud.v1.RedisService.GeoAdd:input_type -> cloud.v1.GeoAddRequest
+	146, // 113: cloud.v1.RedisService.GeoDist:input_type -> cloud.v1.GeoDistRequest
+	149, // 114: cloud.v1.RedisService.GeoSearch:input_type -> cloud.v1.GeoSearchRequest
+	152, // 115: cloud.v1.RedisService.JSONSet:input_type -> cloud.v1.JSONSetRequest
+	154, // 116: cloud.v1.RedisService.JSONGet:input_type -> cloud.v1.JSONGetRequest
+	156, // 117: cloud.v1.RedisService.JSONDel:input_type -> cloud.v1.JSONDelRequest
+	158, // 118: cloud.v1.RedisService.JSONNumIncrBy:input_type -> cloud.v1.JSONNumIncrByRequest
+	160, // 119: cloud.v1.RedisService.JSONArrAppend:input_type -> cloud.v1.JSONArrAppendRequest
+	162, // 120: cloud.v1.RedisService.BFReserve:input_type -> cloud.v1.BFReserveRequest
+	164, // 121: cloud.v1.RedisService.BFAdd:input_type -> cloud.v1.BFAddRequest
+	166, // 122: cloud.v1.RedisService.BFExists:input_type -> cloud.v1.BFExistsRequest
+	168, // 123: cloud.v1.RedisService.CFAdd:input_type -> cloud.v1.CFAddRequest
+	170, // 124: cloud.v1.RedisService.CFDel:input_type -> cloud.v1.CFDelRequest
+	172, // 125: cloud.v1.RedisService.CFExists:input_type -> cloud.v1.CFExistsRequest
+	174, // 126: cloud.v1.RedisService.CMSInitByDim:input_type -> cloud.v1.CMSInitByDimRequest
+	177, // 127: cloud.v1.RedisService.CMSIncrBy:input_type -> cloud.v1.CMSIncrByRequest
+	179, // 128: cloud.v1.RedisService.CMSQuery:input_type -> cloud.v1.CMSQueryRequest
+	181, // 129: cloud.v1.RedisService.TopKReserve:input_type -> cloud.v1.TopKReserveRequest
+	183, // 130: cloud.v1.RedisService.TopKAdd:input_type -> cloud.v1.TopKAddRequest
+	185, // 131: cloud.v1.RedisService.TopKQuery:input_type -> cloud.v1.TopKQueryRequest
+	187, // 132: cloud.v1.RedisService.TopKList:input_type -> cloud.v1.TopKListRequest
+	190, // 133: cloud.v1.RedisService.TDigestCreate:input_type -> cloud.v1.TDigestCreateRequest
+	192, // 134: cloud.v1.RedisService.TDigestAdd:input_type -> cloud.v1.TDigestAddRequest
+	194, // 135: cloud.v1.RedisService.TDigestQuantile:input_type -> cloud.v1.TDigestQuantileRequest
+	196, // 136: cloud.v1.RedisService.TDigestCDF:input_type -> cloud.v1.TDigestCDFRequest
+	198, // 137: cloud.v1.RedisService.TSAdd:input_type -> cloud.v1.TSAddRequest
+	202, // 138: cloud.v1.RedisService.TSRange:input_type -> cloud.v1.TSRangeRequest
+	204, // 139: cloud.v1.RedisService.TSMRange:input_type -> cloud.v1.TSMRangeRequest
+	207, // 140: cloud.v1.RedisService.Append:input_type -> cloud.v1.AppendRequest
+	209, // 141: cloud.v1.RedisService.GetRange:input_type -> cloud.v1.GetRangeRequest
+	211, // 142: cloud.v1.RedisService.SetRange:input_type -> cloud.v1.SetRangeRequest
+	213, // 143: cloud.v1.RedisService.StrLen:input_type -> cloud.v1.StrLenRequest
+	215, // 144: cloud.v1.RedisService.GetDel:input_type -> cloud.v1.GetDelRequest
+	217, // 145: cloud.v1.RedisService.GetEx:input_type -> cloud.v1.GetExRequest
+	219, // 146: cloud.v1.RedisService.MGet:input_type -> cloud.v1.MGetRequest
+	223, // 147: cloud.v1.RedisService.MSet:input_type -> cloud.v1.MSetRequest
+	225, // 148: cloud.v1.RedisService.MSetNX:input_type -> cloud.v1.MSetNXRequest
+	227, // 149: cloud.v1.RedisService.Ping:input_type -> cloud.v1.PingRequest
+	229, // 150: cloud.v1.RedisService.Backup:input_type -> cloud.v1.BackupRequest
+	231, // 151: cloud.v1.RedisService.Restore:input_type -> cloud.v1.RestoreRequest
+	233, // 152: cloud.v1.RedisService.BackupStream:input_type -> cloud.v1.BackupStreamRequest
+	235, // 153: cloud.v1.RedisService.RestoreStream:input_type -> cloud.v1.RestoreStreamRequest
+	237, // 154: cloud.v1.RedisService.Join:input_type -> cloud.v1.JoinRequest
+	1,   // 155: cloud.v1.RedisService.Set:output_type -> cloud.v1.SetResponse
+	3,   // 156: cloud.v1.RedisService.Get:output_type -> cloud.v1.GetResponse
+	5,   // 157: cloud.v1.RedisService.Del:output_type -> cloud.v1.DelResponse
+	7,   // 158: cloud.v1.RedisService.Incr:output_type -> cloud.v1.IncrResponse
+	9,   // 159: cloud.v1.RedisService.IncrBy:output_type -> cloud.v1.IncrByResponse
+	11,  // 160: cloud.v1.RedisService.Decr:output_type -> cloud.v1.DecrResponse
+	13,  // 161: cloud.v1.RedisService.DecrBy:output_type -> cloud.v1.DecrByResponse
+	15,  // 162: cloud.v1.RedisService.IncrByFloat:output_type -> cloud.v1.IncrByFloatResponse
+	17,  // 163: cloud.v1.RedisService.Expire:output_type -> cloud.v1.ExpireResponse
+	19,  // 164: cloud.v1.RedisService.ExpireAt:output_type -> cloud.v1.ExpireAtResponse
+	21,  // 165: cloud.v1.RedisService.PExpireAt:output_type -> cloud.v1.PExpireAtResponse
+	23,  // 166: cloud.v1.RedisService.Ttl:output_type -> cloud.v1.TtlResponse
+	25,  // 167: cloud.v1.RedisService.Pttl:output_type -> cloud.v1.PttlResponse
+	27,  // 168: cloud.v1.RedisService.Persist:output_type -> cloud.v1.PersistResponse
+	29,  // 169: cloud.v1.RedisService.HSet:output_type -> cloud.v1.HSetResponse
+	31,  // 170: cloud.v1.RedisService.HGet:output_type -> cloud.v1.HGetResponse
+	33,  // 171: cloud.v1.RedisService.HDel:output_type -> cloud.v1.HDelResponse
+	35,  // 172: cloud.v1.RedisService.HGetAll:output_type -> cloud.v1.HGetAllResponse
+	37,  // 173: cloud.v1.RedisService.HIncrBy:output_type -> cloud.v1.HIncrByResponse
+	39,  // 174: cloud.v1.RedisService.HScan:output_type -> cloud.v1.HScanResponse
+	41,  // 175: cloud.v1.RedisService.LPush:output_type -> cloud.v1.LPushResponse
+	43,  // 176: cloud.v1.RedisService.RPush:output_type -> cloud.v1.RPushResponse
+	45,  // 177: cloud.v1.RedisService.LPop:output_type -> cloud.v1.LPopResponse
+	47,  // 178: cloud.v1.RedisService.RPop:output_type -> cloud.v1.RPopResponse
+	49,  // 179: cloud.v1.RedisService.LRange:output_type -> cloud.v1.LRangeResponse
+	51,  // 180: cloud.v1.RedisService.LLen:output_type -> cloud.v1.LLenResponse
+	53,  // 181: cloud.v1.RedisService.LTrim:output_type -> cloud.v1.LTrimResponse
+	55,  // 182: cloud.v1.RedisService.LIndex:output_type -> cloud.v1.LIndexResponse
+	57,  // 183: cloud.v1.RedisService.BLPop:output_type -> cloud.v1.BLPopResponse
+	59,  // 184: cloud.v1.RedisService.BRPop:output_type -> cloud.v1.BRPopResponse
+	61,  // 185: cloud.v1.RedisService.SAdd:output_type -> cloud.v1.SAddResponse
+	63,  // 186: cloud.v1.RedisService.SRem:output_type -> cloud.v1.SRemResponse
+	65,  // 187: cloud.v1.RedisService.SIsMember:output_type -> cloud.v1.SIsMemberResponse
+	67,  // 188: cloud.v1.RedisService.SMembers:output_type -> cloud.v1.SMembersResponse
+	69,  // 189: cloud.v1.RedisService.SCard:output_type -> cloud.v1.SCardResponse
+	71,  // 190: cloud.v1.RedisService.SPop:output_type -> cloud.v1.SPopResponse
+	73,  // 191: cloud.v1.RedisService.SRandMember:output_type -> cloud.v1.SRandMemberResponse
+	75,  // 192: cloud.v1.RedisService.SInter:output_type -> cloud.v1.SInterResponse
+	77,  // 193: cloud.v1.RedisService.SUnion:output_type -> cloud.v1.SUnionResponse
+	79,  // 194: cloud.v1.RedisService.SDiff:output_type -> cloud.v1.SDiffResponse
+	81,  // 195: cloud.v1.RedisService.SInterStore:output_type -> cloud.v1.SInterStoreResponse
+	83,  // 196: cloud.v1.RedisService.SUnionStore:output_type -> cloud.v1.SUnionStoreResponse
+	85,  // 197: cloud.v1.RedisService.SDiffStore:output_type -> cloud.v1.SDiffStoreResponse
+	88,  // 198: cloud.v1.RedisService.ZAdd:output_type -> cloud.v1.ZAddResponse
+	90,  // 199: cloud.v1.RedisService.ZRem:output_type -> cloud.v1.ZRemResponse
+	92,  // 200: cloud.v1.RedisService.ZScore:output_type -> cloud.v1.ZScoreResponse
+	94,  // 201: cloud.v1.RedisService.ZRank:output_type -> cloud.v1.ZRankResponse
+	97,  // 202: cloud.v1.RedisService.ZRange:output_type -> cloud.v1.ZRangeResponse
+	99,  // 203: cloud.v1.RedisService.ZCount:output_type -> cloud.v1.ZCountResponse
+	101, // 204: cloud.v1.RedisService.ZPopMin:output_type -> cloud.v1.ZPopMinResponse
+	103, // 205: cloud.v1.RedisService.ZPopMax:output_type -> cloud.v1.ZPopMaxResponse
+	106, // 206: cloud.v1.RedisService.XAdd:output_type -> cloud.v1.XAddResponse
+	108, // 207: cloud.v1.RedisService.XRange:output_type -> cloud.v1.XRangeResponse
+	110, // 208: cloud.v1.RedisService.XRevRange:output_type -> cloud.v1.XRevRangeResponse
+	112, // 209: cloud.v1.RedisService.XLen:output_type -> cloud.v1.XLenResponse
+	114, // 210: cloud.v1.RedisService.XTrim:output_type -> cloud.v1.XTrimResponse
+	116, // 211: cloud.v1.RedisService.XGroupCreate:output_type -> cloud.v1.XGroupCreateResponse
+	118, // 212: cloud.v1.RedisService.XReadGroup:output_type -> cloud.v1.XReadGroupResponse
+	120, // 213: cloud.v1.RedisService.XAck:output_type -> cloud.v1.XAckResponse
+	123, // 214: cloud.v1.RedisService.XPending:output_type -> cloud.v1.XPendingResponse
+	125, // 215: cloud.v1.RedisService.XClaim:output_type -> cloud.v1.XClaimResponse
+	127, // 216: cloud.v1.RedisService.SetBit:output_type -> cloud.v1.SetBitResponse
+	129, // 217: cloud.v1.RedisService.GetBit:output_type -> cloud.v1.GetBitResponse
+	131, // 218: cloud.v1.RedisService.BitCount:output_type -> cloud.v1.BitCountResponse
+	133, // 219: cloud.v1.RedisService.BitPos:output_type -> cloud.v1.BitPosResponse
+	135, // 220: cloud.v1.RedisService.BitOp:output_type -> cloud.v1.BitOpResponse
+	137, // 221: cloud.v1.RedisService.PFAdd:output_type -> cloud.v1.PFAddResponse
+	139, // 222: cloud.v1.RedisService.PFCount:output_type -> cloud.v1.PFCountResponse
+	141, // 223: cloud.v1.RedisService.PFMerge:output_type -> cloud.v1.PFMergeResponse
+	145, // 224: cloud.v1.RedisService.GeoAdd:output_type -> cloud.v1.GeoAddResponse
+	147, // 225: cloud.v1.RedisService.GeoDist:output_type -> cloud.v1.GeoDistResponse
+	151, // 226: cloud.v1.RedisService.GeoSearch:output_type -> cloud.v1.GeoSearchResponse
+	153, // 227: cloud.v1.RedisService.JSONSet:output_type -> cloud.v1.JSONSetResponse
+	155, // 228: cloud.v1.RedisService.JSONGet:output_type -> cloud.v1.JSONGetResponse
+	157, // 229: cloud.v1.RedisService.JSONDel:output_type -> cloud.v1.JSONDelResponse
+	159, // 230: cloud.v1.RedisService.JSONNumIncrBy:output_type -> cloud.v1.JSONNumIncrByResponse
+	161, // 231: cloud.v1.RedisService.JSONArrAppend:output_type -> cloud.v1.JSONArrAppendResponse
+	163, // 232: cloud.v1.RedisService.BFReserve:output_type -> cloud.v1.BFReserveResponse
+	165, // 233: cloud.v1.RedisService.BFAdd:output_type -> cloud.v1.BFAddResponse
+	167, // 234: cloud.v1.RedisService.BFExists:output_type -> cloud.v1.BFExistsResponse
+	169, // 235: cloud.v1.RedisService.CFAdd:output_type -> cloud.v1.CFAddResponse
+	171, // 236: cloud.v1.RedisService.CFDel:output_type -> cloud.v1.CFDelResponse
+	173, // 237: cloud.v1.RedisService.CFExists:output_type -> cloud.v1.CFExistsResponse
+	175, // 238: cloud.v1.RedisService.CMSInitByDim:output_type -> cloud.v1.CMSInitByDimResponse
+	178, // 239: cloud.v1.RedisService.CMSIncrBy:output_type -> cloud.v1.CMSIncrByResponse
+	180, // 240: cloud.v1.RedisService.CMSQuery:output_type -> cloud.v1.CMSQueryResponse
+	182, // 241: cloud.v1.RedisService.TopKReserve:output_type -> cloud.v1.TopKReserveResponse
+	184, // 242: cloud.v1.RedisService.TopKAdd:output_type -> cloud.v1.TopKAddResponse
+	186, // 243: cloud.v1.RedisService.TopKQuery:output_type -> cloud.v1.TopKQueryResponse
+	189, // 244: cloud.v1.RedisService.TopKList:output_type -> cloud.v1.TopKListResponse
+	191, // 245: cloud.v1.RedisService.TDigestCreate:output_type -> cloud.v1.TDigestCreateResponse
+	193, // 246: cloud.v1.RedisService.TDigestAdd:output_type -> cloud.v1.TDigestAddResponse
+	195, // 247: cloud.v1.RedisService.TDigestQuantile:output_type -> cloud.v1.TDigestQuantileResponse
+	197, // 248: cloud.v1.RedisService.TDigestCDF:output_type -> cloud.v1.TDigestCDFResponse
+	199, // 249: cloud.v1.RedisService.TSAdd:output_type -> cloud.v1.TSAddResponse
+	203, // 250: cloud.v1.RedisService.TSRange:output_type -> cloud.v1.TSRangeResponse
+	206, // 251: cloud.v1.RedisService.TSMRange:output_type -> cloud.v1.TSMRangeResponse
+	208, // 252: cloud.v1.RedisService.Append:output_type -> cloud.v1.AppendResponse
+	210, // 253: cloud.v1.RedisService.GetRange:output_type -> cloud.v1.GetRangeResponse
+	212, // 254: cloud.v1.RedisService.SetRange:output_type -> cloud.v1.SetRangeResponse
+	214, // 255: cloud.v1.RedisService.StrLen:output_type -> cloud.v1.StrLenResponse
+	216, // 256: cloud.v1.RedisService.GetDel:output_type -> cloud.v1.GetDelResponse
+	218, // 257: cloud.v1.RedisService.GetEx:output_type -> cloud.v1.GetExResponse
+	221, // 258: cloud.v1.RedisService.MGet:output_type -> cloud.v1.MGetResponse
+	224, // 259: cloud.v1.RedisService.MSet:output_type -> cloud.v1.MSetResponse
+	226, // 260: cloud.v1.RedisService.MSetNX:output_type -> cloud.v1.MSetNXResponse
+	228, // 261: cloud.v1.RedisService.Ping:output_type -> cloud.v1.PingResponse
+	230, // 262: cloud.v1.RedisService.Backup:output_type -> cloud.v1.BackupResponse
+	232, // 263: cloud.v1.RedisService.Restore:output_type -> cloud.v1.RestoreResponse
+	234, // 264: cloud.v1.RedisService.BackupStream:output_type -> cloud.v1.BackupStreamResponse
+	236, // 265: cloud.v1.RedisService.RestoreStream:output_type -> cloud.v1.RestoreStreamResponse
+	238, // 266: cloud.v1.RedisService.Join:output_type -> cloud.v1.JoinResponse
+	155, // [155:267] is the sub-list for method output_type
+	43,  // [43:155] is the sub-list for method input_type
+	43,  // [43:43] is the sub-list for extension type_name
+	43,  // [43:43] is the sub-list for extension extendee
+	0,   // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_cloud_v1_cloud_proto_init() }
+func file_cloud_v1_cloud_proto_init() {
+	if File_cloud_v1_cloud_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cloud_v1_cloud_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*SetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*DelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*IncrRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*IncrResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*IncrByRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*IncrByResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*DecrRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*DecrResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*DecrByRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*DecrByResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*IncrByFloatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*IncrByFloatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ExpireRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ExpireResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ExpireAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ExpireAtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*PExpireAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*PExpireAtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*TtlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*TtlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*PttlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*PttlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*PersistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*PersistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*HSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*HSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*HGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*HGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*HDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*HDelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*HGetAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*HGetAllResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*HIncrByRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*HIncrByResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*HScanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*HScanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*LPushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*LPushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*RPushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*RPushResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*LPopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*LPopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*RPopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*RPopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*LRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*LRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*LLenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*LLenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*LTrimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*LTrimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*LIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*LIndexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*BLPopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*BLPopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*BRPopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*BRPopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*SAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*SAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*SRemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*SRemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*SIsMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*SIsMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*SMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*SMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*SCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*SCardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*SPopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*SPopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*SRandMemberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*SRandMemberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*SInterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*SInterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*SUnionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*SUnionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*SDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*SDiffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*SInterStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*SInterStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*SUnionStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*SUnionStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*SDiffStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*SDiffStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*ZMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*ZAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*ZAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*ZRemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*ZRemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*ZScoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*ZScoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*ZRankRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*ZRankResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*ZRangeLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*ZRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*ZRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*ZCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*ZCountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*ZPopMinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*ZPopMinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*ZPopMaxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*ZPopMaxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*XAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*XAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*XRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*XRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*XRevRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*XRevRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*XLenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*XLenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*XTrimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[114].Exporter = func(v any, i int) any {
+			switch v := v.(*XTrimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*XGroupCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*XGroupCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*XReadGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[118].Exporter = func(v any, i int) any {
+			switch v := v.(*XReadGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[119].Exporter = func(v any, i int) any {
+			switch v := v.(*XAckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[120].Exporter = func(v any, i int) any {
+			switch v := v.(*XAckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[121].Exporter = func(v any, i int) any {
+			switch v := v.(*XPendingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[122].Exporter = func(v any, i int) any {
+			switch v := v.(*PendingEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[123].Exporter = func(v any, i int) any {
+			switch v := v.(*XPendingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[124].Exporter = func(v any, i int) any {
+			switch v := v.(*XClaimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[125].Exporter = func(v any, i int) any {
+			switch v := v.(*XClaimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[126].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[127].Exporter = func(v any, i int) any {
+			switch v := v.(*SetBitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[128].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[129].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[130].Exporter = func(v any, i int) any {
+			switch v := v.(*BitCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[131].Exporter = func(v any, i int) any {
+			switch v := v.(*BitCountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[132].Exporter = func(v any, i int) any {
+			switch v := v.(*BitPosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[133].Exporter = func(v any, i int) any {
+			switch v := v.(*BitPosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[134].Exporter = func(v any, i int) any {
+			switch v := v.(*BitOpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[135].Exporter = func(v any, i int) any {
+			switch v := v.(*BitOpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[136].Exporter = func(v any, i int) any {
+			switch v := v.(*PFAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[137].Exporter = func(v any, i int) any {
+			switch v := v.(*PFAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[138].Exporter = func(v any, i int) any {
+			switch v := v.(*PFCountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[139].Exporter = func(v any, i int) any {
+			switch v := v.(*PFCountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[140].Exporter = func(v any, i int) any {
+			switch v := v.(*PFMergeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[141].Exporter = func(v any, i int) any {
+			switch v := v.(*PFMergeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[142].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[143].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[144].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[145].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[146].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoDistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[147].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoDistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[148].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoBox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[149].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[150].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[151].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[152].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[153].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[154].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[155].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[156].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[157].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONDelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[158].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONNumIncrByRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[159].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONNumIncrByResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[160].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONArrAppendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[161].Exporter = func(v any, i int) any {
+			switch v := v.(*JSONArrAppendResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[162].Exporter = func(v any, i int) any {
+			switch v := v.(*BFReserveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[163].Exporter = func(v any, i int) any {
+			switch v := v.(*BFReserveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[164].Exporter = func(v any, i int) any {
+			switch v := v.(*BFAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[165].Exporter = func(v any, i int) any {
+			switch v := v.(*BFAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[166].Exporter = func(v any, i int) any {
+			switch v := v.(*BFExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[167].Exporter = func(v any, i int) any {
+			switch v := v.(*BFExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[168].Exporter = func(v any, i int) any {
+			switch v := v.(*CFAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[169].Exporter = func(v any, i int) any {
+			switch v := v.(*CFAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[170].Exporter = func(v any, i int) any {
+			switch v := v.(*CFDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[171].Exporter = func(v any, i int) any {
+			switch v := v.(*CFDelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[172].Exporter = func(v any, i int) any {
+			switch v := v.(*CFExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[173].Exporter = func(v any, i int) any {
+			switch v := v.(*CFExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[174].Exporter = func(v any, i int) any {
+			switch v := v.(*CMSInitByDimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[175].Exporter = func(v any, i int) any {
+			switch v := v.(*CMSInitByDimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[176].Exporter = func(v any, i int) any {
+			switch v := v.(*CMSIncrement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[177].Exporter = func(v any, i int) any {
+			switch v := v.(*CMSIncrByRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[178].Exporter = func(v any, i int) any {
+			switch v := v.(*CMSIncrByResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[179].Exporter = func(v any, i int) any {
+			switch v := v.(*CMSQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[180].Exporter = func(v any, i int) any {
+			switch v := v.(*CMSQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[181].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKReserveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[182].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKReserveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[183].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[184].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[185].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[186].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKQueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[187].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[188].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[189].Exporter = func(v any, i int) any {
+			switch v := v.(*TopKListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[190].Exporter = func(v any, i int) any {
+			switch v := v.(*TDigestCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[191].Exporter = func(v any, i int) any {
+			switch v := v.(*TDigestCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[192].Exporter = func(v any, i int) any {
+			switch v := v.(*TDigestAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[193].Exporter = func(v any, i int) any {
+			switch v := v.(*TDigestAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[194].Exporter = func(v any, i int) any {
+			switch v := v.(*TDigestQuantileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[195].Exporter = func(v any, i int) any {
+			switch v := v.(*TDigestQuantileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[196].Exporter = func(v any, i int) any {
+			switch v := v.(*TDigestCDFRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[197].Exporter = func(v any, i int) any {
+			switch v := v.(*TDigestCDFResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[198].Exporter = func(v any, i int) any {
+			switch v := v.(*TSAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[199].Exporter = func(v any, i int) any {
+			switch v := v.(*TSAddResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1150,8 +18121,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*SetResponse); i {
+		file_cloud_v1_cloud_proto_msgTypes[200].Exporter = func(v any, i int) any {
+			switch v := v.(*TSAggregation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1162,8 +18133,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetRequest); i {
+		file_cloud_v1_cloud_proto_msgTypes[201].Exporter = func(v any, i int) any {
+			switch v := v.(*TSSample); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1174,8 +18145,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetResponse); i {
+		file_cloud_v1_cloud_proto_msgTypes[202].Exporter = func(v any, i int) any {
+			switch v := v.(*TSRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1186,8 +18157,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*DelRequest); i {
+		file_cloud_v1_cloud_proto_msgTypes[203].Exporter = func(v any, i int) any {
+			switch v := v.(*TSRangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1198,8 +18169,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*DelResponse); i {
+		file_cloud_v1_cloud_proto_msgTypes[204].Exporter = func(v any, i int) any {
+			switch v := v.(*TSMRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1210,8 +18181,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*IncrRequest); i {
+		file_cloud_v1_cloud_proto_msgTypes[205].Exporter = func(v any, i int) any {
+			switch v := v.(*TSSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1222,8 +18193,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*IncrResponse); i {
+		file_cloud_v1_cloud_proto_msgTypes[206].Exporter = func(v any, i int) any {
+			switch v := v.(*TSMRangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1234,8 +18205,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*ExpireRequest); i {
+		file_cloud_v1_cloud_proto_msgTypes[207].Exporter = func(v any, i int) any {
+			switch v := v.(*AppendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1246,8 +18217,8 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ExpireResponse); i {
+		file_cloud_v1_cloud_proto_msgTypes[208].Exporter = func(v any, i int) any {
+			switch v := v.(*AppendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1258,7 +18229,223 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_cloud_v1_cloud_proto_msgTypes[209].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[210].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[211].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[212].Exporter = func(v any, i int) any {
+			switch v := v.(*SetRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[213].Exporter = func(v any, i int) any {
+			switch v := v.(*StrLenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[214].Exporter = func(v any, i int) any {
+			switch v := v.(*StrLenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[215].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[216].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[217].Exporter = func(v any, i int) any {
+			switch v := v.(*GetExRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[218].Exporter = func(v any, i int) any {
+			switch v := v.(*GetExResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[219].Exporter = func(v any, i int) any {
+			switch v := v.(*MGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[220].Exporter = func(v any, i int) any {
+			switch v := v.(*MGetValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[221].Exporter = func(v any, i int) any {
+			switch v := v.(*MGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[222].Exporter = func(v any, i int) any {
+			switch v := v.(*KeyValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[223].Exporter = func(v any, i int) any {
+			switch v := v.(*MSetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[224].Exporter = func(v any, i int) any {
+			switch v := v.(*MSetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[225].Exporter = func(v any, i int) any {
+			switch v := v.(*MSetNXRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[226].Exporter = func(v any, i int) any {
+			switch v := v.(*MSetNXResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[227].Exporter = func(v any, i int) any {
 			switch v := v.(*PingRequest); i {
 			case 0:
 				return &v.state
@@ -1270,7 +18457,7 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_cloud_v1_cloud_proto_msgTypes[228].Exporter = func(v any, i int) any {
 			switch v := v.(*PingResponse); i {
 			case 0:
 				return &v.state
@@ -1282,7 +18469,7 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_cloud_v1_cloud_proto_msgTypes[229].Exporter = func(v any, i int) any {
 			switch v := v.(*BackupRequest); i {
 			case 0:
 				return &v.state
@@ -1294,7 +18481,7 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_cloud_v1_cloud_proto_msgTypes[230].Exporter = func(v any, i int) any {
 			switch v := v.(*BackupResponse); i {
 			case 0:
 				return &v.state
@@ -1306,7 +18493,7 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_cloud_v1_cloud_proto_msgTypes[231].Exporter = func(v any, i int) any {
 			switch v := v.(*RestoreRequest); i {
 			case 0:
 				return &v.state
@@ -1318,7 +18505,7 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_cloud_v1_cloud_proto_msgTypes[232].Exporter = func(v any, i int) any {
 			switch v := v.(*RestoreResponse); i {
 			case 0:
 				return &v.state
@@ -1330,7 +18517,55 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_cloud_v1_cloud_proto_msgTypes[233].Exporter = func(v any, i int) any {
+			switch v := v.(*BackupStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[234].Exporter = func(v any, i int) any {
+			switch v := v.(*BackupStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[235].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[236].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cloud_v1_cloud_proto_msgTypes[237].Exporter = func(v any, i int) any {
 			switch v := v.(*JoinRequest); i {
 			case 0:
 				return &v.state
@@ -1342,7 +18577,7 @@ func file_cloud_v1_cloud_proto_init() {
 				return nil
 			}
 		}
-		file_cloud_v1_cloud_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_cloud_v1_cloud_proto_msgTypes[238].Exporter = func(v any, i int) any {
 			switch v := v.(*JoinResponse); i {
 			case 0:
 				return &v.state
@@ -1355,13 +18590,43 @@ func file_cloud_v1_cloud_proto_init() {
 			}
 		}
 	}
+	file_cloud_v1_cloud_proto_msgTypes[0].OneofWrappers = []any{
+		(*SetRequest_Ttl)(nil),
+		(*SetRequest_UnixTimeSeconds)(nil),
+		(*SetRequest_UnixTimeMilliseconds)(nil),
+		(*SetRequest_KeepTtl)(nil),
+	}
+	file_cloud_v1_cloud_proto_msgTypes[1].OneofWrappers = []any{}
+	file_cloud_v1_cloud_proto_msgTypes[88].OneofWrappers = []any{}
+	file_cloud_v1_cloud_proto_msgTypes[105].OneofWrappers = []any{}
+	file_cloud_v1_cloud_proto_msgTypes[113].OneofWrappers = []any{
+		(*XTrimRequest_MaxLen)(nil),
+		(*XTrimRequest_MinId)(nil),
+	}
+	file_cloud_v1_cloud_proto_msgTypes[130].OneofWrappers = []any{}
+	file_cloud_v1_cloud_proto_msgTypes[132].OneofWrappers = []any{}
+	file_cloud_v1_cloud_proto_msgTypes[149].OneofWrappers = []any{
+		(*GeoSearchRequest_FromMember)(nil),
+		(*GeoSearchRequest_FromPoint)(nil),
+		(*GeoSearchRequest_ByRadius)(nil),
+		(*GeoSearchRequest_ByBox)(nil),
+	}
+	file_cloud_v1_cloud_proto_msgTypes[198].OneofWrappers = []any{}
+	file_cloud_v1_cloud_proto_msgTypes[202].OneofWrappers = []any{}
+	file_cloud_v1_cloud_proto_msgTypes[204].OneofWrappers = []any{}
+	file_cloud_v1_cloud_proto_msgTypes[217].OneofWrappers = []any{
+		(*GetExRequest_Ttl)(nil),
+		(*GetExRequest_UnixTimeSeconds)(nil),
+		(*GetExRequest_UnixTimeMilliseconds)(nil),
+		(*GetExRequest_Persist)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cloud_v1_cloud_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   247,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
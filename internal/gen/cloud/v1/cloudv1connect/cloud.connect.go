@@ -41,14 +41,233 @@ const (
 	RedisServiceDelProcedure = "/cloud.v1.RedisService/Del"
 	// RedisServiceIncrProcedure is the fully-qualified name of the RedisService's Incr RPC.
 	RedisServiceIncrProcedure = "/cloud.v1.RedisService/Incr"
+	// RedisServiceIncrByProcedure is the fully-qualified name of the RedisService's IncrBy RPC.
+	RedisServiceIncrByProcedure = "/cloud.v1.RedisService/IncrBy"
+	// RedisServiceDecrProcedure is the fully-qualified name of the RedisService's Decr RPC.
+	RedisServiceDecrProcedure = "/cloud.v1.RedisService/Decr"
+	// RedisServiceDecrByProcedure is the fully-qualified name of the RedisService's DecrBy RPC.
+	RedisServiceDecrByProcedure = "/cloud.v1.RedisService/DecrBy"
+	// RedisServiceIncrByFloatProcedure is the fully-qualified name of the RedisService's IncrByFloat
+	// RPC.
+	RedisServiceIncrByFloatProcedure = "/cloud.v1.RedisService/IncrByFloat"
 	// RedisServiceExpireProcedure is the fully-qualified name of the RedisService's Expire RPC.
 	RedisServiceExpireProcedure = "/cloud.v1.RedisService/Expire"
+	// RedisServiceExpireAtProcedure is the fully-qualified name of the RedisService's ExpireAt RPC.
+	RedisServiceExpireAtProcedure = "/cloud.v1.RedisService/ExpireAt"
+	// RedisServicePExpireAtProcedure is the fully-qualified name of the RedisService's PExpireAt RPC.
+	RedisServicePExpireAtProcedure = "/cloud.v1.RedisService/PExpireAt"
+	// RedisServiceTtlProcedure is the fully-qualified name of the RedisService's Ttl RPC.
+	RedisServiceTtlProcedure = "/cloud.v1.RedisService/Ttl"
+	// RedisServicePttlProcedure is the fully-qualified name of the RedisService's Pttl RPC.
+	RedisServicePttlProcedure = "/cloud.v1.RedisService/Pttl"
+	// RedisServicePersistProcedure is the fully-qualified name of the RedisService's Persist RPC.
+	RedisServicePersistProcedure = "/cloud.v1.RedisService/Persist"
+	// RedisServiceHSetProcedure is the fully-qualified name of the RedisService's HSet RPC.
+	RedisServiceHSetProcedure = "/cloud.v1.RedisService/HSet"
+	// RedisServiceHGetProcedure is the fully-qualified name of the RedisService's HGet RPC.
+	RedisServiceHGetProcedure = "/cloud.v1.RedisService/HGet"
+	// RedisServiceHDelProcedure is the fully-qualified name of the RedisService's HDel RPC.
+	RedisServiceHDelProcedure = "/cloud.v1.RedisService/HDel"
+	// RedisServiceHGetAllProcedure is the fully-qualified name of the RedisService's HGetAll RPC.
+	RedisServiceHGetAllProcedure = "/cloud.v1.RedisService/HGetAll"
+	// RedisServiceHIncrByProcedure is the fully-qualified name of the RedisService's HIncrBy RPC.
+	RedisServiceHIncrByProcedure = "/cloud.v1.RedisService/HIncrBy"
+	// RedisServiceHScanProcedure is the fully-qualified name of the RedisService's HScan RPC.
+	RedisServiceHScanProcedure = "/cloud.v1.RedisService/HScan"
+	// RedisServiceLPushProcedure is the fully-qualified name of the RedisService's LPush RPC.
+	RedisServiceLPushProcedure = "/cloud.v1.RedisService/LPush"
+	// RedisServiceRPushProcedure is the fully-qualified name of the RedisService's RPush RPC.
+	RedisServiceRPushProcedure = "/cloud.v1.RedisService/RPush"
+	// RedisServiceLPopProcedure is the fully-qualified name of the RedisService's LPop RPC.
+	RedisServiceLPopProcedure = "/cloud.v1.RedisService/LPop"
+	// RedisServiceRPopProcedure is the fully-qualified name of the RedisService's RPop RPC.
+	RedisServiceRPopProcedure = "/cloud.v1.RedisService/RPop"
+	// RedisServiceLRangeProcedure is the fully-qualified name of the RedisService's LRange RPC.
+	RedisServiceLRangeProcedure = "/cloud.v1.RedisService/LRange"
+	// RedisServiceLLenProcedure is the fully-qualified name of the RedisService's LLen RPC.
+	RedisServiceLLenProcedure = "/cloud.v1.RedisService/LLen"
+	// RedisServiceLTrimProcedure is the fully-qualified name of the RedisService's LTrim RPC.
+	RedisServiceLTrimProcedure = "/cloud.v1.RedisService/LTrim"
+	// RedisServiceLIndexProcedure is the fully-qualified name of the RedisService's LIndex RPC.
+	RedisServiceLIndexProcedure = "/cloud.v1.RedisService/LIndex"
+	// RedisServiceBLPopProcedure is the fully-qualified name of the RedisService's BLPop RPC.
+	RedisServiceBLPopProcedure = "/cloud.v1.RedisService/BLPop"
+	// RedisServiceBRPopProcedure is the fully-qualified name of the RedisService's BRPop RPC.
+	RedisServiceBRPopProcedure = "/cloud.v1.RedisService/BRPop"
+	// RedisServiceSAddProcedure is the fully-qualified name of the RedisService's SAdd RPC.
+	RedisServiceSAddProcedure = "/cloud.v1.RedisService/SAdd"
+	// RedisServiceSRemProcedure is the fully-qualified name of the RedisService's SRem RPC.
+	RedisServiceSRemProcedure = "/cloud.v1.RedisService/SRem"
+	// RedisServiceSIsMemberProcedure is the fully-qualified name of the RedisService's SIsMember RPC.
+	RedisServiceSIsMemberProcedure = "/cloud.v1.RedisService/SIsMember"
+	// RedisServiceSMembersProcedure is the fully-qualified name of the RedisService's SMembers RPC.
+	RedisServiceSMembersProcedure = "/cloud.v1.RedisService/SMembers"
+	// RedisServiceSCardProcedure is the fully-qualified name of the RedisService's SCard RPC.
+	RedisServiceSCardProcedure = "/cloud.v1.RedisService/SCard"
+	// RedisServiceSPopProcedure is the fully-qualified name of the RedisService's SPop RPC.
+	RedisServiceSPopProcedure = "/cloud.v1.RedisService/SPop"
+	// RedisServiceSRandMemberProcedure is the fully-qualified name of the RedisService's SRandMember
+	// RPC.
+	RedisServiceSRandMemberProcedure = "/cloud.v1.RedisService/SRandMember"
+	// RedisServiceSInterProcedure is the fully-qualified name of the RedisService's SInter RPC.
+	RedisServiceSInterProcedure = "/cloud.v1.RedisService/SInter"
+	// RedisServiceSUnionProcedure is the fully-qualified name of the RedisService's SUnion RPC.
+	RedisServiceSUnionProcedure = "/cloud.v1.RedisService/SUnion"
+	// RedisServiceSDiffProcedure is the fully-qualified name of the RedisService's SDiff RPC.
+	RedisServiceSDiffProcedure = "/cloud.v1.RedisService/SDiff"
+	// RedisServiceSInterStoreProcedure is the fully-qualified name of the RedisService's SInterStore
+	// RPC.
+	RedisServiceSInterStoreProcedure = "/cloud.v1.RedisService/SInterStore"
+	// RedisServiceSUnionStoreProcedure is the fully-qualified name of the RedisService's SUnionStore
+	// RPC.
+	RedisServiceSUnionStoreProcedure = "/cloud.v1.RedisService/SUnionStore"
+	// RedisServiceSDiffStoreProcedure is the fully-qualified name of the RedisService's SDiffStore RPC.
+	RedisServiceSDiffStoreProcedure = "/cloud.v1.RedisService/SDiffStore"
+	// RedisServiceZAddProcedure is the fully-qualified name of the RedisService's ZAdd RPC.
+	RedisServiceZAddProcedure = "/cloud.v1.RedisService/ZAdd"
+	// RedisServiceZRemProcedure is the fully-qualified name of the RedisService's ZRem RPC.
+	RedisServiceZRemProcedure = "/cloud.v1.RedisService/ZRem"
+	// RedisServiceZScoreProcedure is the fully-qualified name of the RedisService's ZScore RPC.
+	RedisServiceZScoreProcedure = "/cloud.v1.RedisService/ZScore"
+	// RedisServiceZRankProcedure is the fully-qualified name of the RedisService's ZRank RPC.
+	RedisServiceZRankProcedure = "/cloud.v1.RedisService/ZRank"
+	// RedisServiceZRangeProcedure is the fully-qualified name of the RedisService's ZRange RPC.
+	RedisServiceZRangeProcedure = "/cloud.v1.RedisService/ZRange"
+	// RedisServiceZCountProcedure is the fully-qualified name of the RedisService's ZCount RPC.
+	RedisServiceZCountProcedure = "/cloud.v1.RedisService/ZCount"
+	// RedisServiceZPopMinProcedure is the fully-qualified name of the RedisService's ZPopMin RPC.
+	RedisServiceZPopMinProcedure = "/cloud.v1.RedisService/ZPopMin"
+	// RedisServiceZPopMaxProcedure is the fully-qualified name of the RedisService's ZPopMax RPC.
+	RedisServiceZPopMaxProcedure = "/cloud.v1.RedisService/ZPopMax"
+	// RedisServiceXAddProcedure is the fully-qualified name of the RedisService's XAdd RPC.
+	RedisServiceXAddProcedure = "/cloud.v1.RedisService/XAdd"
+	// RedisServiceXRangeProcedure is the fully-qualified name of the RedisService's XRange RPC.
+	RedisServiceXRangeProcedure = "/cloud.v1.RedisService/XRange"
+	// RedisServiceXRevRangeProcedure is the fully-qualified name of the RedisService's XRevRange RPC.
+	RedisServiceXRevRangeProcedure = "/cloud.v1.RedisService/XRevRange"
+	// RedisServiceXLenProcedure is the fully-qualified name of the RedisService's XLen RPC.
+	RedisServiceXLenProcedure = "/cloud.v1.RedisService/XLen"
+	// RedisServiceXTrimProcedure is the fully-qualified name of the RedisService's XTrim RPC.
+	RedisServiceXTrimProcedure = "/cloud.v1.RedisService/XTrim"
+	// RedisServiceXGroupCreateProcedure is the fully-qualified name of the RedisService's XGroupCreate
+	// RPC.
+	RedisServiceXGroupCreateProcedure = "/cloud.v1.RedisService/XGroupCreate"
+	// RedisServiceXReadGroupProcedure is the fully-qualified name of the RedisService's XReadGroup RPC.
+	RedisServiceXReadGroupProcedure = "/cloud.v1.RedisService/XReadGroup"
+	// RedisServiceXAckProcedure is the fully-qualified name of the RedisService's XAck RPC.
+	RedisServiceXAckProcedure = "/cloud.v1.RedisService/XAck"
+	// RedisServiceXPendingProcedure is the fully-qualified name of the RedisService's XPending RPC.
+	RedisServiceXPendingProcedure = "/cloud.v1.RedisService/XPending"
+	// RedisServiceXClaimProcedure is the fully-qualified name of the RedisService's XClaim RPC.
+	RedisServiceXClaimProcedure = "/cloud.v1.RedisService/XClaim"
+	// RedisServiceSetBitProcedure is the fully-qualified name of the RedisService's SetBit RPC.
+	RedisServiceSetBitProcedure = "/cloud.v1.RedisService/SetBit"
+	// RedisServiceGetBitProcedure is the fully-qualified name of the RedisService's GetBit RPC.
+	RedisServiceGetBitProcedure = "/cloud.v1.RedisService/GetBit"
+	// RedisServiceBitCountProcedure is the fully-qualified name of the RedisService's BitCount RPC.
+	RedisServiceBitCountProcedure = "/cloud.v1.RedisService/BitCount"
+	// RedisServiceBitPosProcedure is the fully-qualified name of the RedisService's BitPos RPC.
+	RedisServiceBitPosProcedure = "/cloud.v1.RedisService/BitPos"
+	// RedisServiceBitOpProcedure is the fully-qualified name of the RedisService's BitOp RPC.
+	RedisServiceBitOpProcedure = "/cloud.v1.RedisService/BitOp"
+	// RedisServicePFAddProcedure is the fully-qualified name of the RedisService's PFAdd RPC.
+	RedisServicePFAddProcedure = "/cloud.v1.RedisService/PFAdd"
+	// RedisServicePFCountProcedure is the fully-qualified name of the RedisService's PFCount RPC.
+	RedisServicePFCountProcedure = "/cloud.v1.RedisService/PFCount"
+	// RedisServicePFMergeProcedure is the fully-qualified name of the RedisService's PFMerge RPC.
+	RedisServicePFMergeProcedure = "/cloud.v1.RedisService/PFMerge"
+	// RedisServiceGeoAddProcedure is the fully-qualified name of the RedisService's GeoAdd RPC.
+	RedisServiceGeoAddProcedure = "/cloud.v1.RedisService/GeoAdd"
+	// RedisServiceGeoDistProcedure is the fully-qualified name of the RedisService's GeoDist RPC.
+	RedisServiceGeoDistProcedure = "/cloud.v1.RedisService/GeoDist"
+	// RedisServiceGeoSearchProcedure is the fully-qualified name of the RedisService's GeoSearch RPC.
+	RedisServiceGeoSearchProcedure = "/cloud.v1.RedisService/GeoSearch"
+	// RedisServiceJSONSetProcedure is the fully-qualified name of the RedisService's JSONSet RPC.
+	RedisServiceJSONSetProcedure = "/cloud.v1.RedisService/JSONSet"
+	// RedisServiceJSONGetProcedure is the fully-qualified name of the RedisService's JSONGet RPC.
+	RedisServiceJSONGetProcedure = "/cloud.v1.RedisService/JSONGet"
+	// RedisServiceJSONDelProcedure is the fully-qualified name of the RedisService's JSONDel RPC.
+	RedisServiceJSONDelProcedure = "/cloud.v1.RedisService/JSONDel"
+	// RedisServiceJSONNumIncrByProcedure is the fully-qualified name of the RedisService's
+	// JSONNumIncrBy RPC.
+	RedisServiceJSONNumIncrByProcedure = "/cloud.v1.RedisService/JSONNumIncrBy"
+	// RedisServiceJSONArrAppendProcedure is the fully-qualified name of the RedisService's
+	// JSONArrAppend RPC.
+	RedisServiceJSONArrAppendProcedure = "/cloud.v1.RedisService/JSONArrAppend"
+	// RedisServiceBFReserveProcedure is the fully-qualified name of the RedisService's BFReserve RPC.
+	RedisServiceBFReserveProcedure = "/cloud.v1.RedisService/BFReserve"
+	// RedisServiceBFAddProcedure is the fully-qualified name of the RedisService's BFAdd RPC.
+	RedisServiceBFAddProcedure = "/cloud.v1.RedisService/BFAdd"
+	// RedisServiceBFExistsProcedure is the fully-qualified name of the RedisService's BFExists RPC.
+	RedisServiceBFExistsProcedure = "/cloud.v1.RedisService/BFExists"
+	// RedisServiceCFAddProcedure is the fully-qualified name of the RedisService's CFAdd RPC.
+	RedisServiceCFAddProcedure = "/cloud.v1.RedisService/CFAdd"
+	// RedisServiceCFDelProcedure is the fully-qualified name of the RedisService's CFDel RPC.
+	RedisServiceCFDelProcedure = "/cloud.v1.RedisService/CFDel"
+	// RedisServiceCFExistsProcedure is the fully-qualified name of the RedisService's CFExists RPC.
+	RedisServiceCFExistsProcedure = "/cloud.v1.RedisService/CFExists"
+	// RedisServiceCMSInitByDimProcedure is the fully-qualified name of the RedisService's CMSInitByDim
+	// RPC.
+	RedisServiceCMSInitByDimProcedure = "/cloud.v1.RedisService/CMSInitByDim"
+	// RedisServiceCMSIncrByProcedure is the fully-qualified name of the RedisService's CMSIncrBy RPC.
+	RedisServiceCMSIncrByProcedure = "/cloud.v1.RedisService/CMSIncrBy"
+	// RedisServiceCMSQueryProcedure is the fully-qualified name of the RedisService's CMSQuery RPC.
+	RedisServiceCMSQueryProcedure = "/cloud.v1.RedisService/CMSQuery"
+	// RedisServiceTopKReserveProcedure is the fully-qualified name of the RedisService's TopKReserve
+	// RPC.
+	RedisServiceTopKReserveProcedure = "/cloud.v1.RedisService/TopKReserve"
+	// RedisServiceTopKAddProcedure is the fully-qualified name of the RedisService's TopKAdd RPC.
+	RedisServiceTopKAddProcedure = "/cloud.v1.RedisService/TopKAdd"
+	// RedisServiceTopKQueryProcedure is the fully-qualified name of the RedisService's TopKQuery RPC.
+	RedisServiceTopKQueryProcedure = "/cloud.v1.RedisService/TopKQuery"
+	// RedisServiceTopKListProcedure is the fully-qualified name of the RedisService's TopKList RPC.
+	RedisServiceTopKListProcedure = "/cloud.v1.RedisService/TopKList"
+	// RedisServiceTDigestCreateProcedure is the fully-qualified name of the RedisService's
+	// TDigestCreate RPC.
+	RedisServiceTDigestCreateProcedure = "/cloud.v1.RedisService/TDigestCreate"
+	// RedisServiceTDigestAddProcedure is the fully-qualified name of the RedisService's TDigestAdd RPC.
+	RedisServiceTDigestAddProcedure = "/cloud.v1.RedisService/TDigestAdd"
+	// RedisServiceTDigestQuantileProcedure is the fully-qualified name of the RedisService's
+	// TDigestQuantile RPC.
+	RedisServiceTDigestQuantileProcedure = "/cloud.v1.RedisService/TDigestQuantile"
+	// RedisServiceTDigestCDFProcedure is the fully-qualified name of the RedisService's TDigestCDF RPC.
+	RedisServiceTDigestCDFProcedure = "/cloud.v1.RedisService/TDigestCDF"
+	// RedisServiceTSAddProcedure is the fully-qualified name of the RedisService's TSAdd RPC.
+	RedisServiceTSAddProcedure = "/cloud.v1.RedisService/TSAdd"
+	// RedisServiceTSRangeProcedure is the fully-qualified name of the RedisService's TSRange RPC.
+	RedisServiceTSRangeProcedure = "/cloud.v1.RedisService/TSRange"
+	// RedisServiceTSMRangeProcedure is the fully-qualified name of the RedisService's TSMRange RPC.
+	RedisServiceTSMRangeProcedure = "/cloud.v1.RedisService/TSMRange"
+	// RedisServiceAppendProcedure is the fully-qualified name of the RedisService's Append RPC.
+	RedisServiceAppendProcedure = "/cloud.v1.RedisService/Append"
+	// RedisServiceGetRangeProcedure is the fully-qualified name of the RedisService's GetRange RPC.
+	RedisServiceGetRangeProcedure = "/cloud.v1.RedisService/GetRange"
+	// RedisServiceSetRangeProcedure is the fully-qualified name of the RedisService's SetRange RPC.
+	RedisServiceSetRangeProcedure = "/cloud.v1.RedisService/SetRange"
+	// RedisServiceStrLenProcedure is the fully-qualified name of the RedisService's StrLen RPC.
+	RedisServiceStrLenProcedure = "/cloud.v1.RedisService/StrLen"
+	// RedisServiceGetDelProcedure is the fully-qualified name of the RedisService's GetDel RPC.
+	RedisServiceGetDelProcedure = "/cloud.v1.RedisService/GetDel"
+	// RedisServiceGetExProcedure is the fully-qualified name of the RedisService's GetEx RPC.
+	RedisServiceGetExProcedure = "/cloud.v1.RedisService/GetEx"
+	// RedisServiceMGetProcedure is the fully-qualified name of the RedisService's MGet RPC.
+	RedisServiceMGetProcedure = "/cloud.v1.RedisService/MGet"
+	// RedisServiceMSetProcedure is the fully-qualified name of the RedisService's MSet RPC.
+	RedisServiceMSetProcedure = "/cloud.v1.RedisService/MSet"
+	// RedisServiceMSetNXProcedure is the fully-qualified name of the RedisService's MSetNX RPC.
+	RedisServiceMSetNXProcedure = "/cloud.v1.RedisService/MSetNX"
 	// RedisServicePingProcedure is the fully-qualified name of the RedisService's Ping RPC.
 	RedisServicePingProcedure = "/cloud.v1.RedisService/Ping"
 	// RedisServiceBackupProcedure is the fully-qualified name of the RedisService's Backup RPC.
 	RedisServiceBackupProcedure = "/cloud.v1.RedisService/Backup"
 	// RedisServiceRestoreProcedure is the fully-qualified name of the RedisService's Restore RPC.
 	RedisServiceRestoreProcedure = "/cloud.v1.RedisService/Restore"
+	// RedisServiceBackupStreamProcedure is the fully-qualified name of the RedisService's BackupStream
+	// RPC.
+	RedisServiceBackupStreamProcedure = "/cloud.v1.RedisService/BackupStream"
+	// RedisServiceRestoreStreamProcedure is the fully-qualified name of the RedisService's
+	// RestoreStream RPC.
+	RedisServiceRestoreStreamProcedure = "/cloud.v1.RedisService/RestoreStream"
 	// RedisServiceJoinProcedure is the fully-qualified name of the RedisService's Join RPC.
 	RedisServiceJoinProcedure = "/cloud.v1.RedisService/Join"
 )
@@ -63,14 +282,223 @@ type RedisServiceClient interface {
 	Del(context.Context, *connect.Request[v1.DelRequest]) (*connect.Response[v1.DelResponse], error)
 	// Incr increments the integer value of a key
 	Incr(context.Context, *connect.Request[v1.IncrRequest]) (*connect.Response[v1.IncrResponse], error)
+	// IncrBy increments the integer value of a key by the given amount
+	IncrBy(context.Context, *connect.Request[v1.IncrByRequest]) (*connect.Response[v1.IncrByResponse], error)
+	// Decr decrements the integer value of a key
+	Decr(context.Context, *connect.Request[v1.DecrRequest]) (*connect.Response[v1.DecrResponse], error)
+	// DecrBy decrements the integer value of a key by the given amount
+	DecrBy(context.Context, *connect.Request[v1.DecrByRequest]) (*connect.Response[v1.DecrByResponse], error)
+	// IncrByFloat increments the floating point value of a key by the given amount
+	IncrByFloat(context.Context, *connect.Request[v1.IncrByFloatRequest]) (*connect.Response[v1.IncrByFloatResponse], error)
 	// Expire sets a timeout on a key
 	Expire(context.Context, *connect.Request[v1.ExpireRequest]) (*connect.Response[v1.ExpireResponse], error)
+	// ExpireAt sets the Unix time in seconds at which a key expires
+	ExpireAt(context.Context, *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error)
+	// PExpireAt sets the Unix time in milliseconds at which a key expires
+	PExpireAt(context.Context, *connect.Request[v1.PExpireAtRequest]) (*connect.Response[v1.PExpireAtResponse], error)
+	// Ttl returns the remaining time to live of a key in seconds
+	Ttl(context.Context, *connect.Request[v1.TtlRequest]) (*connect.Response[v1.TtlResponse], error)
+	// Pttl returns the remaining time to live of a key in milliseconds
+	Pttl(context.Context, *connect.Request[v1.PttlRequest]) (*connect.Response[v1.PttlResponse], error)
+	// Persist removes the timeout from a key
+	Persist(context.Context, *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error)
+	// HSet sets fields of a hash
+	HSet(context.Context, *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error)
+	// HGet retrieves the value of a hash field
+	HGet(context.Context, *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error)
+	// HDel deletes fields from a hash
+	HDel(context.Context, *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error)
+	// HGetAll retrieves all fields and values of a hash
+	HGetAll(context.Context, *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error)
+	// HIncrBy increments the integer value of a hash field by the given amount
+	HIncrBy(context.Context, *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error)
+	// HScan incrementally iterates over the fields of a hash
+	HScan(context.Context, *connect.Request[v1.HScanRequest]) (*connect.Response[v1.HScanResponse], error)
+	// LPush inserts values at the head of a list
+	LPush(context.Context, *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error)
+	// RPush inserts values at the tail of a list
+	RPush(context.Context, *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error)
+	// LPop removes and returns elements from the head of a list
+	LPop(context.Context, *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error)
+	// RPop removes and returns elements from the tail of a list
+	RPop(context.Context, *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error)
+	// LRange retrieves a range of elements from a list
+	LRange(context.Context, *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error)
+	// LLen returns the length of a list
+	LLen(context.Context, *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error)
+	// LTrim trims a list to the given range
+	LTrim(context.Context, *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error)
+	// LIndex retrieves an element from a list by its index
+	LIndex(context.Context, *connect.Request[v1.LIndexRequest]) (*connect.Response[v1.LIndexResponse], error)
+	// BLPop removes and returns the head of the first non-empty list, waiting until one is available
+	BLPop(context.Context, *connect.Request[v1.BLPopRequest]) (*connect.Response[v1.BLPopResponse], error)
+	// BRPop removes and returns the tail of the first non-empty list, waiting until one is available
+	BRPop(context.Context, *connect.Request[v1.BRPopRequest]) (*connect.Response[v1.BRPopResponse], error)
+	// SAdd adds members to a set
+	SAdd(context.Context, *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error)
+	// SRem removes members from a set
+	SRem(context.Context, *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error)
+	// SIsMember checks whether a member belongs to a set
+	SIsMember(context.Context, *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error)
+	// SMembers retrieves all members of a set
+	SMembers(context.Context, *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error)
+	// SCard returns the number of members of a set
+	SCard(context.Context, *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error)
+	// SPop removes and returns random members of a set
+	SPop(context.Context, *connect.Request[v1.SPopRequest]) (*connect.Response[v1.SPopResponse], error)
+	// SRandMember returns random members of a set without removing them
+	SRandMember(context.Context, *connect.Request[v1.SRandMemberRequest]) (*connect.Response[v1.SRandMemberResponse], error)
+	// SInter returns the intersection of sets
+	SInter(context.Context, *connect.Request[v1.SInterRequest]) (*connect.Response[v1.SInterResponse], error)
+	// SUnion returns the union of sets
+	SUnion(context.Context, *connect.Request[v1.SUnionRequest]) (*connect.Response[v1.SUnionResponse], error)
+	// SDiff returns the difference between the first set and the others
+	SDiff(context.Context, *connect.Request[v1.SDiffRequest]) (*connect.Response[v1.SDiffResponse], error)
+	// SInterStore stores the intersection of sets in a destination key
+	SInterStore(context.Context, *connect.Request[v1.SInterStoreRequest]) (*connect.Response[v1.SInterStoreResponse], error)
+	// SUnionStore stores the union of sets in a destination key
+	SUnionStore(context.Context, *connect.Request[v1.SUnionStoreRequest]) (*connect.Response[v1.SUnionStoreResponse], error)
+	// SDiffStore stores the difference of sets in a destination key
+	SDiffStore(context.Context, *connect.Request[v1.SDiffStoreRequest]) (*connect.Response[v1.SDiffStoreResponse], error)
+	// ZAdd adds members to a sorted set or updates their scores
+	ZAdd(context.Context, *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error)
+	// ZRem removes members from a sorted set
+	ZRem(context.Context, *connect.Request[v1.ZRemRequest]) (*connect.Response[v1.ZRemResponse], error)
+	// ZScore retrieves the score of a sorted set member
+	ZScore(context.Context, *connect.Request[v1.ZScoreRequest]) (*connect.Response[v1.ZScoreResponse], error)
+	// ZRank retrieves the rank of a sorted set member
+	ZRank(context.Context, *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error)
+	// ZRange retrieves sorted set members by rank, score or lex range
+	ZRange(context.Context, *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error)
+	// ZCount counts the sorted set members within a score range
+	ZCount(context.Context, *connect.Request[v1.ZCountRequest]) (*connect.Response[v1.ZCountResponse], error)
+	// ZPopMin removes and returns the members with the lowest scores
+	ZPopMin(context.Context, *connect.Request[v1.ZPopMinRequest]) (*connect.Response[v1.ZPopMinResponse], error)
+	// ZPopMax removes and returns the members with the highest scores
+	ZPopMax(context.Context, *connect.Request[v1.ZPopMaxRequest]) (*connect.Response[v1.ZPopMaxResponse], error)
+	// XAdd appends an entry to a stream
+	XAdd(context.Context, *connect.Request[v1.XAddRequest]) (*connect.Response[v1.XAddResponse], error)
+	// XRange retrieves stream entries within an ID range
+	XRange(context.Context, *connect.Request[v1.XRangeRequest]) (*connect.Response[v1.XRangeResponse], error)
+	// XRevRange retrieves stream entries within an ID range, newest first
+	XRevRange(context.Context, *connect.Request[v1.XRevRangeRequest]) (*connect.Response[v1.XRevRangeResponse], error)
+	// XLen returns the number of entries in a stream
+	XLen(context.Context, *connect.Request[v1.XLenRequest]) (*connect.Response[v1.XLenResponse], error)
+	// XTrim removes the oldest entries of a stream
+	XTrim(context.Context, *connect.Request[v1.XTrimRequest]) (*connect.Response[v1.XTrimResponse], error)
+	// XGroupCreate creates a consumer group on a stream
+	XGroupCreate(context.Context, *connect.Request[v1.XGroupCreateRequest]) (*connect.Response[v1.XGroupCreateResponse], error)
+	// XReadGroup reads stream entries on behalf of a consumer of a group
+	XReadGroup(context.Context, *connect.Request[v1.XReadGroupRequest]) (*connect.Response[v1.XReadGroupResponse], error)
+	// XAck acknowledges entries delivered to a consumer group
+	XAck(context.Context, *connect.Request[v1.XAckRequest]) (*connect.Response[v1.XAckResponse], error)
+	// XPending inspects the pending entries of a consumer group
+	XPending(context.Context, *connect.Request[v1.XPendingRequest]) (*connect.Response[v1.XPendingResponse], error)
+	// XClaim transfers idle pending entries to another consumer
+	XClaim(context.Context, *connect.Request[v1.XClaimRequest]) (*connect.Response[v1.XClaimResponse], error)
+	// SetBit sets or clears a bit of a string value
+	SetBit(context.Context, *connect.Request[v1.SetBitRequest]) (*connect.Response[v1.SetBitResponse], error)
+	// GetBit retrieves a bit of a string value
+	GetBit(context.Context, *connect.Request[v1.GetBitRequest]) (*connect.Response[v1.GetBitResponse], error)
+	// BitCount counts the set bits of a string value
+	BitCount(context.Context, *connect.Request[v1.BitCountRequest]) (*connect.Response[v1.BitCountResponse], error)
+	// BitPos finds the first set or clear bit of a string value
+	BitPos(context.Context, *connect.Request[v1.BitPosRequest]) (*connect.Response[v1.BitPosResponse], error)
+	// BitOp stores a bitwise operation between string values in a destination key
+	BitOp(context.Context, *connect.Request[v1.BitOpRequest]) (*connect.Response[v1.BitOpResponse], error)
+	// PFAdd adds elements to a HyperLogLog
+	PFAdd(context.Context, *connect.Request[v1.PFAddRequest]) (*connect.Response[v1.PFAddResponse], error)
+	// PFCount estimates the number of distinct elements in HyperLogLogs
+	PFCount(context.Context, *connect.Request[v1.PFCountRequest]) (*connect.Response[v1.PFCountResponse], error)
+	// PFMerge merges HyperLogLogs into a destination key
+	PFMerge(context.Context, *connect.Request[v1.PFMergeRequest]) (*connect.Response[v1.PFMergeResponse], error)
+	// GeoAdd adds members with coordinates to a geo index
+	GeoAdd(context.Context, *connect.Request[v1.GeoAddRequest]) (*connect.Response[v1.GeoAddResponse], error)
+	// GeoDist returns the distance between two members of a geo index
+	GeoDist(context.Context, *connect.Request[v1.GeoDistRequest]) (*connect.Response[v1.GeoDistResponse], error)
+	// GeoSearch finds the members of a geo index within a radius or box
+	GeoSearch(context.Context, *connect.Request[v1.GeoSearchRequest]) (*connect.Response[v1.GeoSearchResponse], error)
+	// JSONSet sets a value at a path in a JSON document
+	JSONSet(context.Context, *connect.Request[v1.JSONSetRequest]) (*connect.Response[v1.JSONSetResponse], error)
+	// JSONGet retrieves the values at paths in a JSON document
+	JSONGet(context.Context, *connect.Request[v1.JSONGetRequest]) (*connect.Response[v1.JSONGetResponse], error)
+	// JSONDel deletes the values at a path in a JSON document
+	JSONDel(context.Context, *connect.Request[v1.JSONDelRequest]) (*connect.Response[v1.JSONDelResponse], error)
+	// JSONNumIncrBy increments the numbers at a path in a JSON document
+	JSONNumIncrBy(context.Context, *connect.Request[v1.JSONNumIncrByRequest]) (*connect.Response[v1.JSONNumIncrByResponse], error)
+	// JSONArrAppend appends values to the arrays at a path in a JSON document
+	JSONArrAppend(context.Context, *connect.Request[v1.JSONArrAppendRequest]) (*connect.Response[v1.JSONArrAppendResponse], error)
+	// BFReserve creates an empty Bloom filter
+	BFReserve(context.Context, *connect.Request[v1.BFReserveRequest]) (*connect.Response[v1.BFReserveResponse], error)
+	// BFAdd adds items to a Bloom filter
+	BFAdd(context.Context, *connect.Request[v1.BFAddRequest]) (*connect.Response[v1.BFAddResponse], error)
+	// BFExists checks whether items may be in a Bloom filter
+	BFExists(context.Context, *connect.Request[v1.BFExistsRequest]) (*connect.Response[v1.BFExistsResponse], error)
+	// CFAdd adds items to a Cuckoo filter
+	CFAdd(context.Context, *connect.Request[v1.CFAddRequest]) (*connect.Response[v1.CFAddResponse], error)
+	// CFDel deletes an item from a Cuckoo filter
+	CFDel(context.Context, *connect.Request[v1.CFDelRequest]) (*connect.Response[v1.CFDelResponse], error)
+	// CFExists checks whether items may be in a Cuckoo filter
+	CFExists(context.Context, *connect.Request[v1.CFExistsRequest]) (*connect.Response[v1.CFExistsResponse], error)
+	// CMSInitByDim creates a Count-Min Sketch with the given dimensions
+	CMSInitByDim(context.Context, *connect.Request[v1.CMSInitByDimRequest]) (*connect.Response[v1.CMSInitByDimResponse], error)
+	// CMSIncrBy increments the counts of items in a Count-Min Sketch
+	CMSIncrBy(context.Context, *connect.Request[v1.CMSIncrByRequest]) (*connect.Response[v1.CMSIncrByResponse], error)
+	// CMSQuery returns the estimated counts of items in a Count-Min Sketch
+	CMSQuery(context.Context, *connect.Request[v1.CMSQueryRequest]) (*connect.Response[v1.CMSQueryResponse], error)
+	// TopKReserve creates an empty Top-K sketch
+	TopKReserve(context.Context, *connect.Request[v1.TopKReserveRequest]) (*connect.Response[v1.TopKReserveResponse], error)
+	// TopKAdd adds items to a Top-K sketch
+	TopKAdd(context.Context, *connect.Request[v1.TopKAddRequest]) (*connect.Response[v1.TopKAddResponse], error)
+	// TopKQuery checks whether items are among the top k of a sketch
+	TopKQuery(context.Context, *connect.Request[v1.TopKQueryRequest]) (*connect.Response[v1.TopKQueryResponse], error)
+	// TopKList returns the top k items of a sketch
+	TopKList(context.Context, *connect.Request[v1.TopKListRequest]) (*connect.Response[v1.TopKListResponse], error)
+	// TDigestCreate creates an empty t-digest
+	TDigestCreate(context.Context, *connect.Request[v1.TDigestCreateRequest]) (*connect.Response[v1.TDigestCreateResponse], error)
+	// TDigestAdd adds samples to a t-digest
+	TDigestAdd(context.Context, *connect.Request[v1.TDigestAddRequest]) (*connect.Response[v1.TDigestAddResponse], error)
+	// TDigestQuantile returns the estimated values at quantiles of a t-digest
+	TDigestQuantile(context.Context, *connect.Request[v1.TDigestQuantileRequest]) (*connect.Response[v1.TDigestQuantileResponse], error)
+	// TDigestCDF returns the estimated fractions of samples at most the given values
+	TDigestCDF(context.Context, *connect.Request[v1.TDigestCDFRequest]) (*connect.Response[v1.TDigestCDFResponse], error)
+	// TSAdd adds a sample to a time series
+	TSAdd(context.Context, *connect.Request[v1.TSAddRequest]) (*connect.Response[v1.TSAddResponse], error)
+	// TSRange returns the samples of a time series within a time range
+	TSRange(context.Context, *connect.Request[v1.TSRangeRequest]) (*connect.Response[v1.TSRangeResponse], error)
+	// TSMRange returns the samples within a time range of the time series matching label filters
+	TSMRange(context.Context, *connect.Request[v1.TSMRangeRequest]) (*connect.Response[v1.TSMRangeResponse], error)
+	// Append appends a value to a string
+	Append(context.Context, *connect.Request[v1.AppendRequest]) (*connect.Response[v1.AppendResponse], error)
+	// GetRange returns a substring of a string
+	GetRange(context.Context, *connect.Request[v1.GetRangeRequest]) (*connect.Response[v1.GetRangeResponse], error)
+	// SetRange overwrites part of a string starting at an offset
+	SetRange(context.Context, *connect.Request[v1.SetRangeRequest]) (*connect.Response[v1.SetRangeResponse], error)
+	// StrLen returns the length of a string
+	StrLen(context.Context, *connect.Request[v1.StrLenRequest]) (*connect.Response[v1.StrLenResponse], error)
+	// GetDel returns the value of a key and deletes it
+	GetDel(context.Context, *connect.Request[v1.GetDelRequest]) (*connect.Response[v1.GetDelResponse], error)
+	// GetEx returns the value of a key and updates its timeout
+	GetEx(context.Context, *connect.Request[v1.GetExRequest]) (*connect.Response[v1.GetExResponse], error)
+	// MGet returns the values of several keys
+	MGet(context.Context, *connect.Request[v1.MGetRequest]) (*connect.Response[v1.MGetResponse], error)
+	// MSet sets several keys at once
+	MSet(context.Context, *connect.Request[v1.MSetRequest]) (*connect.Response[v1.MSetResponse], error)
+	// MSetNX sets several keys at once if none of them exists
+	MSetNX(context.Context, *connect.Request[v1.MSetNXRequest]) (*connect.Response[v1.MSetNXResponse], error)
 	// Ping checks if the server is responsive
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
 	// Backup creates a backup of the current dataset
 	Backup(context.Context, *connect.Request[v1.BackupRequest]) (*connect.Response[v1.BackupResponse], error)
-	// Restore rebuilds the dataset from a backup file
+	// Restore rebuilds the dataset from a backup file. It is a disaster recovery
+	// operation: the leader commits ahead of its followers until they install
+	// the backup, so it should only be used on a fresh cluster.
 	Restore(context.Context, *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error)
+	// BackupStream creates a backup and streams it to the client in chunks
+	BackupStream(context.Context, *connect.Request[v1.BackupStreamRequest]) (*connect.ServerStreamForClient[v1.BackupStreamResponse], error)
+	// RestoreStream rebuilds the dataset from a backup streamed by the client in chunks.
+	// Like Restore, it is a disaster recovery operation for a fresh cluster.
+	RestoreStream(context.Context) *connect.ClientStreamForClient[v1.RestoreStreamRequest, v1.RestoreStreamResponse]
 	// Join adds a new node to the cluster
 	Join(context.Context, *connect.Request[v1.JoinRequest]) (*connect.Response[v1.JoinResponse], error)
 }
@@ -105,11 +533,516 @@ func NewRedisServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+RedisServiceIncrProcedure,
 			opts...,
 		),
+		incrBy: connect.NewClient[v1.IncrByRequest, v1.IncrByResponse](
+			httpClient,
+			baseURL+RedisServiceIncrByProcedure,
+			opts...,
+		),
+		decr: connect.NewClient[v1.DecrRequest, v1.DecrResponse](
+			httpClient,
+			baseURL+RedisServiceDecrProcedure,
+			opts...,
+		),
+		decrBy: connect.NewClient[v1.DecrByRequest, v1.DecrByResponse](
+			httpClient,
+			baseURL+RedisServiceDecrByProcedure,
+			opts...,
+		),
+		incrByFloat: connect.NewClient[v1.IncrByFloatRequest, v1.IncrByFloatResponse](
+			httpClient,
+			baseURL+RedisServiceIncrByFloatProcedure,
+			opts...,
+		),
 		expire: connect.NewClient[v1.ExpireRequest, v1.ExpireResponse](
 			httpClient,
 			baseURL+RedisServiceExpireProcedure,
 			opts...,
 		),
+		expireAt: connect.NewClient[v1.ExpireAtRequest, v1.ExpireAtResponse](
+			httpClient,
+			baseURL+RedisServiceExpireAtProcedure,
+			opts...,
+		),
+		pExpireAt: connect.NewClient[v1.PExpireAtRequest, v1.PExpireAtResponse](
+			httpClient,
+			baseURL+RedisServicePExpireAtProcedure,
+			opts...,
+		),
+		ttl: connect.NewClient[v1.TtlRequest, v1.TtlResponse](
+			httpClient,
+			baseURL+RedisServiceTtlProcedure,
+			opts...,
+		),
+		pttl: connect.NewClient[v1.PttlRequest, v1.PttlResponse](
+			httpClient,
+			baseURL+RedisServicePttlProcedure,
+			opts...,
+		),
+		persist: connect.NewClient[v1.PersistRequest, v1.PersistResponse](
+			httpClient,
+			baseURL+RedisServicePersistProcedure,
+			opts...,
+		),
+		hSet: connect.NewClient[v1.HSetRequest, v1.HSetResponse](
+			httpClient,
+			baseURL+RedisServiceHSetProcedure,
+			opts...,
+		),
+		hGet: connect.NewClient[v1.HGetRequest, v1.HGetResponse](
+			httpClient,
+			baseURL+RedisServiceHGetProcedure,
+			opts...,
+		),
+		hDel: connect.NewClient[v1.HDelRequest, v1.HDelResponse](
+			httpClient,
+			baseURL+RedisServiceHDelProcedure,
+			opts...,
+		),
+		hGetAll: connect.NewClient[v1.HGetAllRequest, v1.HGetAllResponse](
+			httpClient,
+			baseURL+RedisServiceHGetAllProcedure,
+			opts...,
+		),
+		hIncrBy: connect.NewClient[v1.HIncrByRequest, v1.HIncrByResponse](
+			httpClient,
+			baseURL+RedisServiceHIncrByProcedure,
+			opts...,
+		),
+		hScan: connect.NewClient[v1.HScanRequest, v1.HScanResponse](
+			httpClient,
+			baseURL+RedisServiceHScanProcedure,
+			opts...,
+		),
+		lPush: connect.NewClient[v1.LPushRequest, v1.LPushResponse](
+			httpClient,
+			baseURL+RedisServiceLPushProcedure,
+			opts...,
+		),
+		rPush: connect.NewClient[v1.RPushRequest, v1.RPushResponse](
+			httpClient,
+			baseURL+RedisServiceRPushProcedure,
+			opts...,
+		),
+		lPop: connect.NewClient[v1.LPopRequest, v1.LPopResponse](
+			httpClient,
+			baseURL+RedisServiceLPopProcedure,
+			opts...,
+		),
+		rPop: connect.NewClient[v1.RPopRequest, v1.RPopResponse](
+			httpClient,
+			baseURL+RedisServiceRPopProcedure,
+			opts...,
+		),
+		lRange: connect.NewClient[v1.LRangeRequest, v1.LRangeResponse](
+			httpClient,
+			baseURL+RedisServiceLRangeProcedure,
+			opts...,
+		),
+		lLen: connect.NewClient[v1.LLenRequest, v1.LLenResponse](
+			httpClient,
+			baseURL+RedisServiceLLenProcedure,
+			opts...,
+		),
+		lTrim: connect.NewClient[v1.LTrimRequest, v1.LTrimResponse](
+			httpClient,
+			baseURL+RedisServiceLTrimProcedure,
+			opts...,
+		),
+		lIndex: connect.NewClient[v1.LIndexRequest, v1.LIndexResponse](
+			httpClient,
+			baseURL+RedisServiceLIndexProcedure,
+			opts...,
+		),
+		bLPop: connect.NewClient[v1.BLPopRequest, v1.BLPopResponse](
+			httpClient,
+			baseURL+RedisServiceBLPopProcedure,
+			opts...,
+		),
+		bRPop: connect.NewClient[v1.BRPopRequest, v1.BRPopResponse](
+			httpClient,
+			baseURL+RedisServiceBRPopProcedure,
+			opts...,
+		),
+		sAdd: connect.NewClient[v1.SAddRequest, v1.SAddResponse](
+			httpClient,
+			baseURL+RedisServiceSAddProcedure,
+			opts...,
+		),
+		sRem: connect.NewClient[v1.SRemRequest, v1.SRemResponse](
+			httpClient,
+			baseURL+RedisServiceSRemProcedure,
+			opts...,
+		),
+		sIsMember: connect.NewClient[v1.SIsMemberRequest, v1.SIsMemberResponse](
+			httpClient,
+			baseURL+RedisServiceSIsMemberProcedure,
+			opts...,
+		),
+		sMembers: connect.NewClient[v1.SMembersRequest, v1.SMembersResponse](
+			httpClient,
+			baseURL+RedisServiceSMembersProcedure,
+			opts...,
+		),
+		sCard: connect.NewClient[v1.SCardRequest, v1.SCardResponse](
+			httpClient,
+			baseURL+RedisServiceSCardProcedure,
+			opts...,
+		),
+		sPop: connect.NewClient[v1.SPopRequest, v1.SPopResponse](
+			httpClient,
+			baseURL+RedisServiceSPopProcedure,
+			opts...,
+		),
+		sRandMember: connect.NewClient[v1.SRandMemberRequest, v1.SRandMemberResponse](
+			httpClient,
+			baseURL+RedisServiceSRandMemberProcedure,
+			opts...,
+		),
+		sInter: connect.NewClient[v1.SInterRequest, v1.SInterResponse](
+			httpClient,
+			baseURL+RedisServiceSInterProcedure,
+			opts...,
+		),
+		sUnion: connect.NewClient[v1.SUnionRequest, v1.SUnionResponse](
+			httpClient,
+			baseURL+RedisServiceSUnionProcedure,
+			opts...,
+		),
+		sDiff: connect.NewClient[v1.SDiffRequest, v1.SDiffResponse](
+			httpClient,
+			baseURL+RedisServiceSDiffProcedure,
+			opts...,
+		),
+		sInterStore: connect.NewClient[v1.SInterStoreRequest, v1.SInterStoreResponse](
+			httpClient,
+			baseURL+RedisServiceSInterStoreProcedure,
+			opts...,
+		),
+		sUnionStore: connect.NewClient[v1.SUnionStoreRequest, v1.SUnionStoreResponse](
+			httpClient,
+			baseURL+RedisServiceSUnionStoreProcedure,
+			opts...,
+		),
+		sDiffStore: connect.NewClient[v1.SDiffStoreRequest, v1.SDiffStoreResponse](
+			httpClient,
+			baseURL+RedisServiceSDiffStoreProcedure,
+			opts...,
+		),
+		zAdd: connect.NewClient[v1.ZAddRequest, v1.ZAddResponse](
+			httpClient,
+			baseURL+RedisServiceZAddProcedure,
+			opts...,
+		),
+		zRem: connect.NewClient[v1.ZRemRequest, v1.ZRemResponse](
+			httpClient,
+			baseURL+RedisServiceZRemProcedure,
+			opts...,
+		),
+		zScore: connect.NewClient[v1.ZScoreRequest, v1.ZScoreResponse](
+			httpClient,
+			baseURL+RedisServiceZScoreProcedure,
+			opts...,
+		),
+		zRank: connect.NewClient[v1.ZRankRequest, v1.ZRankResponse](
+			httpClient,
+			baseURL+RedisServiceZRankProcedure,
+			opts...,
+		),
+		zRange: connect.NewClient[v1.ZRangeRequest, v1.ZRangeResponse](
+			httpClient,
+			baseURL+RedisServiceZRangeProcedure,
+			opts...,
+		),
+		zCount: connect.NewClient[v1.ZCountRequest, v1.ZCountResponse](
+			httpClient,
+			baseURL+RedisServiceZCountProcedure,
+			opts...,
+		),
+		zPopMin: connect.NewClient[v1.ZPopMinRequest, v1.ZPopMinResponse](
+			httpClient,
+			baseURL+RedisServiceZPopMinProcedure,
+			opts...,
+		),
+		zPopMax: connect.NewClient[v1.ZPopMaxRequest, v1.ZPopMaxResponse](
+			httpClient,
+			baseURL+RedisServiceZPopMaxProcedure,
+			opts...,
+		),
+		xAdd: connect.NewClient[v1.XAddRequest, v1.XAddResponse](
+			httpClient,
+			baseURL+RedisServiceXAddProcedure,
+			opts...,
+		),
+		xRange: connect.NewClient[v1.XRangeRequest, v1.XRangeResponse](
+			httpClient,
+			baseURL+RedisServiceXRangeProcedure,
+			opts...,
+		),
+		xRevRange: connect.NewClient[v1.XRevRangeRequest, v1.XRevRangeResponse](
+			httpClient,
+			baseURL+RedisServiceXRevRangeProcedure,
+			opts...,
+		),
+		xLen: connect.NewClient[v1.XLenRequest, v1.XLenResponse](
+			httpClient,
+			baseURL+RedisServiceXLenProcedure,
+			opts...,
+		),
+		xTrim: connect.NewClient[v1.XTrimRequest, v1.XTrimResponse](
+			httpClient,
+			baseURL+RedisServiceXTrimProcedure,
+			opts...,
+		),
+		xGroupCreate: connect.NewClient[v1.XGroupCreateRequest, v1.XGroupCreateResponse](
+			httpClient,
+			baseURL+RedisServiceXGroupCreateProcedure,
+			opts...,
+		),
+		xReadGroup: connect.NewClient[v1.XReadGroupRequest, v1.XReadGroupResponse](
+			httpClient,
+			baseURL+RedisServiceXReadGroupProcedure,
+			opts...,
+		),
+		xAck: connect.NewClient[v1.XAckRequest, v1.XAckResponse](
+			httpClient,
+			baseURL+RedisServiceXAckProcedure,
+			opts...,
+		),
+		xPending: connect.NewClient[v1.XPendingRequest, v1.XPendingResponse](
+			httpClient,
+			baseURL+RedisServiceXPendingProcedure,
+			opts...,
+		),
+		xClaim: connect.NewClient[v1.XClaimRequest, v1.XClaimResponse](
+			httpClient,
+			baseURL+RedisServiceXClaimProcedure,
+			opts...,
+		),
+		setBit: connect.NewClient[v1.SetBitRequest, v1.SetBitResponse](
+			httpClient,
+			baseURL+RedisServiceSetBitProcedure,
+			opts...,
+		),
+		getBit: connect.NewClient[v1.GetBitRequest, v1.GetBitResponse](
+			httpClient,
+			baseURL+RedisServiceGetBitProcedure,
+			opts...,
+		),
+		bitCount: connect.NewClient[v1.BitCountRequest, v1.BitCountResponse](
+			httpClient,
+			baseURL+RedisServiceBitCountProcedure,
+			opts...,
+		),
+		bitPos: connect.NewClient[v1.BitPosRequest, v1.BitPosResponse](
+			httpClient,
+			baseURL+RedisServiceBitPosProcedure,
+			opts...,
+		),
+		bitOp: connect.NewClient[v1.BitOpRequest, v1.BitOpResponse](
+			httpClient,
+			baseURL+RedisServiceBitOpProcedure,
+			opts...,
+		),
+		pFAdd: connect.NewClient[v1.PFAddRequest, v1.PFAddResponse](
+			httpClient,
+			baseURL+RedisServicePFAddProcedure,
+			opts...,
+		),
+		pFCount: connect.NewClient[v1.PFCountRequest, v1.PFCountResponse](
+			httpClient,
+			baseURL+RedisServicePFCountProcedure,
+			opts...,
+		),
+		pFMerge: connect.NewClient[v1.PFMergeRequest, v1.PFMergeResponse](
+			httpClient,
+			baseURL+RedisServicePFMergeProcedure,
+			opts...,
+		),
+		geoAdd: connect.NewClient[v1.GeoAddRequest, v1.GeoAddResponse](
+			httpClient,
+			baseURL+RedisServiceGeoAddProcedure,
+			opts...,
+		),
+		geoDist: connect.NewClient[v1.GeoDistRequest, v1.GeoDistResponse](
+			httpClient,
+			baseURL+RedisServiceGeoDistProcedure,
+			opts...,
+		),
+		geoSearch: connect.NewClient[v1.GeoSearchRequest, v1.GeoSearchResponse](
+			httpClient,
+			baseURL+RedisServiceGeoSearchProcedure,
+			opts...,
+		),
+		jSONSet: connect.NewClient[v1.JSONSetRequest, v1.JSONSetResponse](
+			httpClient,
+			baseURL+RedisServiceJSONSetProcedure,
+			opts...,
+		),
+		jSONGet: connect.NewClient[v1.JSONGetRequest, v1.JSONGetResponse](
+			httpClient,
+			baseURL+RedisServiceJSONGetProcedure,
+			opts...,
+		),
+		jSONDel: connect.NewClient[v1.JSONDelRequest, v1.JSONDelResponse](
+			httpClient,
+			baseURL+RedisServiceJSONDelProcedure,
+			opts...,
+		),
+		jSONNumIncrBy: connect.NewClient[v1.JSONNumIncrByRequest, v1.JSONNumIncrByResponse](
+			httpClient,
+			baseURL+RedisServiceJSONNumIncrByProcedure,
+			opts...,
+		),
+		jSONArrAppend: connect.NewClient[v1.JSONArrAppendRequest, v1.JSONArrAppendResponse](
+			httpClient,
+			baseURL+RedisServiceJSONArrAppendProcedure,
+			opts...,
+		),
+		bFReserve: connect.NewClient[v1.BFReserveRequest, v1.BFReserveResponse](
+			httpClient,
+			baseURL+RedisServiceBFReserveProcedure,
+			opts...,
+		),
+		bFAdd: connect.NewClient[v1.BFAddRequest, v1.BFAddResponse](
+			httpClient,
+			baseURL+RedisServiceBFAddProcedure,
+			opts...,
+		),
+		bFExists: connect.NewClient[v1.BFExistsRequest, v1.BFExistsResponse](
+			httpClient,
+			baseURL+RedisServiceBFExistsProcedure,
+			opts...,
+		),
+		cFAdd: connect.NewClient[v1.CFAddRequest, v1.CFAddResponse](
+			httpClient,
+			baseURL+RedisServiceCFAddProcedure,
+			opts...,
+		),
+		cFDel: connect.NewClient[v1.CFDelRequest, v1.CFDelResponse](
+			httpClient,
+			baseURL+RedisServiceCFDelProcedure,
+			opts...,
+		),
+		cFExists: connect.NewClient[v1.CFExistsRequest, v1.CFExistsResponse](
+			httpClient,
+			baseURL+RedisServiceCFExistsProcedure,
+			opts...,
+		),
+		cMSInitByDim: connect.NewClient[v1.CMSInitByDimRequest, v1.CMSInitByDimResponse](
+			httpClient,
+			baseURL+RedisServiceCMSInitByDimProcedure,
+			opts...,
+		),
+		cMSIncrBy: connect.NewClient[v1.CMSIncrByRequest, v1.CMSIncrByResponse](
+			httpClient,
+			baseURL+RedisServiceCMSIncrByProcedure,
+			opts...,
+		),
+		cMSQuery: connect.NewClient[v1.CMSQueryRequest, v1.CMSQueryResponse](
+			httpClient,
+			baseURL+RedisServiceCMSQueryProcedure,
+			opts...,
+		),
+		topKReserve: connect.NewClient[v1.TopKReserveRequest, v1.TopKReserveResponse](
+			httpClient,
+			baseURL+RedisServiceTopKReserveProcedure,
+			opts...,
+		),
+		topKAdd: connect.NewClient[v1.TopKAddRequest, v1.TopKAddResponse](
+			httpClient,
+			baseURL+RedisServiceTopKAddProcedure,
+			opts...,
+		),
+		topKQuery: connect.NewClient[v1.TopKQueryRequest, v1.TopKQueryResponse](
+			httpClient,
+			baseURL+RedisServiceTopKQueryProcedure,
+			opts...,
+		),
+		topKList: connect.NewClient[v1.TopKListRequest, v1.TopKListResponse](
+			httpClient,
+			baseURL+RedisServiceTopKListProcedure,
+			opts...,
+		),
+		tDigestCreate: connect.NewClient[v1.TDigestCreateRequest, v1.TDigestCreateResponse](
+			httpClient,
+			baseURL+RedisServiceTDigestCreateProcedure,
+			opts...,
+		),
+		tDigestAdd: connect.NewClient[v1.TDigestAddRequest, v1.TDigestAddResponse](
+			httpClient,
+			baseURL+RedisServiceTDigestAddProcedure,
+			opts...,
+		),
+		tDigestQuantile: connect.NewClient[v1.TDigestQuantileRequest, v1.TDigestQuantileResponse](
+			httpClient,
+			baseURL+RedisServiceTDigestQuantileProcedure,
+			opts...,
+		),
+		tDigestCDF: connect.NewClient[v1.TDigestCDFRequest, v1.TDigestCDFResponse](
+			httpClient,
+			baseURL+RedisServiceTDigestCDFProcedure,
+			opts...,
+		),
+		tSAdd: connect.NewClient[v1.TSAddRequest, v1.TSAddResponse](
+			httpClient,
+			baseURL+RedisServiceTSAddProcedure,
+			opts...,
+		),
+		tSRange: connect.NewClient[v1.TSRangeRequest, v1.TSRangeResponse](
+			httpClient,
+			baseURL+RedisServiceTSRangeProcedure,
+			opts...,
+		),
+		tSMRange: connect.NewClient[v1.TSMRangeRequest, v1.TSMRangeResponse](
+			httpClient,
+			baseURL+RedisServiceTSMRangeProcedure,
+			opts...,
+		),
+		append: connect.NewClient[v1.AppendRequest, v1.AppendResponse](
+			httpClient,
+			baseURL+RedisServiceAppendProcedure,
+			opts...,
+		),
+		getRange: connect.NewClient[v1.GetRangeRequest, v1.GetRangeResponse](
+			httpClient,
+			baseURL+RedisServiceGetRangeProcedure,
+			opts...,
+		),
+		setRange: connect.NewClient[v1.SetRangeRequest, v1.SetRangeResponse](
+			httpClient,
+			baseURL+RedisServiceSetRangeProcedure,
+			opts...,
+		),
+		strLen: connect.NewClient[v1.StrLenRequest, v1.StrLenResponse](
+			httpClient,
+			baseURL+RedisServiceStrLenProcedure,
+			opts...,
+		),
+		getDel: connect.NewClient[v1.GetDelRequest, v1.GetDelResponse](
+			httpClient,
+			baseURL+RedisServiceGetDelProcedure,
+			opts...,
+		),
+		getEx: connect.NewClient[v1.GetExRequest, v1.GetExResponse](
+			httpClient,
+			baseURL+RedisServiceGetExProcedure,
+			opts...,
+		),
+		mGet: connect.NewClient[v1.MGetRequest, v1.MGetResponse](
+			httpClient,
+			baseURL+RedisServiceMGetProcedure,
+			opts...,
+		),
+		mSet: connect.NewClient[v1.MSetRequest, v1.MSetResponse](
+			httpClient,
+			baseURL+RedisServiceMSetProcedure,
+			opts...,
+		),
+		mSetNX: connect.NewClient[v1.MSetNXRequest, v1.MSetNXResponse](
+			httpClient,
+			baseURL+RedisServiceMSetNXProcedure,
+			opts...,
+		),
 		ping: connect.NewClient[v1.PingRequest, v1.PingResponse](
 			httpClient,
 			baseURL+RedisServicePingProcedure,
@@ -125,6 +1058,16 @@ func NewRedisServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+RedisServiceRestoreProcedure,
 			opts...,
 		),
+		backupStream: connect.NewClient[v1.BackupStreamRequest, v1.BackupStreamResponse](
+			httpClient,
+			baseURL+RedisServiceBackupStreamProcedure,
+			opts...,
+		),
+		restoreStream: connect.NewClient[v1.RestoreStreamRequest, v1.RestoreStreamResponse](
+			httpClient,
+			baseURL+RedisServiceRestoreStreamProcedure,
+			opts...,
+		),
 		join: connect.NewClient[v1.JoinRequest, v1.JoinResponse](
 			httpClient,
 			baseURL+RedisServiceJoinProcedure,
@@ -133,42 +1076,650 @@ func NewRedisServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 	}
 }
 
-// redisServiceClient implements RedisServiceClient.
-type redisServiceClient struct {
-	set     *connect.Client[v1.SetRequest, v1.SetResponse]
-	get     *connect.Client[v1.GetRequest, v1.GetResponse]
-	del     *connect.Client[v1.DelRequest, v1.DelResponse]
-	incr    *connect.Client[v1.IncrRequest, v1.IncrResponse]
-	expire  *connect.Client[v1.ExpireRequest, v1.ExpireResponse]
-	ping    *connect.Client[v1.PingRequest, v1.PingResponse]
-	backup  *connect.Client[v1.BackupRequest, v1.BackupResponse]
-	restore *connect.Client[v1.RestoreRequest, v1.RestoreResponse]
-	join    *connect.Client[v1.JoinRequest, v1.JoinResponse]
+// redisServiceClient implements RedisServiceClient.
+type redisServiceClient struct {
+	set             *connect.Client[v1.SetRequest, v1.SetResponse]
+	get             *connect.Client[v1.GetRequest, v1.GetResponse]
+	del             *connect.Client[v1.DelRequest, v1.DelResponse]
+	incr            *connect.Client[v1.IncrRequest, v1.IncrResponse]
+	incrBy          *connect.Client[v1.IncrByRequest, v1.IncrByResponse]
+	decr            *connect.Client[v1.DecrRequest, v1.DecrResponse]
+	decrBy          *connect.Client[v1.DecrByRequest, v1.DecrByResponse]
+	incrByFloat     *connect.Client[v1.IncrByFloatRequest, v1.IncrByFloatResponse]
+	expire          *connect.Client[v1.ExpireRequest, v1.ExpireResponse]
+	expireAt        *connect.Client[v1.ExpireAtRequest, v1.ExpireAtResponse]
+	pExpireAt       *connect.Client[v1.PExpireAtRequest, v1.PExpireAtResponse]
+	ttl             *connect.Client[v1.TtlRequest, v1.TtlResponse]
+	pttl            *connect.Client[v1.PttlRequest, v1.PttlResponse]
+	persist         *connect.Client[v1.PersistRequest, v1.PersistResponse]
+	hSet            *connect.Client[v1.HSetRequest, v1.HSetResponse]
+	hGet            *connect.Client[v1.HGetRequest, v1.HGetResponse]
+	hDel            *connect.Client[v1.HDelRequest, v1.HDelResponse]
+	hGetAll         *connect.Client[v1.HGetAllRequest, v1.HGetAllResponse]
+	hIncrBy         *connect.Client[v1.HIncrByRequest, v1.HIncrByResponse]
+	hScan           *connect.Client[v1.HScanRequest, v1.HScanResponse]
+	lPush           *connect.Client[v1.LPushRequest, v1.LPushResponse]
+	rPush           *connect.Client[v1.RPushRequest, v1.RPushResponse]
+	lPop            *connect.Client[v1.LPopRequest, v1.LPopResponse]
+	rPop            *connect.Client[v1.RPopRequest, v1.RPopResponse]
+	lRange          *connect.Client[v1.LRangeRequest, v1.LRangeResponse]
+	lLen            *connect.Client[v1.LLenRequest, v1.LLenResponse]
+	lTrim           *connect.Client[v1.LTrimRequest, v1.LTrimResponse]
+	lIndex          *connect.Client[v1.LIndexRequest, v1.LIndexResponse]
+	bLPop           *connect.Client[v1.BLPopRequest, v1.BLPopResponse]
+	bRPop           *connect.Client[v1.BRPopRequest, v1.BRPopResponse]
+	sAdd            *connect.Client[v1.SAddRequest, v1.SAddResponse]
+	sRem            *connect.Client[v1.SRemRequest, v1.SRemResponse]
+	sIsMember       *connect.Client[v1.SIsMemberRequest, v1.SIsMemberResponse]
+	sMembers        *connect.Client[v1.SMembersRequest, v1.SMembersResponse]
+	sCard           *connect.Client[v1.SCardRequest, v1.SCardResponse]
+	sPop            *connect.Client[v1.SPopRequest, v1.SPopResponse]
+	sRandMember     *connect.Client[v1.SRandMemberRequest, v1.SRandMemberResponse]
+	sInter          *connect.Client[v1.SInterRequest, v1.SInterResponse]
+	sUnion          *connect.Client[v1.SUnionRequest, v1.SUnionResponse]
+	sDiff           *connect.Client[v1.SDiffRequest, v1.SDiffResponse]
+	sInterStore     *connect.Client[v1.SInterStoreRequest, v1.SInterStoreResponse]
+	sUnionStore     *connect.Client[v1.SUnionStoreRequest, v1.SUnionStoreResponse]
+	sDiffStore      *connect.Client[v1.SDiffStoreRequest, v1.SDiffStoreResponse]
+	zAdd            *connect.Client[v1.ZAddRequest, v1.ZAddResponse]
+	zRem            *connect.Client[v1.ZRemRequest, v1.ZRemResponse]
+	zScore          *connect.Client[v1.ZScoreRequest, v1.ZScoreResponse]
+	zRank           *connect.Client[v1.ZRankRequest, v1.ZRankResponse]
+	zRange          *connect.Client[v1.ZRangeRequest, v1.ZRangeResponse]
+	zCount          *connect.Client[v1.ZCountRequest, v1.ZCountResponse]
+	zPopMin         *connect.Client[v1.ZPopMinRequest, v1.ZPopMinResponse]
+	zPopMax         *connect.Client[v1.ZPopMaxRequest, v1.ZPopMaxResponse]
+	xAdd            *connect.Client[v1.XAddRequest, v1.XAddResponse]
+	xRange          *connect.Client[v1.XRangeRequest, v1.XRangeResponse]
+	xRevRange       *connect.Client[v1.XRevRangeRequest, v1.XRevRangeResponse]
+	xLen            *connect.Client[v1.XLenRequest, v1.XLenResponse]
+	xTrim           *connect.Client[v1.XTrimRequest, v1.XTrimResponse]
+	xGroupCreate    *connect.Client[v1.XGroupCreateRequest, v1.XGroupCreateResponse]
+	xReadGroup      *connect.Client[v1.XReadGroupRequest, v1.XReadGroupResponse]
+	xAck            *connect.Client[v1.XAckRequest, v1.XAckResponse]
+	xPending        *connect.Client[v1.XPendingRequest, v1.XPendingResponse]
+	xClaim          *connect.Client[v1.XClaimRequest, v1.XClaimResponse]
+	setBit          *connect.Client[v1.SetBitRequest, v1.SetBitResponse]
+	getBit          *connect.Client[v1.GetBitRequest, v1.GetBitResponse]
+	bitCount        *connect.Client[v1.BitCountRequest, v1.BitCountResponse]
+	bitPos          *connect.Client[v1.BitPosRequest, v1.BitPosResponse]
+	bitOp           *connect.Client[v1.BitOpRequest, v1.BitOpResponse]
+	pFAdd           *connect.Client[v1.PFAddRequest, v1.PFAddResponse]
+	pFCount         *connect.Client[v1.PFCountRequest, v1.PFCountResponse]
+	pFMerge         *connect.Client[v1.PFMergeRequest, v1.PFMergeResponse]
+	geoAdd          *connect.Client[v1.GeoAddRequest, v1.GeoAddResponse]
+	geoDist         *connect.Client[v1.GeoDistRequest, v1.GeoDistResponse]
+	geoSearch       *connect.Client[v1.GeoSearchRequest, v1.GeoSearchResponse]
+	jSONSet         *connect.Client[v1.JSONSetRequest, v1.JSONSetResponse]
+	jSONGet         *connect.Client[v1.JSONGetRequest, v1.JSONGetResponse]
+	jSONDel         *connect.Client[v1.JSONDelRequest, v1.JSONDelResponse]
+	jSONNumIncrBy   *connect.Client[v1.JSONNumIncrByRequest, v1.JSONNumIncrByResponse]
+	jSONArrAppend   *connect.Client[v1.JSONArrAppendRequest, v1.JSONArrAppendResponse]
+	bFReserve       *connect.Client[v1.BFReserveRequest, v1.BFReserveResponse]
+	bFAdd           *connect.Client[v1.BFAddRequest, v1.BFAddResponse]
+	bFExists        *connect.Client[v1.BFExistsRequest, v1.BFExistsResponse]
+	cFAdd           *connect.Client[v1.CFAddRequest, v1.CFAddResponse]
+	cFDel           *connect.Client[v1.CFDelRequest, v1.CFDelResponse]
+	cFExists        *connect.Client[v1.CFExistsRequest, v1.CFExistsResponse]
+	cMSInitByDim    *connect.Client[v1.CMSInitByDimRequest, v1.CMSInitByDimResponse]
+	cMSIncrBy       *connect.Client[v1.CMSIncrByRequest, v1.CMSIncrByResponse]
+	cMSQuery        *connect.Client[v1.CMSQueryRequest, v1.CMSQueryResponse]
+	topKReserve     *connect.Client[v1.TopKReserveRequest, v1.TopKReserveResponse]
+	topKAdd         *connect.Client[v1.TopKAddRequest, v1.TopKAddResponse]
+	topKQuery       *connect.Client[v1.TopKQueryRequest, v1.TopKQueryResponse]
+	topKList        *connect.Client[v1.TopKListRequest, v1.TopKListResponse]
+	tDigestCreate   *connect.Client[v1.TDigestCreateRequest, v1.TDigestCreateResponse]
+	tDigestAdd      *connect.Client[v1.TDigestAddRequest, v1.TDigestAddResponse]
+	tDigestQuantile *connect.Client[v1.TDigestQuantileRequest, v1.TDigestQuantileResponse]
+	tDigestCDF      *connect.Client[v1.TDigestCDFRequest, v1.TDigestCDFResponse]
+	tSAdd           *connect.Client[v1.TSAddRequest, v1.TSAddResponse]
+	tSRange         *connect.Client[v1.TSRangeRequest, v1.TSRangeResponse]
+	tSMRange        *connect.Client[v1.TSMRangeRequest, v1.TSMRangeResponse]
+	append          *connect.Client[v1.AppendRequest, v1.AppendResponse]
+	getRange        *connect.Client[v1.GetRangeRequest, v1.GetRangeResponse]
+	setRange        *connect.Client[v1.SetRangeRequest, v1.SetRangeResponse]
+	strLen          *connect.Client[v1.StrLenRequest, v1.StrLenResponse]
+	getDel          *connect.Client[v1.GetDelRequest, v1.GetDelResponse]
+	getEx           *connect.Client[v1.GetExRequest, v1.GetExResponse]
+	mGet            *connect.Client[v1.MGetRequest, v1.MGetResponse]
+	mSet            *connect.Client[v1.MSetRequest, v1.MSetResponse]
+	mSetNX          *connect.Client[v1.MSetNXRequest, v1.MSetNXResponse]
+	ping            *connect.Client[v1.PingRequest, v1.PingResponse]
+	backup          *connect.Client[v1.BackupRequest, v1.BackupResponse]
+	restore         *connect.Client[v1.RestoreRequest, v1.RestoreResponse]
+	backupStream    *connect.Client[v1.BackupStreamRequest, v1.BackupStreamResponse]
+	restoreStream   *connect.Client[v1.RestoreStreamRequest, v1.RestoreStreamResponse]
+	join            *connect.Client[v1.JoinRequest, v1.JoinResponse]
+}
+
+// Set calls cloud.v1.RedisService.Set.
+func (c *redisServiceClient) Set(ctx context.Context, req *connect.Request[v1.SetRequest]) (*connect.Response[v1.SetResponse], error) {
+	return c.set.CallUnary(ctx, req)
+}
+
+// Get calls cloud.v1.RedisService.Get.
+func (c *redisServiceClient) Get(ctx context.Context, req *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// Del calls cloud.v1.RedisService.Del.
+func (c *redisServiceClient) Del(ctx context.Context, req *connect.Request[v1.DelRequest]) (*connect.Response[v1.DelResponse], error) {
+	return c.del.CallUnary(ctx, req)
+}
+
+// Incr calls cloud.v1.RedisService.Incr.
+func (c *redisServiceClient) Incr(ctx context.Context, req *connect.Request[v1.IncrRequest]) (*connect.Response[v1.IncrResponse], error) {
+	return c.incr.CallUnary(ctx, req)
+}
+
+// IncrBy calls cloud.v1.RedisService.IncrBy.
+func (c *redisServiceClient) IncrBy(ctx context.Context, req *connect.Request[v1.IncrByRequest]) (*connect.Response[v1.IncrByResponse], error) {
+	return c.incrBy.CallUnary(ctx, req)
+}
+
+// Decr calls cloud.v1.RedisService.Decr.
+func (c *redisServiceClient) Decr(ctx context.Context, req *connect.Request[v1.DecrRequest]) (*connect.Response[v1.DecrResponse], error) {
+	return c.decr.CallUnary(ctx, req)
+}
+
+// DecrBy calls cloud.v1.RedisService.DecrBy.
+func (c *redisServiceClient) DecrBy(ctx context.Context, req *connect.Request[v1.DecrByRequest]) (*connect.Response[v1.DecrByResponse], error) {
+	return c.decrBy.CallUnary(ctx, req)
+}
+
+// IncrByFloat calls cloud.v1.RedisService.IncrByFloat.
+func (c *redisServiceClient) IncrByFloat(ctx context.Context, req *connect.Request[v1.IncrByFloatRequest]) (*connect.Response[v1.IncrByFloatResponse], error) {
+	return c.incrByFloat.CallUnary(ctx, req)
+}
+
+// Expire calls cloud.v1.RedisService.Expire.
+func (c *redisServiceClient) Expire(ctx context.Context, req *connect.Request[v1.ExpireRequest]) (*connect.Response[v1.ExpireResponse], error) {
+	return c.expire.CallUnary(ctx, req)
+}
+
+// ExpireAt calls cloud.v1.RedisService.ExpireAt.
+func (c *redisServiceClient) ExpireAt(ctx context.Context, req *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error) {
+	return c.expireAt.CallUnary(ctx, req)
+}
+
+// PExpireAt calls cloud.v1.RedisService.PExpireAt.
+func (c *redisServiceClient) PExpireAt(ctx context.Context, req *connect.Request[v1.PExpireAtRequest]) (*connect.Response[v1.PExpireAtResponse], error) {
+	return c.pExpireAt.CallUnary(ctx, req)
+}
+
+// Ttl calls cloud.v1.RedisService.Ttl.
+func (c *redisServiceClient) Ttl(ctx context.Context, req *connect.Request[v1.TtlRequest]) (*connect.Response[v1.TtlResponse], error) {
+	return c.ttl.CallUnary(ctx, req)
+}
+
+// Pttl calls cloud.v1.RedisService.Pttl.
+func (c *redisServiceClient) Pttl(ctx context.Context, req *connect.Request[v1.PttlRequest]) (*connect.Response[v1.PttlResponse], error) {
+	return c.pttl.CallUnary(ctx, req)
+}
+
+// Persist calls cloud.v1.RedisService.Persist.
+func (c *redisServiceClient) Persist(ctx context.Context, req *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error) {
+	return c.persist.CallUnary(ctx, req)
+}
+
+// HSet calls cloud.v1.RedisService.HSet.
+func (c *redisServiceClient) HSet(ctx context.Context, req *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error) {
+	return c.hSet.CallUnary(ctx, req)
+}
+
+// HGet calls cloud.v1.RedisService.HGet.
+func (c *redisServiceClient) HGet(ctx context.Context, req *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error) {
+	return c.hGet.CallUnary(ctx, req)
+}
+
+// HDel calls cloud.v1.RedisService.HDel.
+func (c *redisServiceClient) HDel(ctx context.Context, req *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error) {
+	return c.hDel.CallUnary(ctx, req)
+}
+
+// HGetAll calls cloud.v1.RedisService.HGetAll.
+func (c *redisServiceClient) HGetAll(ctx context.Context, req *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error) {
+	return c.hGetAll.CallUnary(ctx, req)
+}
+
+// HIncrBy calls cloud.v1.RedisService.HIncrBy.
+func (c *redisServiceClient) HIncrBy(ctx context.Context, req *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error) {
+	return c.hIncrBy.CallUnary(ctx, req)
+}
+
+// HScan calls cloud.v1.RedisService.HScan.
+func (c *redisServiceClient) HScan(ctx context.Context, req *connect.Request[v1.HScanRequest]) (*connect.Response[v1.HScanResponse], error) {
+	return c.hScan.CallUnary(ctx, req)
+}
+
+// LPush calls cloud.v1.RedisService.LPush.
+func (c *redisServiceClient) LPush(ctx context.Context, req *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error) {
+	return c.lPush.CallUnary(ctx, req)
+}
+
+// RPush calls cloud.v1.RedisService.RPush.
+func (c *redisServiceClient) RPush(ctx context.Context, req *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error) {
+	return c.rPush.CallUnary(ctx, req)
+}
+
+// LPop calls cloud.v1.RedisService.LPop.
+func (c *redisServiceClient) LPop(ctx context.Context, req *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error) {
+	return c.lPop.CallUnary(ctx, req)
+}
+
+// RPop calls cloud.v1.RedisService.RPop.
+func (c *redisServiceClient) RPop(ctx context.Context, req *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error) {
+	return c.rPop.CallUnary(ctx, req)
+}
+
+// LRange calls cloud.v1.RedisService.LRange.
+func (c *redisServiceClient) LRange(ctx context.Context, req *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error) {
+	return c.lRange.CallUnary(ctx, req)
+}
+
+// LLen calls cloud.v1.RedisService.LLen.
+func (c *redisServiceClient) LLen(ctx context.Context, req *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error) {
+	return c.lLen.CallUnary(ctx, req)
+}
+
+// LTrim calls cloud.v1.RedisService.LTrim.
+func (c *redisServiceClient) LTrim(ctx context.Context, req *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error) {
+	return c.lTrim.CallUnary(ctx, req)
+}
+
+// LIndex calls cloud.v1.RedisService.LIndex.
+func (c *redisServiceClient) LIndex(ctx context.Context, req *connect.Request[v1.LIndexRequest]) (*connect.Response[v1.LIndexResponse], error) {
+	return c.lIndex.CallUnary(ctx, req)
+}
+
+// BLPop calls cloud.v1.RedisService.BLPop.
+func (c *redisServiceClient) BLPop(ctx context.Context, req *connect.Request[v1.BLPopRequest]) (*connect.Response[v1.BLPopResponse], error) {
+	return c.bLPop.CallUnary(ctx, req)
+}
+
+// BRPop calls cloud.v1.RedisService.BRPop.
+func (c *redisServiceClient) BRPop(ctx context.Context, req *connect.Request[v1.BRPopRequest]) (*connect.Response[v1.BRPopResponse], error) {
+	return c.bRPop.CallUnary(ctx, req)
+}
+
+// SAdd calls cloud.v1.RedisService.SAdd.
+func (c *redisServiceClient) SAdd(ctx context.Context, req *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error) {
+	return c.sAdd.CallUnary(ctx, req)
+}
+
+// SRem calls cloud.v1.RedisService.SRem.
+func (c *redisServiceClient) SRem(ctx context.Context, req *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error) {
+	return c.sRem.CallUnary(ctx, req)
+}
+
+// SIsMember calls cloud.v1.RedisService.SIsMember.
+func (c *redisServiceClient) SIsMember(ctx context.Context, req *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error) {
+	return c.sIsMember.CallUnary(ctx, req)
+}
+
+// SMembers calls cloud.v1.RedisService.SMembers.
+func (c *redisServiceClient) SMembers(ctx context.Context, req *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error) {
+	return c.sMembers.CallUnary(ctx, req)
+}
+
+// SCard calls cloud.v1.RedisService.SCard.
+func (c *redisServiceClient) SCard(ctx context.Context, req *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error) {
+	return c.sCard.CallUnary(ctx, req)
+}
+
+// SPop calls cloud.v1.RedisService.SPop.
+func (c *redisServiceClient) SPop(ctx context.Context, req *connect.Request[v1.SPopRequest]) (*connect.Response[v1.SPopResponse], error) {
+	return c.sPop.CallUnary(ctx, req)
+}
+
+// SRandMember calls cloud.v1.RedisService.SRandMember.
+func (c *redisServiceClient) SRandMember(ctx context.Context, req *connect.Request[v1.SRandMemberRequest]) (*connect.Response[v1.SRandMemberResponse], error) {
+	return c.sRandMember.CallUnary(ctx, req)
+}
+
+// SInter calls cloud.v1.RedisService.SInter.
+func (c *redisServiceClient) SInter(ctx context.Context, req *connect.Request[v1.SInterRequest]) (*connect.Response[v1.SInterResponse], error) {
+	return c.sInter.CallUnary(ctx, req)
+}
+
+// SUnion calls cloud.v1.RedisService.SUnion.
+func (c *redisServiceClient) SUnion(ctx context.Context, req *connect.Request[v1.SUnionRequest]) (*connect.Response[v1.SUnionResponse], error) {
+	return c.sUnion.CallUnary(ctx, req)
+}
+
+// SDiff calls cloud.v1.RedisService.SDiff.
+func (c *redisServiceClient) SDiff(ctx context.Context, req *connect.Request[v1.SDiffRequest]) (*connect.Response[v1.SDiffResponse], error) {
+	return c.sDiff.CallUnary(ctx, req)
+}
+
+// SInterStore calls cloud.v1.RedisService.SInterStore.
+func (c *redisServiceClient) SInterStore(ctx context.Context, req *connect.Request[v1.SInterStoreRequest]) (*connect.Response[v1.SInterStoreResponse], error) {
+	return c.sInterStore.CallUnary(ctx, req)
+}
+
+// SUnionStore calls cloud.v1.RedisService.SUnionStore.
+func (c *redisServiceClient) SUnionStore(ctx context.Context, req *connect.Request[v1.SUnionStoreRequest]) (*connect.Response[v1.SUnionStoreResponse], error) {
+	return c.sUnionStore.CallUnary(ctx, req)
+}
+
+// SDiffStore calls cloud.v1.RedisService.SDiffStore.
+func (c *redisServiceClient) SDiffStore(ctx context.Context, req *connect.Request[v1.SDiffStoreRequest]) (*connect.Response[v1.SDiffStoreResponse], error) {
+	return c.sDiffStore.CallUnary(ctx, req)
+}
+
+// ZAdd calls cloud.v1.RedisService.ZAdd.
+func (c *redisServiceClient) ZAdd(ctx context.Context, req *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error) {
+	return c.zAdd.CallUnary(ctx, req)
+}
+
+// ZRem calls cloud.v1.RedisService.ZRem.
+func (c *redisServiceClient) ZRem(ctx context.Context, req *connect.Request[v1.ZRemRequest]) (*connect.Response[v1.ZRemResponse], error) {
+	return c.zRem.CallUnary(ctx, req)
+}
+
+// ZScore calls cloud.v1.RedisService.ZScore.
+func (c *redisServiceClient) ZScore(ctx context.Context, req *connect.Request[v1.ZScoreRequest]) (*connect.Response[v1.ZScoreResponse], error) {
+	return c.zScore.CallUnary(ctx, req)
+}
+
+// ZRank calls cloud.v1.RedisService.ZRank.
+func (c *redisServiceClient) ZRank(ctx context.Context, req *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error) {
+	return c.zRank.CallUnary(ctx, req)
+}
+
+// ZRange calls cloud.v1.RedisService.ZRange.
+func (c *redisServiceClient) ZRange(ctx context.Context, req *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error) {
+	return c.zRange.CallUnary(ctx, req)
+}
+
+// ZCount calls cloud.v1.RedisService.ZCount.
+func (c *redisServiceClient) ZCount(ctx context.Context, req *connect.Request[v1.ZCountRequest]) (*connect.Response[v1.ZCountResponse], error) {
+	return c.zCount.CallUnary(ctx, req)
+}
+
+// ZPopMin calls cloud.v1.RedisService.ZPopMin.
+func (c *redisServiceClient) ZPopMin(ctx context.Context, req *connect.Request[v1.ZPopMinRequest]) (*connect.Response[v1.ZPopMinResponse], error) {
+	return c.zPopMin.CallUnary(ctx, req)
+}
+
+// ZPopMax calls cloud.v1.RedisService.ZPopMax.
+func (c *redisServiceClient) ZPopMax(ctx context.Context, req *connect.Request[v1.ZPopMaxRequest]) (*connect.Response[v1.ZPopMaxResponse], error) {
+	return c.zPopMax.CallUnary(ctx, req)
+}
+
+// XAdd calls cloud.v1.RedisService.XAdd.
+func (c *redisServiceClient) XAdd(ctx context.Context, req *connect.Request[v1.XAddRequest]) (*connect.Response[v1.XAddResponse], error) {
+	return c.xAdd.CallUnary(ctx, req)
+}
+
+// XRange calls cloud.v1.RedisService.XRange.
+func (c *redisServiceClient) XRange(ctx context.Context, req *connect.Request[v1.XRangeRequest]) (*connect.Response[v1.XRangeResponse], error) {
+	return c.xRange.CallUnary(ctx, req)
+}
+
+// XRevRange calls cloud.v1.RedisService.XRevRange.
+func (c *redisServiceClient) XRevRange(ctx context.Context, req *connect.Request[v1.XRevRangeRequest]) (*connect.Response[v1.XRevRangeResponse], error) {
+	return c.xRevRange.CallUnary(ctx, req)
+}
+
+// XLen calls cloud.v1.RedisService.XLen.
+func (c *redisServiceClient) XLen(ctx context.Context, req *connect.Request[v1.XLenRequest]) (*connect.Response[v1.XLenResponse], error) {
+	return c.xLen.CallUnary(ctx, req)
+}
+
+// XTrim calls cloud.v1.RedisService.XTrim.
+func (c *redisServiceClient) XTrim(ctx context.Context, req *connect.Request[v1.XTrimRequest]) (*connect.Response[v1.XTrimResponse], error) {
+	return c.xTrim.CallUnary(ctx, req)
+}
+
+// XGroupCreate calls cloud.v1.RedisService.XGroupCreate.
+func (c *redisServiceClient) XGroupCreate(ctx context.Context, req *connect.Request[v1.XGroupCreateRequest]) (*connect.Response[v1.XGroupCreateResponse], error) {
+	return c.xGroupCreate.CallUnary(ctx, req)
+}
+
+// XReadGroup calls cloud.v1.RedisService.XReadGroup.
+func (c *redisServiceClient) XReadGroup(ctx context.Context, req *connect.Request[v1.XReadGroupRequest]) (*connect.Response[v1.XReadGroupResponse], error) {
+	return c.xReadGroup.CallUnary(ctx, req)
+}
+
+// XAck calls cloud.v1.RedisService.XAck.
+func (c *redisServiceClient) XAck(ctx context.Context, req *connect.Request[v1.XAckRequest]) (*connect.Response[v1.XAckResponse], error) {
+	return c.xAck.CallUnary(ctx, req)
+}
+
+// XPending calls cloud.v1.RedisService.XPending.
+func (c *redisServiceClient) XPending(ctx context.Context, req *connect.Request[v1.XPendingRequest]) (*connect.Response[v1.XPendingResponse], error) {
+	return c.xPending.CallUnary(ctx, req)
+}
+
+// XClaim calls cloud.v1.RedisService.XClaim.
+func (c *redisServiceClient) XClaim(ctx context.Context, req *connect.Request[v1.XClaimRequest]) (*connect.Response[v1.XClaimResponse], error) {
+	return c.xClaim.CallUnary(ctx, req)
+}
+
+// SetBit calls cloud.v1.RedisService.SetBit.
+func (c *redisServiceClient) SetBit(ctx context.Context, req *connect.Request[v1.SetBitRequest]) (*connect.Response[v1.SetBitResponse], error) {
+	return c.setBit.CallUnary(ctx, req)
+}
+
+// GetBit calls cloud.v1.RedisService.GetBit.
+func (c *redisServiceClient) GetBit(ctx context.Context, req *connect.Request[v1.GetBitRequest]) (*connect.Response[v1.GetBitResponse], error) {
+	return c.getBit.CallUnary(ctx, req)
+}
+
+// BitCount calls cloud.v1.RedisService.BitCount.
+func (c *redisServiceClient) BitCount(ctx context.Context, req *connect.Request[v1.BitCountRequest]) (*connect.Response[v1.BitCountResponse], error) {
+	return c.bitCount.CallUnary(ctx, req)
+}
+
+// BitPos calls cloud.v1.RedisService.BitPos.
+func (c *redisServiceClient) BitPos(ctx context.Context, req *connect.Request[v1.BitPosRequest]) (*connect.Response[v1.BitPosResponse], error) {
+	return c.bitPos.CallUnary(ctx, req)
+}
+
+// BitOp calls cloud.v1.RedisService.BitOp.
+func (c *redisServiceClient) BitOp(ctx context.Context, req *connect.Request[v1.BitOpRequest]) (*connect.Response[v1.BitOpResponse], error) {
+	return c.bitOp.CallUnary(ctx, req)
+}
+
+// PFAdd calls cloud.v1.RedisService.PFAdd.
+func (c *redisServiceClient) PFAdd(ctx context.Context, req *connect.Request[v1.PFAddRequest]) (*connect.Response[v1.PFAddResponse], error) {
+	return c.pFAdd.CallUnary(ctx, req)
+}
+
+// PFCount calls cloud.v1.RedisService.PFCount.
+func (c *redisServiceClient) PFCount(ctx context.Context, req *connect.Request[v1.PFCountRequest]) (*connect.Response[v1.PFCountResponse], error) {
+	return c.pFCount.CallUnary(ctx, req)
+}
+
+// PFMerge calls cloud.v1.RedisService.PFMerge.
+func (c *redisServiceClient) PFMerge(ctx context.Context, req *connect.Request[v1.PFMergeRequest]) (*connect.Response[v1.PFMergeResponse], error) {
+	return c.pFMerge.CallUnary(ctx, req)
+}
+
+// GeoAdd calls cloud.v1.RedisService.GeoAdd.
+func (c *redisServiceClient) GeoAdd(ctx context.Context, req *connect.Request[v1.GeoAddRequest]) (*connect.Response[v1.GeoAddResponse], error) {
+	return c.geoAdd.CallUnary(ctx, req)
+}
+
+// GeoDist calls cloud.v1.RedisService.GeoDist.
+func (c *redisServiceClient) GeoDist(ctx context.Context, req *connect.Request[v1.GeoDistRequest]) (*connect.Response[v1.GeoDistResponse], error) {
+	return c.geoDist.CallUnary(ctx, req)
+}
+
+// GeoSearch calls cloud.v1.RedisService.GeoSearch.
+func (c *redisServiceClient) GeoSearch(ctx context.Context, req *connect.Request[v1.GeoSearchRequest]) (*connect.Response[v1.GeoSearchResponse], error) {
+	return c.geoSearch.CallUnary(ctx, req)
+}
+
+// JSONSet calls cloud.v1.RedisService.JSONSet.
+func (c *redisServiceClient) JSONSet(ctx context.Context, req *connect.Request[v1.JSONSetRequest]) (*connect.Response[v1.JSONSetResponse], error) {
+	return c.jSONSet.CallUnary(ctx, req)
+}
+
+// JSONGet calls cloud.v1.RedisService.JSONGet.
+func (c *redisServiceClient) JSONGet(ctx context.Context, req *connect.Request[v1.JSONGetRequest]) (*connect.Response[v1.JSONGetResponse], error) {
+	return c.jSONGet.CallUnary(ctx, req)
 }
 
-// Set calls cloud.v1.RedisService.Set.
-func (c *redisServiceClient) Set(ctx context.Context, req *connect.Request[v1.SetRequest]) (*connect.Response[v1.SetResponse], error) {
-	return c.set.CallUnary(ctx, req)
+// JSONDel calls cloud.v1.RedisService.JSONDel.
+func (c *redisServiceClient) JSONDel(ctx context.Context, req *connect.Request[v1.JSONDelRequest]) (*connect.Response[v1.JSONDelResponse], error) {
+	return c.jSONDel.CallUnary(ctx, req)
 }
 
-// Get calls cloud.v1.RedisService.Get.
-func (c *redisServiceClient) Get(ctx context.Context, req *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
-	return c.get.CallUnary(ctx, req)
+// JSONNumIncrBy calls cloud.v1.RedisService.JSONNumIncrBy.
+func (c *redisServiceClient) JSONNumIncrBy(ctx context.Context, req *connect.Request[v1.JSONNumIncrByRequest]) (*connect.Response[v1.JSONNumIncrByResponse], error) {
+	return c.jSONNumIncrBy.CallUnary(ctx, req)
 }
 
-// Del calls cloud.v1.RedisService.Del.
-func (c *redisServiceClient) Del(ctx context.Context, req *connect.Request[v1.DelRequest]) (*connect.Response[v1.DelResponse], error) {
-	return c.del.CallUnary(ctx, req)
+// JSONArrAppend calls cloud.v1.RedisService.JSONArrAppend.
+func (c *redisServiceClient) JSONArrAppend(ctx context.Context, req *connect.Request[v1.JSONArrAppendRequest]) (*connect.Response[v1.JSONArrAppendResponse], error) {
+	return c.jSONArrAppend.CallUnary(ctx, req)
 }
 
-// Incr calls cloud.v1.RedisService.Incr.
-func (c *redisServiceClient) Incr(ctx context.Context, req *connect.Request[v1.IncrRequest]) (*connect.Response[v1.IncrResponse], error) {
-	return c.incr.CallUnary(ctx, req)
+// BFReserve calls cloud.v1.RedisService.BFReserve.
+func (c *redisServiceClient) BFReserve(ctx context.Context, req *connect.Request[v1.BFReserveRequest]) (*connect.Response[v1.BFReserveResponse], error) {
+	return c.bFReserve.CallUnary(ctx, req)
 }
 
-// Expire calls cloud.v1.RedisService.Expire.
-func (c *redisServiceClient) Expire(ctx context.Context, req *connect.Request[v1.ExpireRequest]) (*connect.Response[v1.ExpireResponse], error) {
-	return c.expire.CallUnary(ctx, req)
+// BFAdd calls cloud.v1.RedisService.BFAdd.
+func (c *redisServiceClient) BFAdd(ctx context.Context, req *connect.Request[v1.BFAddRequest]) (*connect.Response[v1.BFAddResponse], error) {
+	return c.bFAdd.CallUnary(ctx, req)
+}
+
+// BFExists calls cloud.v1.RedisService.BFExists.
+func (c *redisServiceClient) BFExists(ctx context.Context, req *connect.Request[v1.BFExistsRequest]) (*connect.Response[v1.BFExistsResponse], error) {
+	return c.bFExists.CallUnary(ctx, req)
+}
+
+// CFAdd calls cloud.v1.RedisService.CFAdd.
+func (c *redisServiceClient) CFAdd(ctx context.Context, req *connect.Request[v1.CFAddRequest]) (*connect.Response[v1.CFAddResponse], error) {
+	return c.cFAdd.CallUnary(ctx, req)
+}
+
+// CFDel calls cloud.v1.RedisService.CFDel.
+func (c *redisServiceClient) CFDel(ctx context.Context, req *connect.Request[v1.CFDelRequest]) (*connect.Response[v1.CFDelResponse], error) {
+	return c.cFDel.CallUnary(ctx, req)
+}
+
+// CFExists calls cloud.v1.RedisService.CFExists.
+func (c *redisServiceClient) CFExists(ctx context.Context, req *connect.Request[v1.CFExistsRequest]) (*connect.Response[v1.CFExistsResponse], error) {
+	return c.cFExists.CallUnary(ctx, req)
+}
+
+// CMSInitByDim calls cloud.v1.RedisService.CMSInitByDim.
+func (c *redisServiceClient) CMSInitByDim(ctx context.Context, req *connect.Request[v1.CMSInitByDimRequest]) (*connect.Response[v1.CMSInitByDimResponse], error) {
+	return c.cMSInitByDim.CallUnary(ctx, req)
+}
+
+// CMSIncrBy calls cloud.v1.RedisService.CMSIncrBy.
+func (c *redisServiceClient) CMSIncrBy(ctx context.Context, req *connect.Request[v1.CMSIncrByRequest]) (*connect.Response[v1.CMSIncrByResponse], error) {
+	return c.cMSIncrBy.CallUnary(ctx, req)
+}
+
+// CMSQuery calls cloud.v1.RedisService.CMSQuery.
+func (c *redisServiceClient) CMSQuery(ctx context.Context, req *connect.Request[v1.CMSQueryRequest]) (*connect.Response[v1.CMSQueryResponse], error) {
+	return c.cMSQuery.CallUnary(ctx, req)
+}
+
+// TopKReserve calls cloud.v1.RedisService.TopKReserve.
+func (c *redisServiceClient) TopKReserve(ctx context.Context, req *connect.Request[v1.TopKReserveRequest]) (*connect.Response[v1.TopKReserveResponse], error) {
+	return c.topKReserve.CallUnary(ctx, req)
+}
+
+// TopKAdd calls cloud.v1.RedisService.TopKAdd.
+func (c *redisServiceClient) TopKAdd(ctx context.Context, req *connect.Request[v1.TopKAddRequest]) (*connect.Response[v1.TopKAddResponse], error) {
+	return c.topKAdd.CallUnary(ctx, req)
+}
+
+// TopKQuery calls cloud.v1.RedisService.TopKQuery.
+func (c *redisServiceClient) TopKQuery(ctx context.Context, req *connect.Request[v1.TopKQueryRequest]) (*connect.Response[v1.TopKQueryResponse], error) {
+	return c.topKQuery.CallUnary(ctx, req)
+}
+
+// TopKList calls cloud.v1.RedisService.TopKList.
+func (c *redisServiceClient) TopKList(ctx context.Context, req *connect.Request[v1.TopKListRequest]) (*connect.Response[v1.TopKListResponse], error) {
+	return c.topKList.CallUnary(ctx, req)
+}
+
+// TDigestCreate calls cloud.v1.RedisService.TDigestCreate.
+func (c *redisServiceClient) TDigestCreate(ctx context.Context, req *connect.Request[v1.TDigestCreateRequest]) (*connect.Response[v1.TDigestCreateResponse], error) {
+	return c.tDigestCreate.CallUnary(ctx, req)
+}
+
+// TDigestAdd calls cloud.v1.RedisService.TDigestAdd.
+func (c *redisServiceClient) TDigestAdd(ctx context.Context, req *connect.Request[v1.TDigestAddRequest]) (*connect.Response[v1.TDigestAddResponse], error) {
+	return c.tDigestAdd.CallUnary(ctx, req)
+}
+
+// TDigestQuantile calls cloud.v1.RedisService.TDigestQuantile.
+func (c *redisServiceClient) TDigestQuantile(ctx context.Context, req *connect.Request[v1.TDigestQuantileRequest]) (*connect.Response[v1.TDigestQuantileResponse], error) {
+	return c.tDigestQuantile.CallUnary(ctx, req)
+}
+
+// TDigestCDF calls cloud.v1.RedisService.TDigestCDF.
+func (c *redisServiceClient) TDigestCDF(ctx context.Context, req *connect.Request[v1.TDigestCDFRequest]) (*connect.Response[v1.TDigestCDFResponse], error) {
+	return c.tDigestCDF.CallUnary(ctx, req)
+}
+
+// TSAdd calls cloud.v1.RedisService.TSAdd.
+func (c *redisServiceClient) TSAdd(ctx context.Context, req *connect.Request[v1.TSAddRequest]) (*connect.Response[v1.TSAddResponse], error) {
+	return c.tSAdd.CallUnary(ctx, req)
+}
+
+// TSRange calls cloud.v1.RedisService.TSRange.
+func (c *redisServiceClient) TSRange(ctx context.Context, req *connect.Request[v1.TSRangeRequest]) (*connect.Response[v1.TSRangeResponse], error) {
+	return c.tSRange.CallUnary(ctx, req)
+}
+
+// TSMRange calls cloud.v1.RedisService.TSMRange.
+func (c *redisServiceClient) TSMRange(ctx context.Context, req *connect.Request[v1.TSMRangeRequest]) (*connect.Response[v1.TSMRangeResponse], error) {
+	return c.tSMRange.CallUnary(ctx, req)
+}
+
+// Append calls cloud.v1.RedisService.Append.
+func (c *redisServiceClient) Append(ctx context.Context, req *connect.Request[v1.AppendRequest]) (*connect.Response[v1.AppendResponse], error) {
+	return c.append.CallUnary(ctx, req)
+}
+
+// GetRange calls cloud.v1.RedisService.GetRange.
+func (c *redisServiceClient) GetRange(ctx context.Context, req *connect.Request[v1.GetRangeRequest]) (*connect.Response[v1.GetRangeResponse], error) {
+	return c.getRange.CallUnary(ctx, req)
+}
+
+// SetRange calls cloud.v1.RedisService.SetRange.
+func (c *redisServiceClient) SetRange(ctx context.Context, req *connect.Request[v1.SetRangeRequest]) (*connect.Response[v1.SetRangeResponse], error) {
+	return c.setRange.CallUnary(ctx, req)
+}
+
+// StrLen calls cloud.v1.RedisService.StrLen.
+func (c *redisServiceClient) StrLen(ctx context.Context, req *connect.Request[v1.StrLenRequest]) (*connect.Response[v1.StrLenResponse], error) {
+	return c.strLen.CallUnary(ctx, req)
+}
+
+// GetDel calls cloud.v1.RedisService.GetDel.
+func (c *redisServiceClient) GetDel(ctx context.Context, req *connect.Request[v1.GetDelRequest]) (*connect.Response[v1.GetDelResponse], error) {
+	return c.getDel.CallUnary(ctx, req)
+}
+
+// GetEx calls cloud.v1.RedisService.GetEx.
+func (c *redisServiceClient) GetEx(ctx context.Context, req *connect.Request[v1.GetExRequest]) (*connect.Response[v1.GetExResponse], error) {
+	return c.getEx.CallUnary(ctx, req)
+}
+
+// MGet calls cloud.v1.RedisService.MGet.
+func (c *redisServiceClient) MGet(ctx context.Context, req *connect.Request[v1.MGetRequest]) (*connect.Response[v1.MGetResponse], error) {
+	return c.mGet.CallUnary(ctx, req)
+}
+
+// MSet calls cloud.v1.RedisService.MSet.
+func (c *redisServiceClient) MSet(ctx context.Context, req *connect.Request[v1.MSetRequest]) (*connect.Response[v1.MSetResponse], error) {
+	return c.mSet.CallUnary(ctx, req)
+}
+
+// MSetNX calls cloud.v1.RedisService.MSetNX.
+func (c *redisServiceClient) MSetNX(ctx context.Context, req *connect.Request[v1.MSetNXRequest]) (*connect.Response[v1.MSetNXResponse], error) {
+	return c.mSetNX.CallUnary(ctx, req)
 }
 
 // Ping calls cloud.v1.RedisService.Ping.
@@ -186,6 +1737,16 @@ func (c *redisServiceClient) Restore(ctx context.Context, req *connect.Request[v
 	return c.restore.CallUnary(ctx, req)
 }
 
+// BackupStream calls cloud.v1.RedisService.BackupStream.
+func (c *redisServiceClient) BackupStream(ctx context.Context, req *connect.Request[v1.BackupStreamRequest]) (*connect.ServerStreamForClient[v1.BackupStreamResponse], error) {
+	return c.backupStream.CallServerStream(ctx, req)
+}
+
+// RestoreStream calls cloud.v1.RedisService.RestoreStream.
+func (c *redisServiceClient) RestoreStream(ctx context.Context) *connect.ClientStreamForClient[v1.RestoreStreamRequest, v1.RestoreStreamResponse] {
+	return c.restoreStream.CallClientStream(ctx)
+}
+
 // Join calls cloud.v1.RedisService.Join.
 func (c *redisServiceClient) Join(ctx context.Context, req *connect.Request[v1.JoinRequest]) (*connect.Response[v1.JoinResponse], error) {
 	return c.join.CallUnary(ctx, req)
@@ -201,14 +1762,223 @@ type RedisServiceHandler interface {
 	Del(context.Context, *connect.Request[v1.DelRequest]) (*connect.Response[v1.DelResponse], error)
 	// Incr increments the integer value of a key
 	Incr(context.Context, *connect.Request[v1.IncrRequest]) (*connect.Response[v1.IncrResponse], error)
+	// IncrBy increments the integer value of a key by the given amount
+	IncrBy(context.Context, *connect.Request[v1.IncrByRequest]) (*connect.Response[v1.IncrByResponse], error)
+	// Decr decrements the integer value of a key
+	Decr(context.Context, *connect.Request[v1.DecrRequest]) (*connect.Response[v1.DecrResponse], error)
+	// DecrBy decrements the integer value of a key by the given amount
+	DecrBy(context.Context, *connect.Request[v1.DecrByRequest]) (*connect.Response[v1.DecrByResponse], error)
+	// IncrByFloat increments the floating point value of a key by the given amount
+	IncrByFloat(context.Context, *connect.Request[v1.IncrByFloatRequest]) (*connect.Response[v1.IncrByFloatResponse], error)
 	// Expire sets a timeout on a key
 	Expire(context.Context, *connect.Request[v1.ExpireRequest]) (*connect.Response[v1.ExpireResponse], error)
+	// ExpireAt sets the Unix time in seconds at which a key expires
+	ExpireAt(context.Context, *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error)
+	// PExpireAt sets the Unix time in milliseconds at which a key expires
+	PExpireAt(context.Context, *connect.Request[v1.PExpireAtRequest]) (*connect.Response[v1.PExpireAtResponse], error)
+	// Ttl returns the remaining time to live of a key in seconds
+	Ttl(context.Context, *connect.Request[v1.TtlRequest]) (*connect.Response[v1.TtlResponse], error)
+	// Pttl returns the remaining time to live of a key in milliseconds
+	Pttl(context.Context, *connect.Request[v1.PttlRequest]) (*connect.Response[v1.PttlResponse], error)
+	// Persist removes the timeout from a key
+	Persist(context.Context, *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error)
+	// HSet sets fields of a hash
+	HSet(context.Context, *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error)
+	// HGet retrieves the value of a hash field
+	HGet(context.Context, *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error)
+	// HDel deletes fields from a hash
+	HDel(context.Context, *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error)
+	// HGetAll retrieves all fields and values of a hash
+	HGetAll(context.Context, *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error)
+	// HIncrBy increments the integer value of a hash field by the given amount
+	HIncrBy(context.Context, *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error)
+	// HScan incrementally iterates over the fields of a hash
+	HScan(context.Context, *connect.Request[v1.HScanRequest]) (*connect.Response[v1.HScanResponse], error)
+	// LPush inserts values at the head of a list
+	LPush(context.Context, *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error)
+	// RPush inserts values at the tail of a list
+	RPush(context.Context, *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error)
+	// LPop removes and returns elements from the head of a list
+	LPop(context.Context, *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error)
+	// RPop removes and returns elements from the tail of a list
+	RPop(context.Context, *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error)
+	// LRange retrieves a range of elements from a list
+	LRange(context.Context, *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error)
+	// LLen returns the length of a list
+	LLen(context.Context, *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error)
+	// LTrim trims a list to the given range
+	LTrim(context.Context, *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error)
+	// LIndex retrieves an element from a list by its index
+	LIndex(context.Context, *connect.Request[v1.LIndexRequest]) (*connect.Response[v1.LIndexResponse], error)
+	// BLPop removes and returns the head of the first non-empty list, waiting until one is available
+	BLPop(context.Context, *connect.Request[v1.BLPopRequest]) (*connect.Response[v1.BLPopResponse], error)
+	// BRPop removes and returns the tail of the first non-empty list, waiting until one is available
+	BRPop(context.Context, *connect.Request[v1.BRPopRequest]) (*connect.Response[v1.BRPopResponse], error)
+	// SAdd adds members to a set
+	SAdd(context.Context, *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error)
+	// SRem removes members from a set
+	SRem(context.Context, *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error)
+	// SIsMember checks whether a member belongs to a set
+	SIsMember(context.Context, *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error)
+	// SMembers retrieves all members of a set
+	SMembers(context.Context, *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error)
+	// SCard returns the number of members of a set
+	SCard(context.Context, *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error)
+	// SPop removes and returns random members of a set
+	SPop(context.Context, *connect.Request[v1.SPopRequest]) (*connect.Response[v1.SPopResponse], error)
+	// SRandMember returns random members of a set without removing them
+	SRandMember(context.Context, *connect.Request[v1.SRandMemberRequest]) (*connect.Response[v1.SRandMemberResponse], error)
+	// SInter returns the intersection of sets
+	SInter(context.Context, *connect.Request[v1.SInterRequest]) (*connect.Response[v1.SInterResponse], error)
+	// SUnion returns the union of sets
+	SUnion(context.Context, *connect.Request[v1.SUnionRequest]) (*connect.Response[v1.SUnionResponse], error)
+	// SDiff returns the difference between the first set and the others
+	SDiff(context.Context, *connect.Request[v1.SDiffRequest]) (*connect.Response[v1.SDiffResponse], error)
+	// SInterStore stores the intersection of sets in a destination key
+	SInterStore(context.Context, *connect.Request[v1.SInterStoreRequest]) (*connect.Response[v1.SInterStoreResponse], error)
+	// SUnionStore stores the union of sets in a destination key
+	SUnionStore(context.Context, *connect.Request[v1.SUnionStoreRequest]) (*connect.Response[v1.SUnionStoreResponse], error)
+	// SDiffStore stores the difference of sets in a destination key
+	SDiffStore(context.Context, *connect.Request[v1.SDiffStoreRequest]) (*connect.Response[v1.SDiffStoreResponse], error)
+	// ZAdd adds members to a sorted set or updates their scores
+	ZAdd(context.Context, *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error)
+	// ZRem removes members from a sorted set
+	ZRem(context.Context, *connect.Request[v1.ZRemRequest]) (*connect.Response[v1.ZRemResponse], error)
+	// ZScore retrieves the score of a sorted set member
+	ZScore(context.Context, *connect.Request[v1.ZScoreRequest]) (*connect.Response[v1.ZScoreResponse], error)
+	// ZRank retrieves the rank of a sorted set member
+	ZRank(context.Context, *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error)
+	// ZRange retrieves sorted set members by rank, score or lex range
+	ZRange(context.Context, *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error)
+	// ZCount counts the sorted set members within a score range
+	ZCount(context.Context, *connect.Request[v1.ZCountRequest]) (*connect.Response[v1.ZCountResponse], error)
+	// ZPopMin removes and returns the members with the lowest scores
+	ZPopMin(context.Context, *connect.Request[v1.ZPopMinRequest]) (*connect.Response[v1.ZPopMinResponse], error)
+	// ZPopMax removes and returns the members with the highest scores
+	ZPopMax(context.Context, *connect.Request[v1.ZPopMaxRequest]) (*connect.Response[v1.ZPopMaxResponse], error)
+	// XAdd appends an entry to a stream
+	XAdd(context.Context, *connect.Request[v1.XAddRequest]) (*connect.Response[v1.XAddResponse], error)
+	// XRange retrieves stream entries within an ID range
+	XRange(context.Context, *connect.Request[v1.XRangeRequest]) (*connect.Response[v1.XRangeResponse], error)
+	// XRevRange retrieves stream entries within an ID range, newest first
+	XRevRange(context.Context, *connect.Request[v1.XRevRangeRequest]) (*connect.Response[v1.XRevRangeResponse], error)
+	// XLen returns the number of entries in a stream
+	XLen(context.Context, *connect.Request[v1.XLenRequest]) (*connect.Response[v1.XLenResponse], error)
+	// XTrim removes the oldest entries of a stream
+	XTrim(context.Context, *connect.Request[v1.XTrimRequest]) (*connect.Response[v1.XTrimResponse], error)
+	// XGroupCreate creates a consumer group on a stream
+	XGroupCreate(context.Context, *connect.Request[v1.XGroupCreateRequest]) (*connect.Response[v1.XGroupCreateResponse], error)
+	// XReadGroup reads stream entries on behalf of a consumer of a group
+	XReadGroup(context.Context, *connect.Request[v1.XReadGroupRequest]) (*connect.Response[v1.XReadGroupResponse], error)
+	// XAck acknowledges entries delivered to a consumer group
+	XAck(context.Context, *connect.Request[v1.XAckRequest]) (*connect.Response[v1.XAckResponse], error)
+	// XPending inspects the pending entries of a consumer group
+	XPending(context.Context, *connect.Request[v1.XPendingRequest]) (*connect.Response[v1.XPendingResponse], error)
+	// XClaim transfers idle pending entries to another consumer
+	XClaim(context.Context, *connect.Request[v1.XClaimRequest]) (*connect.Response[v1.XClaimResponse], error)
+	// SetBit sets or clears a bit of a string value
+	SetBit(context.Context, *connect.Request[v1.SetBitRequest]) (*connect.Response[v1.SetBitResponse], error)
+	// GetBit retrieves a bit of a string value
+	GetBit(context.Context, *connect.Request[v1.GetBitRequest]) (*connect.Response[v1.GetBitResponse], error)
+	// BitCount counts the set bits of a string value
+	BitCount(context.Context, *connect.Request[v1.BitCountRequest]) (*connect.Response[v1.BitCountResponse], error)
+	// BitPos finds the first set or clear bit of a string value
+	BitPos(context.Context, *connect.Request[v1.BitPosRequest]) (*connect.Response[v1.BitPosResponse], error)
+	// BitOp stores a bitwise operation between string values in a destination key
+	BitOp(context.Context, *connect.Request[v1.BitOpRequest]) (*connect.Response[v1.BitOpResponse], error)
+	// PFAdd adds elements to a HyperLogLog
+	PFAdd(context.Context, *connect.Request[v1.PFAddRequest]) (*connect.Response[v1.PFAddResponse], error)
+	// PFCount estimates the number of distinct elements in HyperLogLogs
+	PFCount(context.Context, *connect.Request[v1.PFCountRequest]) (*connect.Response[v1.PFCountResponse], error)
+	// PFMerge merges HyperLogLogs into a destination key
+	PFMerge(context.Context, *connect.Request[v1.PFMergeRequest]) (*connect.Response[v1.PFMergeResponse], error)
+	// GeoAdd adds members with coordinates to a geo index
+	GeoAdd(context.Context, *connect.Request[v1.GeoAddRequest]) (*connect.Response[v1.GeoAddResponse], error)
+	// GeoDist returns the distance between two members of a geo index
+	GeoDist(context.Context, *connect.Request[v1.GeoDistRequest]) (*connect.Response[v1.GeoDistResponse], error)
+	// GeoSearch finds the members of a geo index within a radius or box
+	GeoSearch(context.Context, *connect.Request[v1.GeoSearchRequest]) (*connect.Response[v1.GeoSearchResponse], error)
+	// JSONSet sets a value at a path in a JSON document
+	JSONSet(context.Context, *connect.Request[v1.JSONSetRequest]) (*connect.Response[v1.JSONSetResponse], error)
+	// JSONGet retrieves the values at paths in a JSON document
+	JSONGet(context.Context, *connect.Request[v1.JSONGetRequest]) (*connect.Response[v1.JSONGetResponse], error)
+	// JSONDel deletes the values at a path in a JSON document
+	JSONDel(context.Context, *connect.Request[v1.JSONDelRequest]) (*connect.Response[v1.JSONDelResponse], error)
+	// JSONNumIncrBy increments the numbers at a path in a JSON document
+	JSONNumIncrBy(context.Context, *connect.Request[v1.JSONNumIncrByRequest]) (*connect.Response[v1.JSONNumIncrByResponse], error)
+	// JSONArrAppend appends values to the arrays at a path in a JSON document
+	JSONArrAppend(context.Context, *connect.Request[v1.JSONArrAppendRequest]) (*connect.Response[v1.JSONArrAppendResponse], error)
+	// BFReserve creates an empty Bloom filter
+	BFReserve(context.Context, *connect.Request[v1.BFReserveRequest]) (*connect.Response[v1.BFReserveResponse], error)
+	// BFAdd adds items to a Bloom filter
+	BFAdd(context.Context, *connect.Request[v1.BFAddRequest]) (*connect.Response[v1.BFAddResponse], error)
+	// BFExists checks whether items may be in a Bloom filter
+	BFExists(context.Context, *connect.Request[v1.BFExistsRequest]) (*connect.Response[v1.BFExistsResponse], error)
+	// CFAdd adds items to a Cuckoo filter
+	CFAdd(context.Context, *connect.Request[v1.CFAddRequest]) (*connect.Response[v1.CFAddResponse], error)
+	// CFDel deletes an item from a Cuckoo filter
+	CFDel(context.Context, *connect.Request[v1.CFDelRequest]) (*connect.Response[v1.CFDelResponse], error)
+	// CFExists checks whether items may be in a Cuckoo filter
+	CFExists(context.Context, *connect.Request[v1.CFExistsRequest]) (*connect.Response[v1.CFExistsResponse], error)
+	// CMSInitByDim creates a Count-Min Sketch with the given dimensions
+	CMSInitByDim(context.Context, *connect.Request[v1.CMSInitByDimRequest]) (*connect.Response[v1.CMSInitByDimResponse], error)
+	// CMSIncrBy increments the counts of items in a Count-Min Sketch
+	CMSIncrBy(context.Context, *connect.Request[v1.CMSIncrByRequest]) (*connect.Response[v1.CMSIncrByResponse], error)
+	// CMSQuery returns the estimated counts of items in a Count-Min Sketch
+	CMSQuery(context.Context, *connect.Request[v1.CMSQueryRequest]) (*connect.Response[v1.CMSQueryResponse], error)
+	// TopKReserve creates an empty Top-K sketch
+	TopKReserve(context.Context, *connect.Request[v1.TopKReserveRequest]) (*connect.Response[v1.TopKReserveResponse], error)
+	// TopKAdd adds items to a Top-K sketch
+	TopKAdd(context.Context, *connect.Request[v1.TopKAddRequest]) (*connect.Response[v1.TopKAddResponse], error)
+	// TopKQuery checks whether items are among the top k of a sketch
+	TopKQuery(context.Context, *connect.Request[v1.TopKQueryRequest]) (*connect.Response[v1.TopKQueryResponse], error)
+	// TopKList returns the top k items of a sketch
+	TopKList(context.Context, *connect.Request[v1.TopKListRequest]) (*connect.Response[v1.TopKListResponse], error)
+	// TDigestCreate creates an empty t-digest
+	TDigestCreate(context.Context, *connect.Request[v1.TDigestCreateRequest]) (*connect.Response[v1.TDigestCreateResponse], error)
+	// TDigestAdd adds samples to a t-digest
+	TDigestAdd(context.Context, *connect.Request[v1.TDigestAddRequest]) (*connect.Response[v1.TDigestAddResponse], error)
+	// TDigestQuantile returns the estimated values at quantiles of a t-digest
+	TDigestQuantile(context.Context, *connect.Request[v1.TDigestQuantileRequest]) (*connect.Response[v1.TDigestQuantileResponse], error)
+	// TDigestCDF returns the estimated fractions of samples at most the given values
+	TDigestCDF(context.Context, *connect.Request[v1.TDigestCDFRequest]) (*connect.Response[v1.TDigestCDFResponse], error)
+	// TSAdd adds a sample to a time series
+	TSAdd(context.Context, *connect.Request[v1.TSAddRequest]) (*connect.Response[v1.TSAddResponse], error)
+	// TSRange returns the samples of a time series within a time range
+	TSRange(context.Context, *connect.Request[v1.TSRangeRequest]) (*connect.Response[v1.TSRangeResponse], error)
+	// TSMRange returns the samples within a time range of the time series matching label filters
+	TSMRange(context.Context, *connect.Request[v1.TSMRangeRequest]) (*connect.Response[v1.TSMRangeResponse], error)
+	// Append appends a value to a string
+	Append(context.Context, *connect.Request[v1.AppendRequest]) (*connect.Response[v1.AppendResponse], error)
+	// GetRange returns a substring of a string
+	GetRange(context.Context, *connect.Request[v1.GetRangeRequest]) (*connect.Response[v1.GetRangeResponse], error)
+	// SetRange overwrites part of a string starting at an offset
+	SetRange(context.Context, *connect.Request[v1.SetRangeRequest]) (*connect.Response[v1.SetRangeResponse], error)
+	// StrLen returns the length of a string
+	StrLen(context.Context, *connect.Request[v1.StrLenRequest]) (*connect.Response[v1.StrLenResponse], error)
+	// GetDel returns the value of a key and deletes it
+	GetDel(context.Context, *connect.Request[v1.GetDelRequest]) (*connect.Response[v1.GetDelResponse], error)
+	// GetEx returns the value of a key and updates its timeout
+	GetEx(context.Context, *connect.Request[v1.GetExRequest]) (*connect.Response[v1.GetExResponse], error)
+	// MGet returns the values of several keys
+	MGet(context.Context, *connect.Request[v1.MGetRequest]) (*connect.Response[v1.MGetResponse], error)
+	// MSet sets several keys at once
+	MSet(context.Context, *connect.Request[v1.MSetRequest]) (*connect.Response[v1.MSetResponse], error)
+	// MSetNX sets several keys at once if none of them exists
+	MSetNX(context.Context, *connect.Request[v1.MSetNXRequest]) (*connect.Response[v1.MSetNXResponse], error)
 	// Ping checks if the server is responsive
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
 	// Backup creates a backup of the current dataset
 	Backup(context.Context, *connect.Request[v1.BackupRequest]) (*connect.Response[v1.BackupResponse], error)
-	// Restore rebuilds the dataset from a backup file
+	// Restore rebuilds the dataset from a backup file. It is a disaster recovery
+	// operation: the leader commits ahead of its followers until they install
+	// the backup, so it should only be used on a fresh cluster.
 	Restore(context.Context, *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error)
+	// BackupStream creates a backup and streams it to the client in chunks
+	BackupStream(context.Context, *connect.Request[v1.BackupStreamRequest], *connect.ServerStream[v1.BackupStreamResponse]) error
+	// RestoreStream rebuilds the dataset from a backup streamed by the client in chunks.
+	// Like Restore, it is a disaster recovery operation for a fresh cluster.
+	RestoreStream(context.Context, *connect.ClientStream[v1.RestoreStreamRequest]) (*connect.Response[v1.RestoreStreamResponse], error)
 	// Join adds a new node to the cluster
 	Join(context.Context, *connect.Request[v1.JoinRequest]) (*connect.Response[v1.JoinResponse], error)
 }
@@ -224,24 +1994,529 @@ func NewRedisServiceHandler(svc RedisServiceHandler, opts ...connect.HandlerOpti
 		svc.Set,
 		opts...,
 	)
-	redisServiceGetHandler := connect.NewUnaryHandler(
-		RedisServiceGetProcedure,
-		svc.Get,
+	redisServiceGetHandler := connect.NewUnaryHandler(
+		RedisServiceGetProcedure,
+		svc.Get,
+		opts...,
+	)
+	redisServiceDelHandler := connect.NewUnaryHandler(
+		RedisServiceDelProcedure,
+		svc.Del,
+		opts...,
+	)
+	redisServiceIncrHandler := connect.NewUnaryHandler(
+		RedisServiceIncrProcedure,
+		svc.Incr,
+		opts...,
+	)
+	redisServiceIncrByHandler := connect.NewUnaryHandler(
+		RedisServiceIncrByProcedure,
+		svc.IncrBy,
+		opts...,
+	)
+	redisServiceDecrHandler := connect.NewUnaryHandler(
+		RedisServiceDecrProcedure,
+		svc.Decr,
+		opts...,
+	)
+	redisServiceDecrByHandler := connect.NewUnaryHandler(
+		RedisServiceDecrByProcedure,
+		svc.DecrBy,
+		opts...,
+	)
+	redisServiceIncrByFloatHandler := connect.NewUnaryHandler(
+		RedisServiceIncrByFloatProcedure,
+		svc.IncrByFloat,
+		opts...,
+	)
+	redisServiceExpireHandler := connect.NewUnaryHandler(
+		RedisServiceExpireProcedure,
+		svc.Expire,
+		opts...,
+	)
+	redisServiceExpireAtHandler := connect.NewUnaryHandler(
+		RedisServiceExpireAtProcedure,
+		svc.ExpireAt,
+		opts...,
+	)
+	redisServicePExpireAtHandler := connect.NewUnaryHandler(
+		RedisServicePExpireAtProcedure,
+		svc.PExpireAt,
+		opts...,
+	)
+	redisServiceTtlHandler := connect.NewUnaryHandler(
+		RedisServiceTtlProcedure,
+		svc.Ttl,
+		opts...,
+	)
+	redisServicePttlHandler := connect.NewUnaryHandler(
+		RedisServicePttlProcedure,
+		svc.Pttl,
+		opts...,
+	)
+	redisServicePersistHandler := connect.NewUnaryHandler(
+		RedisServicePersistProcedure,
+		svc.Persist,
+		opts...,
+	)
+	redisServiceHSetHandler := connect.NewUnaryHandler(
+		RedisServiceHSetProcedure,
+		svc.HSet,
+		opts...,
+	)
+	redisServiceHGetHandler := connect.NewUnaryHandler(
+		RedisServiceHGetProcedure,
+		svc.HGet,
+		opts...,
+	)
+	redisServiceHDelHandler := connect.NewUnaryHandler(
+		RedisServiceHDelProcedure,
+		svc.HDel,
+		opts...,
+	)
+	redisServiceHGetAllHandler := connect.NewUnaryHandler(
+		RedisServiceHGetAllProcedure,
+		svc.HGetAll,
+		opts...,
+	)
+	redisServiceHIncrByHandler := connect.NewUnaryHandler(
+		RedisServiceHIncrByProcedure,
+		svc.HIncrBy,
+		opts...,
+	)
+	redisServiceHScanHandler := connect.NewUnaryHandler(
+		RedisServiceHScanProcedure,
+		svc.HScan,
+		opts...,
+	)
+	redisServiceLPushHandler := connect.NewUnaryHandler(
+		RedisServiceLPushProcedure,
+		svc.LPush,
+		opts...,
+	)
+	redisServiceRPushHandler := connect.NewUnaryHandler(
+		RedisServiceRPushProcedure,
+		svc.RPush,
+		opts...,
+	)
+	redisServiceLPopHandler := connect.NewUnaryHandler(
+		RedisServiceLPopProcedure,
+		svc.LPop,
+		opts...,
+	)
+	redisServiceRPopHandler := connect.NewUnaryHandler(
+		RedisServiceRPopProcedure,
+		svc.RPop,
+		opts...,
+	)
+	redisServiceLRangeHandler := connect.NewUnaryHandler(
+		RedisServiceLRangeProcedure,
+		svc.LRange,
+		opts...,
+	)
+	redisServiceLLenHandler := connect.NewUnaryHandler(
+		RedisServiceLLenProcedure,
+		svc.LLen,
+		opts...,
+	)
+	redisServiceLTrimHandler := connect.NewUnaryHandler(
+		RedisServiceLTrimProcedure,
+		svc.LTrim,
+		opts...,
+	)
+	redisServiceLIndexHandler := connect.NewUnaryHandler(
+		RedisServiceLIndexProcedure,
+		svc.LIndex,
+		opts...,
+	)
+	redisServiceBLPopHandler := connect.NewUnaryHandler(
+		RedisServiceBLPopProcedure,
+		svc.BLPop,
+		opts...,
+	)
+	redisServiceBRPopHandler := connect.NewUnaryHandler(
+		RedisServiceBRPopProcedure,
+		svc.BRPop,
+		opts...,
+	)
+	redisServiceSAddHandler := connect.NewUnaryHandler(
+		RedisServiceSAddProcedure,
+		svc.SAdd,
+		opts...,
+	)
+	redisServiceSRemHandler := connect.NewUnaryHandler(
+		RedisServiceSRemProcedure,
+		svc.SRem,
+		opts...,
+	)
+	redisServiceSIsMemberHandler := connect.NewUnaryHandler(
+		RedisServiceSIsMemberProcedure,
+		svc.SIsMember,
+		opts...,
+	)
+	redisServiceSMembersHandler := connect.NewUnaryHandler(
+		RedisServiceSMembersProcedure,
+		svc.SMembers,
+		opts...,
+	)
+	redisServiceSCardHandler := connect.NewUnaryHandler(
+		RedisServiceSCardProcedure,
+		svc.SCard,
+		opts...,
+	)
+	redisServiceSPopHandler := connect.NewUnaryHandler(
+		RedisServiceSPopProcedure,
+		svc.SPop,
+		opts...,
+	)
+	redisServiceSRandMemberHandler := connect.NewUnaryHandler(
+		RedisServiceSRandMemberProcedure,
+		svc.SRandMember,
+		opts...,
+	)
+	redisServiceSInterHandler := connect.NewUnaryHandler(
+		RedisServiceSInterProcedure,
+		svc.SInter,
+		opts...,
+	)
+	redisServiceSUnionHandler := connect.NewUnaryHandler(
+		RedisServiceSUnionProcedure,
+		svc.SUnion,
+		opts...,
+	)
+	redisServiceSDiffHandler := connect.NewUnaryHandler(
+		RedisServiceSDiffProcedure,
+		svc.SDiff,
+		opts...,
+	)
+	redisServiceSInterStoreHandler := connect.NewUnaryHandler(
+		RedisServiceSInterStoreProcedure,
+		svc.SInterStore,
+		opts...,
+	)
+	redisServiceSUnionStoreHandler := connect.NewUnaryHandler(
+		RedisServiceSUnionStoreProcedure,
+		svc.SUnionStore,
+		opts...,
+	)
+	redisServiceSDiffStoreHandler := connect.NewUnaryHandler(
+		RedisServiceSDiffStoreProcedure,
+		svc.SDiffStore,
+		opts...,
+	)
+	redisServiceZAddHandler := connect.NewUnaryHandler(
+		RedisServiceZAddProcedure,
+		svc.ZAdd,
+		opts...,
+	)
+	redisServiceZRemHandler := connect.NewUnaryHandler(
+		RedisServiceZRemProcedure,
+		svc.ZRem,
+		opts...,
+	)
+	redisServiceZScoreHandler := connect.NewUnaryHandler(
+		RedisServiceZScoreProcedure,
+		svc.ZScore,
+		opts...,
+	)
+	redisServiceZRankHandler := connect.NewUnaryHandler(
+		RedisServiceZRankProcedure,
+		svc.ZRank,
+		opts...,
+	)
+	redisServiceZRangeHandler := connect.NewUnaryHandler(
+		RedisServiceZRangeProcedure,
+		svc.ZRange,
+		opts...,
+	)
+	redisServiceZCountHandler := connect.NewUnaryHandler(
+		RedisServiceZCountProcedure,
+		svc.ZCount,
+		opts...,
+	)
+	redisServiceZPopMinHandler := connect.NewUnaryHandler(
+		RedisServiceZPopMinProcedure,
+		svc.ZPopMin,
+		opts...,
+	)
+	redisServiceZPopMaxHandler := connect.NewUnaryHandler(
+		RedisServiceZPopMaxProcedure,
+		svc.ZPopMax,
+		opts...,
+	)
+	redisServiceXAddHandler := connect.NewUnaryHandler(
+		RedisServiceXAddProcedure,
+		svc.XAdd,
+		opts...,
+	)
+	redisServiceXRangeHandler := connect.NewUnaryHandler(
+		RedisServiceXRangeProcedure,
+		svc.XRange,
+		opts...,
+	)
+	redisServiceXRevRangeHandler := connect.NewUnaryHandler(
+		RedisServiceXRevRangeProcedure,
+		svc.XRevRange,
+		opts...,
+	)
+	redisServiceXLenHandler := connect.NewUnaryHandler(
+		RedisServiceXLenProcedure,
+		svc.XLen,
+		opts...,
+	)
+	redisServiceXTrimHandler := connect.NewUnaryHandler(
+		RedisServiceXTrimProcedure,
+		svc.XTrim,
+		opts...,
+	)
+	redisServiceXGroupCreateHandler := connect.NewUnaryHandler(
+		RedisServiceXGroupCreateProcedure,
+		svc.XGroupCreate,
+		opts...,
+	)
+	redisServiceXReadGroupHandler := connect.NewUnaryHandler(
+		RedisServiceXReadGroupProcedure,
+		svc.XReadGroup,
+		opts...,
+	)
+	redisServiceXAckHandler := connect.NewUnaryHandler(
+		RedisServiceXAckProcedure,
+		svc.XAck,
+		opts...,
+	)
+	redisServiceXPendingHandler := connect.NewUnaryHandler(
+		RedisServiceXPendingProcedure,
+		svc.XPending,
+		opts...,
+	)
+	redisServiceXClaimHandler := connect.NewUnaryHandler(
+		RedisServiceXClaimProcedure,
+		svc.XClaim,
+		opts...,
+	)
+	redisServiceSetBitHandler := connect.NewUnaryHandler(
+		RedisServiceSetBitProcedure,
+		svc.SetBit,
+		opts...,
+	)
+	redisServiceGetBitHandler := connect.NewUnaryHandler(
+		RedisServiceGetBitProcedure,
+		svc.GetBit,
+		opts...,
+	)
+	redisServiceBitCountHandler := connect.NewUnaryHandler(
+		RedisServiceBitCountProcedure,
+		svc.BitCount,
+		opts...,
+	)
+	redisServiceBitPosHandler := connect.NewUnaryHandler(
+		RedisServiceBitPosProcedure,
+		svc.BitPos,
+		opts...,
+	)
+	redisServiceBitOpHandler := connect.NewUnaryHandler(
+		RedisServiceBitOpProcedure,
+		svc.BitOp,
+		opts...,
+	)
+	redisServicePFAddHandler := connect.NewUnaryHandler(
+		RedisServicePFAddProcedure,
+		svc.PFAdd,
+		opts...,
+	)
+	redisServicePFCountHandler := connect.NewUnaryHandler(
+		RedisServicePFCountProcedure,
+		svc.PFCount,
+		opts...,
+	)
+	redisServicePFMergeHandler := connect.NewUnaryHandler(
+		RedisServicePFMergeProcedure,
+		svc.PFMerge,
+		opts...,
+	)
+	redisServiceGeoAddHandler := connect.NewUnaryHandler(
+		RedisServiceGeoAddProcedure,
+		svc.GeoAdd,
+		opts...,
+	)
+	redisServiceGeoDistHandler := connect.NewUnaryHandler(
+		RedisServiceGeoDistProcedure,
+		svc.GeoDist,
+		opts...,
+	)
+	redisServiceGeoSearchHandler := connect.NewUnaryHandler(
+		RedisServiceGeoSearchProcedure,
+		svc.GeoSearch,
+		opts...,
+	)
+	redisServiceJSONSetHandler := connect.NewUnaryHandler(
+		RedisServiceJSONSetProcedure,
+		svc.JSONSet,
+		opts...,
+	)
+	redisServiceJSONGetHandler := connect.NewUnaryHandler(
+		RedisServiceJSONGetProcedure,
+		svc.JSONGet,
+		opts...,
+	)
+	redisServiceJSONDelHandler := connect.NewUnaryHandler(
+		RedisServiceJSONDelProcedure,
+		svc.JSONDel,
+		opts...,
+	)
+	redisServiceJSONNumIncrByHandler := connect.NewUnaryHandler(
+		RedisServiceJSONNumIncrByProcedure,
+		svc.JSONNumIncrBy,
+		opts...,
+	)
+	redisServiceJSONArrAppendHandler := connect.NewUnaryHandler(
+		RedisServiceJSONArrAppendProcedure,
+		svc.JSONArrAppend,
+		opts...,
+	)
+	redisServiceBFReserveHandler := connect.NewUnaryHandler(
+		RedisServiceBFReserveProcedure,
+		svc.BFReserve,
+		opts...,
+	)
+	redisServiceBFAddHandler := connect.NewUnaryHandler(
+		RedisServiceBFAddProcedure,
+		svc.BFAdd,
+		opts...,
+	)
+	redisServiceBFExistsHandler := connect.NewUnaryHandler(
+		RedisServiceBFExistsProcedure,
+		svc.BFExists,
+		opts...,
+	)
+	redisServiceCFAddHandler := connect.NewUnaryHandler(
+		RedisServiceCFAddProcedure,
+		svc.CFAdd,
+		opts...,
+	)
+	redisServiceCFDelHandler := connect.NewUnaryHandler(
+		RedisServiceCFDelProcedure,
+		svc.CFDel,
+		opts...,
+	)
+	redisServiceCFExistsHandler := connect.NewUnaryHandler(
+		RedisServiceCFExistsProcedure,
+		svc.CFExists,
+		opts...,
+	)
+	redisServiceCMSInitByDimHandler := connect.NewUnaryHandler(
+		RedisServiceCMSInitByDimProcedure,
+		svc.CMSInitByDim,
+		opts...,
+	)
+	redisServiceCMSIncrByHandler := connect.NewUnaryHandler(
+		RedisServiceCMSIncrByProcedure,
+		svc.CMSIncrBy,
+		opts...,
+	)
+	redisServiceCMSQueryHandler := connect.NewUnaryHandler(
+		RedisServiceCMSQueryProcedure,
+		svc.CMSQuery,
 		opts...,
 	)
-	redisServiceDelHandler := connect.NewUnaryHandler(
-		RedisServiceDelProcedure,
-		svc.Del,
+	redisServiceTopKReserveHandler := connect.NewUnaryHandler(
+		RedisServiceTopKReserveProcedure,
+		svc.TopKReserve,
 		opts...,
 	)
-	redisServiceIncrHandler := connect.NewUnaryHandler(
-		RedisServiceIncrProcedure,
-		svc.Incr,
+	redisServiceTopKAddHandler := connect.NewUnaryHandler(
+		RedisServiceTopKAddProcedure,
+		svc.TopKAdd,
 		opts...,
 	)
-	redisServiceExpireHandler := connect.NewUnaryHandler(
-		RedisServiceExpireProcedure,
-		svc.Expire,
+	redisServiceTopKQueryHandler := connect.NewUnaryHandler(
+		RedisServiceTopKQueryProcedure,
+		svc.TopKQuery,
+		opts...,
+	)
+	redisServiceTopKListHandler := connect.NewUnaryHandler(
+		RedisServiceTopKListProcedure,
+		svc.TopKList,
+		opts...,
+	)
+	redisServiceTDigestCreateHandler := connect.NewUnaryHandler(
+		RedisServiceTDigestCreateProcedure,
+		svc.TDigestCreate,
+		opts...,
+	)
+	redisServiceTDigestAddHandler := connect.NewUnaryHandler(
+		RedisServiceTDigestAddProcedure,
+		svc.TDigestAdd,
+		opts...,
+	)
+	redisServiceTDigestQuantileHandler := connect.NewUnaryHandler(
+		RedisServiceTDigestQuantileProcedure,
+		svc.TDigestQuantile,
+		opts...,
+	)
+	redisServiceTDigestCDFHandler := connect.NewUnaryHandler(
+		RedisServiceTDigestCDFProcedure,
+		svc.TDigestCDF,
+		opts...,
+	)
+	redisServiceTSAddHandler := connect.NewUnaryHandler(
+		RedisServiceTSAddProcedure,
+		svc.TSAdd,
+		opts...,
+	)
+	redisServiceTSRangeHandler := connect.NewUnaryHandler(
+		RedisServiceTSRangeProcedure,
+		svc.TSRange,
+		opts...,
+	)
+	redisServiceTSMRangeHandler := connect.NewUnaryHandler(
+		RedisServiceTSMRangeProcedure,
+		svc.TSMRange,
+		opts...,
+	)
+	redisServiceAppendHandler := connect.NewUnaryHandler(
+		RedisServiceAppendProcedure,
+		svc.Append,
+		opts...,
+	)
+	redisServiceGetRangeHandler := connect.NewUnaryHandler(
+		RedisServiceGetRangeProcedure,
+		svc.GetRange,
+		opts...,
+	)
+	redisServiceSetRangeHandler := connect.NewUnaryHandler(
+		RedisServiceSetRangeProcedure,
+		svc.SetRange,
+		opts...,
+	)
+	redisServiceStrLenHandler := connect.NewUnaryHandler(
+		RedisServiceStrLenProcedure,
+		svc.StrLen,
+		opts...,
+	)
+	redisServiceGetDelHandler := connect.NewUnaryHandler(
+		RedisServiceGetDelProcedure,
+		svc.GetDel,
+		opts...,
+	)
+	redisServiceGetExHandler := connect.NewUnaryHandler(
+		RedisServiceGetExProcedure,
+		svc.GetEx,
+		opts...,
+	)
+	redisServiceMGetHandler := connect.NewUnaryHandler(
+		RedisServiceMGetProcedure,
+		svc.MGet,
+		opts...,
+	)
+	redisServiceMSetHandler := connect.NewUnaryHandler(
+		RedisServiceMSetProcedure,
+		svc.MSet,
+		opts...,
+	)
+	redisServiceMSetNXHandler := connect.NewUnaryHandler(
+		RedisServiceMSetNXProcedure,
+		svc.MSetNX,
 		opts...,
 	)
 	redisServicePingHandler := connect.NewUnaryHandler(
@@ -259,6 +2534,16 @@ func NewRedisServiceHandler(svc RedisServiceHandler, opts ...connect.HandlerOpti
 		svc.Restore,
 		opts...,
 	)
+	redisServiceBackupStreamHandler := connect.NewServerStreamHandler(
+		RedisServiceBackupStreamProcedure,
+		svc.BackupStream,
+		opts...,
+	)
+	redisServiceRestoreStreamHandler := connect.NewClientStreamHandler(
+		RedisServiceRestoreStreamProcedure,
+		svc.RestoreStream,
+		opts...,
+	)
 	redisServiceJoinHandler := connect.NewUnaryHandler(
 		RedisServiceJoinProcedure,
 		svc.Join,
@@ -274,14 +2559,220 @@ func NewRedisServiceHandler(svc RedisServiceHandler, opts ...connect.HandlerOpti
 			redisServiceDelHandler.ServeHTTP(w, r)
 		case RedisServiceIncrProcedure:
 			redisServiceIncrHandler.ServeHTTP(w, r)
+		case RedisServiceIncrByProcedure:
+			redisServiceIncrByHandler.ServeHTTP(w, r)
+		case RedisServiceDecrProcedure:
+			redisServiceDecrHandler.ServeHTTP(w, r)
+		case RedisServiceDecrByProcedure:
+			redisServiceDecrByHandler.ServeHTTP(w, r)
+		case RedisServiceIncrByFloatProcedure:
+			redisServiceIncrByFloatHandler.ServeHTTP(w, r)
 		case RedisServiceExpireProcedure:
 			redisServiceExpireHandler.ServeHTTP(w, r)
+		case RedisServiceExpireAtProcedure:
+			redisServiceExpireAtHandler.ServeHTTP(w, r)
+		case RedisServicePExpireAtProcedure:
+			redisServicePExpireAtHandler.ServeHTTP(w, r)
+		case RedisServiceTtlProcedure:
+			redisServiceTtlHandler.ServeHTTP(w, r)
+		case RedisServicePttlProcedure:
+			redisServicePttlHandler.ServeHTTP(w, r)
+		case RedisServicePersistProcedure:
+			redisServicePersistHandler.ServeHTTP(w, r)
+		case RedisServiceHSetProcedure:
+			redisServiceHSetHandler.ServeHTTP(w, r)
+		case RedisServiceHGetProcedure:
+			redisServiceHGetHandler.ServeHTTP(w, r)
+		case RedisServiceHDelProcedure:
+			redisServiceHDelHandler.ServeHTTP(w, r)
+		case RedisServiceHGetAllProcedure:
+			redisServiceHGetAllHandler.ServeHTTP(w, r)
+		case RedisServiceHIncrByProcedure:
+			redisServiceHIncrByHandler.ServeHTTP(w, r)
+		case RedisServiceHScanProcedure:
+			redisServiceHScanHandler.ServeHTTP(w, r)
+		case RedisServiceLPushProcedure:
+			redisServiceLPushHandler.ServeHTTP(w, r)
+		case RedisServiceRPushProcedure:
+			redisServiceRPushHandler.ServeHTTP(w, r)
+		case RedisServiceLPopProcedure:
+			redisServiceLPopHandler.ServeHTTP(w, r)
+		case RedisServiceRPopProcedure:
+			redisServiceRPopHandler.ServeHTTP(w, r)
+		case RedisServiceLRangeProcedure:
+			redisServiceLRangeHandler.ServeHTTP(w, r)
+		case RedisServiceLLenProcedure:
+			redisServiceLLenHandler.ServeHTTP(w, r)
+		case RedisServiceLTrimProcedure:
+			redisServiceLTrimHandler.ServeHTTP(w, r)
+		case RedisServiceLIndexProcedure:
+			redisServiceLIndexHandler.ServeHTTP(w, r)
+		case RedisServiceBLPopProcedure:
+			redisServiceBLPopHandler.ServeHTTP(w, r)
+		case RedisServiceBRPopProcedure:
+			redisServiceBRPopHandler.ServeHTTP(w, r)
+		case RedisServiceSAddProcedure:
+			redisServiceSAddHandler.ServeHTTP(w, r)
+		case RedisServiceSRemProcedure:
+			redisServiceSRemHandler.ServeHTTP(w, r)
+		case RedisServiceSIsMemberProcedure:
+			redisServiceSIsMemberHandler.ServeHTTP(w, r)
+		case RedisServiceSMembersProcedure:
+			redisServiceSMembersHandler.ServeHTTP(w, r)
+		case RedisServiceSCardProcedure:
+			redisServiceSCardHandler.ServeHTTP(w, r)
+		case RedisServiceSPopProcedure:
+			redisServiceSPopHandler.ServeHTTP(w, r)
+		case RedisServiceSRandMemberProcedure:
+			redisServiceSRandMemberHandler.ServeHTTP(w, r)
+		case RedisServiceSInterProcedure:
+			redisServiceSInterHandler.ServeHTTP(w, r)
+		case RedisServiceSUnionProcedure:
+			redisServiceSUnionHandler.ServeHTTP(w, r)
+		case RedisServiceSDiffProcedure:
+			redisServiceSDiffHandler.ServeHTTP(w, r)
+		case RedisServiceSInterStoreProcedure:
+			redisServiceSInterStoreHandler.ServeHTTP(w, r)
+		case RedisServiceSUnionStoreProcedure:
+			redisServiceSUnionStoreHandler.ServeHTTP(w, r)
+		case RedisServiceSDiffStoreProcedure:
+			redisServiceSDiffStoreHandler.ServeHTTP(w, r)
+		case RedisServiceZAddProcedure:
+			redisServiceZAddHandler.ServeHTTP(w, r)
+		case RedisServiceZRemProcedure:
+			redisServiceZRemHandler.ServeHTTP(w, r)
+		case RedisServiceZScoreProcedure:
+			redisServiceZScoreHandler.ServeHTTP(w, r)
+		case RedisServiceZRankProcedure:
+			redisServiceZRankHandler.ServeHTTP(w, r)
+		case RedisServiceZRangeProcedure:
+			redisServiceZRangeHandler.ServeHTTP(w, r)
+		case RedisServiceZCountProcedure:
+			redisServiceZCountHandler.ServeHTTP(w, r)
+		case RedisServiceZPopMinProcedure:
+			redisServiceZPopMinHandler.ServeHTTP(w, r)
+		case RedisServiceZPopMaxProcedure:
+			redisServiceZPopMaxHandler.ServeHTTP(w, r)
+		case RedisServiceXAddProcedure:
+			redisServiceXAddHandler.ServeHTTP(w, r)
+		case RedisServiceXRangeProcedure:
+			redisServiceXRangeHandler.ServeHTTP(w, r)
+		case RedisServiceXRevRangeProcedure:
+			redisServiceXRevRangeHandler.ServeHTTP(w, r)
+		case RedisServiceXLenProcedure:
+			redisServiceXLenHandler.ServeHTTP(w, r)
+		case RedisServiceXTrimProcedure:
+			redisServiceXTrimHandler.ServeHTTP(w, r)
+		case RedisServiceXGroupCreateProcedure:
+			redisServiceXGroupCreateHandler.ServeHTTP(w, r)
+		case RedisServiceXReadGroupProcedure:
+			redisServiceXReadGroupHandler.ServeHTTP(w, r)
+		case RedisServiceXAckProcedure:
+			redisServiceXAckHandler.ServeHTTP(w, r)
+		case RedisServiceXPendingProcedure:
+			redisServiceXPendingHandler.ServeHTTP(w, r)
+		case RedisServiceXClaimProcedure:
+			redisServiceXClaimHandler.ServeHTTP(w, r)
+		case RedisServiceSetBitProcedure:
+			redisServiceSetBitHandler.ServeHTTP(w, r)
+		case RedisServiceGetBitProcedure:
+			redisServiceGetBitHandler.ServeHTTP(w, r)
+		case RedisServiceBitCountProcedure:
+			redisServiceBitCountHandler.ServeHTTP(w, r)
+		case RedisServiceBitPosProcedure:
+			redisServiceBitPosHandler.ServeHTTP(w, r)
+		case RedisServiceBitOpProcedure:
+			redisServiceBitOpHandler.ServeHTTP(w, r)
+		case RedisServicePFAddProcedure:
+			redisServicePFAddHandler.ServeHTTP(w, r)
+		case RedisServicePFCountProcedure:
+			redisServicePFCountHandler.ServeHTTP(w, r)
+		case RedisServicePFMergeProcedure:
+			redisServicePFMergeHandler.ServeHTTP(w, r)
+		case RedisServiceGeoAddProcedure:
+			redisServiceGeoAddHandler.ServeHTTP(w, r)
+		case RedisServiceGeoDistProcedure:
+			redisServiceGeoDistHandler.ServeHTTP(w, r)
+		case RedisServiceGeoSearchProcedure:
+			redisServiceGeoSearchHandler.ServeHTTP(w, r)
+		case RedisServiceJSONSetProcedure:
+			redisServiceJSONSetHandler.ServeHTTP(w, r)
+		case RedisServiceJSONGetProcedure:
+			redisServiceJSONGetHandler.ServeHTTP(w, r)
+		case RedisServiceJSONDelProcedure:
+			redisServiceJSONDelHandler.ServeHTTP(w, r)
+		case RedisServiceJSONNumIncrByProcedure:
+			redisServiceJSONNumIncrByHandler.ServeHTTP(w, r)
+		case RedisServiceJSONArrAppendProcedure:
+			redisServiceJSONArrAppendHandler.ServeHTTP(w, r)
+		case RedisServiceBFReserveProcedure:
+			redisServiceBFReserveHandler.ServeHTTP(w, r)
+		case RedisServiceBFAddProcedure:
+			redisServiceBFAddHandler.ServeHTTP(w, r)
+		case RedisServiceBFExistsProcedure:
+			redisServiceBFExistsHandler.ServeHTTP(w, r)
+		case RedisServiceCFAddProcedure:
+			redisServiceCFAddHandler.ServeHTTP(w, r)
+		case RedisServiceCFDelProcedure:
+			redisServiceCFDelHandler.ServeHTTP(w, r)
+		case RedisServiceCFExistsProcedure:
+			redisServiceCFExistsHandler.ServeHTTP(w, r)
+		case RedisServiceCMSInitByDimProcedure:
+			redisServiceCMSInitByDimHandler.ServeHTTP(w, r)
+		case RedisServiceCMSIncrByProcedure:
+			redisServiceCMSIncrByHandler.ServeHTTP(w, r)
+		case RedisServiceCMSQueryProcedure:
+			redisServiceCMSQueryHandler.ServeHTTP(w, r)
+		case RedisServiceTopKReserveProcedure:
+			redisServiceTopKReserveHandler.ServeHTTP(w, r)
+		case RedisServiceTopKAddProcedure:
+			redisServiceTopKAddHandler.ServeHTTP(w, r)
+		case RedisServiceTopKQueryProcedure:
+			redisServiceTopKQueryHandler.ServeHTTP(w, r)
+		case RedisServiceTopKListProcedure:
+			redisServiceTopKListHandler.ServeHTTP(w, r)
+		case RedisServiceTDigestCreateProcedure:
+			redisServiceTDigestCreateHandler.ServeHTTP(w, r)
+		case RedisServiceTDigestAddProcedure:
+			redisServiceTDigestAddHandler.ServeHTTP(w, r)
+		case RedisServiceTDigestQuantileProcedure:
+			redisServiceTDigestQuantileHandler.ServeHTTP(w, r)
+		case RedisServiceTDigestCDFProcedure:
+			redisServiceTDigestCDFHandler.ServeHTTP(w, r)
+		case RedisServiceTSAddProcedure:
+			redisServiceTSAddHandler.ServeHTTP(w, r)
+		case RedisServiceTSRangeProcedure:
+			redisServiceTSRangeHandler.ServeHTTP(w, r)
+		case RedisServiceTSMRangeProcedure:
+			redisServiceTSMRangeHandler.ServeHTTP(w, r)
+		case RedisServiceAppendProcedure:
+			redisServiceAppendHandler.ServeHTTP(w, r)
+		case RedisServiceGetRangeProcedure:
+			redisServiceGetRangeHandler.ServeHTTP(w, r)
+		case RedisServiceSetRangeProcedure:
+			redisServiceSetRangeHandler.ServeHTTP(w, r)
+		case RedisServiceStrLenProcedure:
+			redisServiceStrLenHandler.ServeHTTP(w, r)
+		case RedisServiceGetDelProcedure:
+			redisServiceGetDelHandler.ServeHTTP(w, r)
+		case RedisServiceGetExProcedure:
+			redisServiceGetExHandler.ServeHTTP(w, r)
+		case RedisServiceMGetProcedure:
+			redisServiceMGetHandler.ServeHTTP(w, r)
+		case RedisServiceMSetProcedure:
+			redisServiceMSetHandler.ServeHTTP(w, r)
+		case RedisServiceMSetNXProcedure:
+			redisServiceMSetNXHandler.ServeHTTP(w, r)
 		case RedisServicePingProcedure:
 			redisServicePingHandler.ServeHTTP(w, r)
 		case RedisServiceBackupProcedure:
 			redisServiceBackupHandler.ServeHTTP(w, r)
 		case RedisServiceRestoreProcedure:
 			redisServiceRestoreHandler.ServeHTTP(w, r)
+		case RedisServiceBackupStreamProcedure:
+			redisServiceBackupStreamHandler.ServeHTTP(w, r)
+		case RedisServiceRestoreStreamProcedure:
+			redisServiceRestoreStreamHandler.ServeHTTP(w, r)
 		case RedisServiceJoinProcedure:
 			redisServiceJoinHandler.ServeHTTP(w, r)
 		default:
@@ -309,10 +2800,414 @@ func (UnimplementedRedisServiceHandler) Incr(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Incr is not implemented"))
 }
 
+func (UnimplementedRedisServiceHandler) IncrBy(context.Context, *connect.Request[v1.IncrByRequest]) (*connect.Response[v1.IncrByResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.IncrBy is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) Decr(context.Context, *connect.Request[v1.DecrRequest]) (*connect.Response[v1.DecrResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Decr is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) DecrBy(context.Context, *connect.Request[v1.DecrByRequest]) (*connect.Response[v1.DecrByResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.DecrBy is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) IncrByFloat(context.Context, *connect.Request[v1.IncrByFloatRequest]) (*connect.Response[v1.IncrByFloatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.IncrByFloat is not implemented"))
+}
+
 func (UnimplementedRedisServiceHandler) Expire(context.Context, *connect.Request[v1.ExpireRequest]) (*connect.Response[v1.ExpireResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Expire is not implemented"))
 }
 
+func (UnimplementedRedisServiceHandler) ExpireAt(context.Context, *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ExpireAt is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) PExpireAt(context.Context, *connect.Request[v1.PExpireAtRequest]) (*connect.Response[v1.PExpireAtResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.PExpireAt is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) Ttl(context.Context, *connect.Request[v1.TtlRequest]) (*connect.Response[v1.TtlResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Ttl is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) Pttl(context.Context, *connect.Request[v1.PttlRequest]) (*connect.Response[v1.PttlResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Pttl is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) Persist(context.Context, *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Persist is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) HSet(context.Context, *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.HSet is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) HGet(context.Context, *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.HGet is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) HDel(context.Context, *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.HDel is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) HGetAll(context.Context, *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.HGetAll is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) HIncrBy(context.Context, *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.HIncrBy is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) HScan(context.Context, *connect.Request[v1.HScanRequest]) (*connect.Response[v1.HScanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.HScan is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) LPush(context.Context, *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.LPush is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) RPush(context.Context, *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.RPush is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) LPop(context.Context, *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.LPop is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) RPop(context.Context, *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.RPop is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) LRange(context.Context, *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.LRange is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) LLen(context.Context, *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.LLen is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) LTrim(context.Context, *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.LTrim is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) LIndex(context.Context, *connect.Request[v1.LIndexRequest]) (*connect.Response[v1.LIndexResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.LIndex is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) BLPop(context.Context, *connect.Request[v1.BLPopRequest]) (*connect.Response[v1.BLPopResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BLPop is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) BRPop(context.Context, *connect.Request[v1.BRPopRequest]) (*connect.Response[v1.BRPopResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BRPop is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SAdd(context.Context, *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SRem(context.Context, *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SRem is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SIsMember(context.Context, *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SIsMember is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SMembers(context.Context, *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SMembers is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SCard(context.Context, *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SCard is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SPop(context.Context, *connect.Request[v1.SPopRequest]) (*connect.Response[v1.SPopResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SPop is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SRandMember(context.Context, *connect.Request[v1.SRandMemberRequest]) (*connect.Response[v1.SRandMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SRandMember is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SInter(context.Context, *connect.Request[v1.SInterRequest]) (*connect.Response[v1.SInterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SInter is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SUnion(context.Context, *connect.Request[v1.SUnionRequest]) (*connect.Response[v1.SUnionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SUnion is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SDiff(context.Context, *connect.Request[v1.SDiffRequest]) (*connect.Response[v1.SDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SDiff is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SInterStore(context.Context, *connect.Request[v1.SInterStoreRequest]) (*connect.Response[v1.SInterStoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SInterStore is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SUnionStore(context.Context, *connect.Request[v1.SUnionStoreRequest]) (*connect.Response[v1.SUnionStoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SUnionStore is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SDiffStore(context.Context, *connect.Request[v1.SDiffStoreRequest]) (*connect.Response[v1.SDiffStoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SDiffStore is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) ZAdd(context.Context, *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ZAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) ZRem(context.Context, *connect.Request[v1.ZRemRequest]) (*connect.Response[v1.ZRemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ZRem is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) ZScore(context.Context, *connect.Request[v1.ZScoreRequest]) (*connect.Response[v1.ZScoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ZScore is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) ZRank(context.Context, *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ZRank is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) ZRange(context.Context, *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ZRange is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) ZCount(context.Context, *connect.Request[v1.ZCountRequest]) (*connect.Response[v1.ZCountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ZCount is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) ZPopMin(context.Context, *connect.Request[v1.ZPopMinRequest]) (*connect.Response[v1.ZPopMinResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ZPopMin is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) ZPopMax(context.Context, *connect.Request[v1.ZPopMaxRequest]) (*connect.Response[v1.ZPopMaxResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.ZPopMax is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XAdd(context.Context, *connect.Request[v1.XAddRequest]) (*connect.Response[v1.XAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XRange(context.Context, *connect.Request[v1.XRangeRequest]) (*connect.Response[v1.XRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XRange is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XRevRange(context.Context, *connect.Request[v1.XRevRangeRequest]) (*connect.Response[v1.XRevRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XRevRange is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XLen(context.Context, *connect.Request[v1.XLenRequest]) (*connect.Response[v1.XLenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XLen is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XTrim(context.Context, *connect.Request[v1.XTrimRequest]) (*connect.Response[v1.XTrimResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XTrim is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XGroupCreate(context.Context, *connect.Request[v1.XGroupCreateRequest]) (*connect.Response[v1.XGroupCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XGroupCreate is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XReadGroup(context.Context, *connect.Request[v1.XReadGroupRequest]) (*connect.Response[v1.XReadGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XReadGroup is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XAck(context.Context, *connect.Request[v1.XAckRequest]) (*connect.Response[v1.XAckResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XAck is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XPending(context.Context, *connect.Request[v1.XPendingRequest]) (*connect.Response[v1.XPendingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XPending is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) XClaim(context.Context, *connect.Request[v1.XClaimRequest]) (*connect.Response[v1.XClaimResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.XClaim is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SetBit(context.Context, *connect.Request[v1.SetBitRequest]) (*connect.Response[v1.SetBitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SetBit is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) GetBit(context.Context, *connect.Request[v1.GetBitRequest]) (*connect.Response[v1.GetBitResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.GetBit is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) BitCount(context.Context, *connect.Request[v1.BitCountRequest]) (*connect.Response[v1.BitCountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BitCount is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) BitPos(context.Context, *connect.Request[v1.BitPosRequest]) (*connect.Response[v1.BitPosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BitPos is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) BitOp(context.Context, *connect.Request[v1.BitOpRequest]) (*connect.Response[v1.BitOpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BitOp is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) PFAdd(context.Context, *connect.Request[v1.PFAddRequest]) (*connect.Response[v1.PFAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.PFAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) PFCount(context.Context, *connect.Request[v1.PFCountRequest]) (*connect.Response[v1.PFCountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.PFCount is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) PFMerge(context.Context, *connect.Request[v1.PFMergeRequest]) (*connect.Response[v1.PFMergeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.PFMerge is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) GeoAdd(context.Context, *connect.Request[v1.GeoAddRequest]) (*connect.Response[v1.GeoAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.GeoAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) GeoDist(context.Context, *connect.Request[v1.GeoDistRequest]) (*connect.Response[v1.GeoDistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.GeoDist is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) GeoSearch(context.Context, *connect.Request[v1.GeoSearchRequest]) (*connect.Response[v1.GeoSearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.GeoSearch is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) JSONSet(context.Context, *connect.Request[v1.JSONSetRequest]) (*connect.Response[v1.JSONSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.JSONSet is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) JSONGet(context.Context, *connect.Request[v1.JSONGetRequest]) (*connect.Response[v1.JSONGetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.JSONGet is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) JSONDel(context.Context, *connect.Request[v1.JSONDelRequest]) (*connect.Response[v1.JSONDelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.JSONDel is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) JSONNumIncrBy(context.Context, *connect.Request[v1.JSONNumIncrByRequest]) (*connect.Response[v1.JSONNumIncrByResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.JSONNumIncrBy is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) JSONArrAppend(context.Context, *connect.Request[v1.JSONArrAppendRequest]) (*connect.Response[v1.JSONArrAppendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.JSONArrAppend is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) BFReserve(context.Context, *connect.Request[v1.BFReserveRequest]) (*connect.Response[v1.BFReserveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BFReserve is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) BFAdd(context.Context, *connect.Request[v1.BFAddRequest]) (*connect.Response[v1.BFAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BFAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) BFExists(context.Context, *connect.Request[v1.BFExistsRequest]) (*connect.Response[v1.BFExistsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BFExists is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) CFAdd(context.Context, *connect.Request[v1.CFAddRequest]) (*connect.Response[v1.CFAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.CFAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) CFDel(context.Context, *connect.Request[v1.CFDelRequest]) (*connect.Response[v1.CFDelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.CFDel is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) CFExists(context.Context, *connect.Request[v1.CFExistsRequest]) (*connect.Response[v1.CFExistsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.CFExists is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) CMSInitByDim(context.Context, *connect.Request[v1.CMSInitByDimRequest]) (*connect.Response[v1.CMSInitByDimResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.CMSInitByDim is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) CMSIncrBy(context.Context, *connect.Request[v1.CMSIncrByRequest]) (*connect.Response[v1.CMSIncrByResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.CMSIncrBy is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) CMSQuery(context.Context, *connect.Request[v1.CMSQueryRequest]) (*connect.Response[v1.CMSQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.CMSQuery is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TopKReserve(context.Context, *connect.Request[v1.TopKReserveRequest]) (*connect.Response[v1.TopKReserveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TopKReserve is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TopKAdd(context.Context, *connect.Request[v1.TopKAddRequest]) (*connect.Response[v1.TopKAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TopKAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TopKQuery(context.Context, *connect.Request[v1.TopKQueryRequest]) (*connect.Response[v1.TopKQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TopKQuery is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TopKList(context.Context, *connect.Request[v1.TopKListRequest]) (*connect.Response[v1.TopKListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TopKList is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TDigestCreate(context.Context, *connect.Request[v1.TDigestCreateRequest]) (*connect.Response[v1.TDigestCreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TDigestCreate is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TDigestAdd(context.Context, *connect.Request[v1.TDigestAddRequest]) (*connect.Response[v1.TDigestAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TDigestAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TDigestQuantile(context.Context, *connect.Request[v1.TDigestQuantileRequest]) (*connect.Response[v1.TDigestQuantileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TDigestQuantile is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TDigestCDF(context.Context, *connect.Request[v1.TDigestCDFRequest]) (*connect.Response[v1.TDigestCDFResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TDigestCDF is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TSAdd(context.Context, *connect.Request[v1.TSAddRequest]) (*connect.Response[v1.TSAddResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TSAdd is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TSRange(context.Context, *connect.Request[v1.TSRangeRequest]) (*connect.Response[v1.TSRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TSRange is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) TSMRange(context.Context, *connect.Request[v1.TSMRangeRequest]) (*connect.Response[v1.TSMRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.TSMRange is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) Append(context.Context, *connect.Request[v1.AppendRequest]) (*connect.Response[v1.AppendResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Append is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) GetRange(context.Context, *connect.Request[v1.GetRangeRequest]) (*connect.Response[v1.GetRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.GetRange is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) SetRange(context.Context, *connect.Request[v1.SetRangeRequest]) (*connect.Response[v1.SetRangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.SetRange is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) StrLen(context.Context, *connect.Request[v1.StrLenRequest]) (*connect.Response[v1.StrLenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.StrLen is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) GetDel(context.Context, *connect.Request[v1.GetDelRequest]) (*connect.Response[v1.GetDelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.GetDel is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) GetEx(context.Context, *connect.Request[v1.GetExRequest]) (*connect.Response[v1.GetExResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.GetEx is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) MGet(context.Context, *connect.Request[v1.MGetRequest]) (*connect.Response[v1.MGetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.MGet is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) MSet(context.Context, *connect.Request[v1.MSetRequest]) (*connect.Response[v1.MSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.MSet is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) MSetNX(context.Context, *connect.Request[v1.MSetNXRequest]) (*connect.Response[v1.MSetNXResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.MSetNX is not implemented"))
+}
+
 func (UnimplementedRedisServiceHandler) Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Ping is not implemented"))
 }
@@ -325,6 +3220,14 @@ func (UnimplementedRedisServiceHandler) Restore(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Restore is not implemented"))
 }
 
+func (UnimplementedRedisServiceHandler) BackupStream(context.Context, *connect.Request[v1.BackupStreamRequest], *connect.ServerStream[v1.BackupStreamResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.BackupStream is not implemented"))
+}
+
+func (UnimplementedRedisServiceHandler) RestoreStream(context.Context, *connect.ClientStream[v1.RestoreStreamRequest]) (*connect.Response[v1.RestoreStreamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.RestoreStream is not implemented"))
+}
+
 func (UnimplementedRedisServiceHandler) Join(context.Context, *connect.Request[v1.JoinRequest]) (*connect.Response[v1.JoinResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cloud.v1.RedisService.Join is not implemented"))
 }
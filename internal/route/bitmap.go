@@ -0,0 +1,95 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// SetBit sets or clears a bit of a string value.
+func (s *RedisServer) SetBit(ctx context.Context, req *connect.Request[v1.SetBitRequest]) (*connect.Response[v1.SetBitResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	original, err := s.store.SetBit(req.Msg.Key, req.Msg.Offset, int(req.Msg.Value))
+	if err != nil {
+		s.logger.Printf("Error setting bit of key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SetBitResponse{Original: int32(original)}), nil
+}
+
+// GetBit retrieves a bit of a string value.
+func (s *RedisServer) GetBit(ctx context.Context, req *connect.Request[v1.GetBitRequest]) (*connect.Response[v1.GetBitResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.GetBit(req.Msg.Key, req.Msg.Offset)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.GetBitResponse{Value: int32(value)}), nil
+}
+
+// BitCount counts the set bits of a string value.
+func (s *RedisServer) BitCount(ctx context.Context, req *connect.Request[v1.BitCountRequest]) (*connect.Response[v1.BitCountResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	start, end := int64(0), int64(-1)
+	if req.Msg.Start != nil {
+		start = *req.Msg.Start
+	}
+	if req.Msg.End != nil {
+		end = *req.Msg.End
+	}
+	count, err := s.store.BitCount(req.Msg.Key, start, end, req.Msg.ByBit)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.BitCountResponse{Count: count}), nil
+}
+
+// BitPos finds the first set or clear bit of a string value.
+func (s *RedisServer) BitPos(ctx context.Context, req *connect.Request[v1.BitPosRequest]) (*connect.Response[v1.BitPosResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	start, end := int64(0), int64(-1)
+	if req.Msg.Start != nil {
+		start = *req.Msg.Start
+	}
+	if req.Msg.End != nil {
+		end = *req.Msg.End
+	}
+	pos, err := s.store.BitPos(req.Msg.Key, int(req.Msg.Bit), start, end, req.Msg.End != nil, req.Msg.ByBit)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.BitPosResponse{Position: pos}), nil
+}
+
+// BitOp stores a bitwise operation between string values in a destination key.
+func (s *RedisServer) BitOp(ctx context.Context, req *connect.Request[v1.BitOpRequest]) (*connect.Response[v1.BitOpResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	length, err := s.store.BitOp(req.Msg.Operation, req.Msg.Destination, req.Msg.Keys...)
+	if err != nil {
+		s.logger.Printf("Error storing %s of %v in %s: %v", req.Msg.Operation, req.Msg.Keys, req.Msg.Destination, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.BitOpResponse{Length: int64(length)}), nil
+}
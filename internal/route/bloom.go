@@ -0,0 +1,62 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// defaultBloomExpansion is the capacity growth of a scaling Bloom filter
+// when the request does not set one.
+const defaultBloomExpansion = 2
+
+// BFReserve creates an empty Bloom filter.
+func (s *RedisServer) BFReserve(ctx context.Context, req *connect.Request[v1.BFReserveRequest]) (*connect.Response[v1.BFReserveResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	expansion := int(req.Msg.Expansion)
+	if req.Msg.NonScaling {
+		expansion = 0
+	} else if expansion == 0 {
+		expansion = defaultBloomExpansion
+	}
+	if err := s.store.BFReserve(req.Msg.Key, req.Msg.ErrorRate, req.Msg.Capacity, expansion); err != nil {
+		s.logger.Printf("Error reserving Bloom filter %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.BFReserveResponse{Success: true}), nil
+}
+
+// BFAdd adds items to a Bloom filter.
+func (s *RedisServer) BFAdd(ctx context.Context, req *connect.Request[v1.BFAddRequest]) (*connect.Response[v1.BFAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	added, err := s.store.BFAdd(req.Msg.Key, req.Msg.Items...)
+	if err != nil {
+		s.logger.Printf("Error adding to Bloom filter %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.BFAddResponse{Added: added}), nil
+}
+
+// BFExists checks whether items may be in a Bloom filter.
+func (s *RedisServer) BFExists(ctx context.Context, req *connect.Request[v1.BFExistsRequest]) (*connect.Response[v1.BFExistsResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	exists, err := s.store.BFExists(req.Msg.Key, req.Msg.Items...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.BFExistsResponse{Exists: exists}), nil
+}
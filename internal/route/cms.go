@@ -0,0 +1,57 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+	Kvstore "redis/internal/store"
+
+	"connectrpc.com/connect"
+)
+
+// CMSInitByDim creates a Count-Min Sketch with the given dimensions.
+func (s *RedisServer) CMSInitByDim(ctx context.Context, req *connect.Request[v1.CMSInitByDimRequest]) (*connect.Response[v1.CMSInitByDimResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.store.CMSInitByDim(req.Msg.Key, int(req.Msg.Width), int(req.Msg.Depth)); err != nil {
+		s.logger.Printf("Error creating Count-Min Sketch %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.CMSInitByDimResponse{Success: true}), nil
+}
+
+// CMSIncrBy increments the counts of items in a Count-Min Sketch.
+func (s *RedisServer) CMSIncrBy(ctx context.Context, req *connect.Request[v1.CMSIncrByRequest]) (*connect.Response[v1.CMSIncrByResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	increments := make([]Kvstore.CMSIncrement, len(req.Msg.Increments))
+	for i, inc := range req.Msg.Increments {
+		increments[i] = Kvstore.CMSIncrement{Item: inc.Item, Increment: inc.Increment}
+	}
+	counts, err := s.store.CMSIncrBy(req.Msg.Key, increments)
+	if err != nil {
+		s.logger.Printf("Error incrementing Count-Min Sketch %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.CMSIncrByResponse{Counts: counts}), nil
+}
+
+// CMSQuery returns the estimated counts of items in a Count-Min Sketch.
+func (s *RedisServer) CMSQuery(ctx context.Context, req *connect.Request[v1.CMSQueryRequest]) (*connect.Response[v1.CMSQueryResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	counts, err := s.store.CMSQuery(req.Msg.Key, req.Msg.Items...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.CMSQueryResponse{Counts: counts}), nil
+}
@@ -0,0 +1,52 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// CFAdd adds items to a Cuckoo filter.
+func (s *RedisServer) CFAdd(ctx context.Context, req *connect.Request[v1.CFAddRequest]) (*connect.Response[v1.CFAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.store.CFAdd(req.Msg.Key, req.Msg.Items...); err != nil {
+		s.logger.Printf("Error adding to Cuckoo filter %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.CFAddResponse{Success: true}), nil
+}
+
+// CFDel deletes an item from a Cuckoo filter.
+func (s *RedisServer) CFDel(ctx context.Context, req *connect.Request[v1.CFDelRequest]) (*connect.Response[v1.CFDelResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	deleted, err := s.store.CFDel(req.Msg.Key, req.Msg.Item)
+	if err != nil {
+		s.logger.Printf("Error deleting from Cuckoo filter %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.CFDelResponse{Deleted: deleted}), nil
+}
+
+// CFExists checks whether items may be in a Cuckoo filter.
+func (s *RedisServer) CFExists(ctx context.Context, req *connect.Request[v1.CFExistsRequest]) (*connect.Response[v1.CFExistsResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	exists, err := s.store.CFExists(req.Msg.Key, req.Msg.Items...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.CFExistsResponse{Exists: exists}), nil
+}
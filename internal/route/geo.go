@@ -0,0 +1,109 @@
+package route
+
+import (
+	"context"
+	"errors"
+
+	v1 "redis/internal/gen/cloud/v1"
+	Kvstore "redis/internal/store"
+
+	"connectrpc.com/connect"
+)
+
+// GeoAdd adds members with coordinates to a geo index.
+func (s *RedisServer) GeoAdd(ctx context.Context, req *connect.Request[v1.GeoAddRequest]) (*connect.Response[v1.GeoAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Nx && req.Msg.Xx {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("nx and xx options are not compatible"))
+	}
+
+	locations := make([]Kvstore.GeoLocation, len(req.Msg.Locations))
+	for i, l := range req.Msg.Locations {
+		locations[i] = Kvstore.GeoLocation{
+			Member:    l.Member,
+			Longitude: l.GetPoint().GetLongitude(),
+			Latitude:  l.GetPoint().GetLatitude(),
+		}
+	}
+	opts := Kvstore.ZAddOptions{NX: req.Msg.Nx, XX: req.Msg.Xx, CH: req.Msg.Ch}
+	count, err := s.store.GeoAdd(req.Msg.Key, locations, opts)
+	if err != nil {
+		s.logger.Printf("Error adding to geo index %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.GeoAddResponse{Count: int64(count)}), nil
+}
+
+// GeoDist returns the distance between two members of a geo index.
+func (s *RedisServer) GeoDist(ctx context.Context, req *connect.Request[v1.GeoDistRequest]) (*connect.Response[v1.GeoDistResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	distance, err := s.store.GeoDist(req.Msg.Key, req.Msg.Member1, req.Msg.Member2, req.Msg.Unit)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.GeoDistResponse{Distance: distance}), nil
+}
+
+// GeoSearch finds the members of a geo index within a radius or box.
+func (s *RedisServer) GeoSearch(ctx context.Context, req *connect.Request[v1.GeoSearchRequest]) (*connect.Response[v1.GeoSearchResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Asc && req.Msg.Desc {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("asc and desc options are not compatible"))
+	}
+	if req.Msg.Any && req.Msg.Count == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("any option requires count"))
+	}
+
+	q := Kvstore.GeoQuery{
+		Unit:  req.Msg.Unit,
+		Count: int(req.Msg.Count),
+		Any:   req.Msg.Any,
+	}
+	switch from := req.Msg.From.(type) {
+	case *v1.GeoSearchRequest_FromMember:
+		q.Member = from.FromMember
+	case *v1.GeoSearchRequest_FromPoint:
+		q.Longitude, q.Latitude = from.FromPoint.GetLongitude(), from.FromPoint.GetLatitude()
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("from_member or from_point is required"))
+	}
+	switch by := req.Msg.By.(type) {
+	case *v1.GeoSearchRequest_ByRadius:
+		q.Radius = by.ByRadius
+	case *v1.GeoSearchRequest_ByBox:
+		q.Width, q.Height = by.ByBox.GetWidth(), by.ByBox.GetHeight()
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("by_radius or by_box is required"))
+	}
+	switch {
+	case req.Msg.Asc:
+		q.Sort = Kvstore.GeoSortAsc
+	case req.Msg.Desc:
+		q.Sort = Kvstore.GeoSortDesc
+	}
+
+	results, err := s.store.GeoSearch(req.Msg.Key, q)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &v1.GeoSearchResponse{Results: make([]*v1.GeoResult, len(results))}
+	for i, r := range results {
+		resp.Results[i] = &v1.GeoResult{
+			Member:   r.Member,
+			Point:    &v1.GeoPoint{Longitude: r.Longitude, Latitude: r.Latitude},
+			Distance: r.Distance,
+			Hash:     r.Hash,
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
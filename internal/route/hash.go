@@ -0,0 +1,105 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// defaultScanCount is the number of elements a scan examines when the request
+// does not set a count.
+const defaultScanCount = 10
+
+// HSet sets fields of a hash.
+func (s *RedisServer) HSet(ctx context.Context, req *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	added, err := s.store.HSet(req.Msg.Key, req.Msg.Fields)
+	if err != nil {
+		s.logger.Printf("Error setting fields of hash %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.HSetResponse{AddedCount: int32(added)}), nil
+}
+
+// HGet retrieves the value of a hash field.
+func (s *RedisServer) HGet(ctx context.Context, req *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.HGet(req.Msg.Key, req.Msg.Field)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.HGetResponse{Value: value}), nil
+}
+
+// HDel deletes fields from a hash.
+func (s *RedisServer) HDel(ctx context.Context, req *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	deleted, err := s.store.HDel(req.Msg.Key, req.Msg.Fields...)
+	if err != nil {
+		s.logger.Printf("Error deleting fields of hash %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.HDelResponse{DeletedCount: int32(deleted)}), nil
+}
+
+// HGetAll retrieves all fields and values of a hash.
+func (s *RedisServer) HGetAll(ctx context.Context, req *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	fields, err := s.store.HGetAll(req.Msg.Key)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.HGetAllResponse{Fields: fields}), nil
+}
+
+// HIncrBy increments the integer value of a hash field by the given amount.
+func (s *RedisServer) HIncrBy(ctx context.Context, req *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.HIncrBy(req.Msg.Key, req.Msg.Field, req.Msg.Increment)
+	if err != nil {
+		s.logger.Printf("Error incrementing field %s of hash %s: %v", req.Msg.Field, req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.HIncrByResponse{Value: value}), nil
+}
+
+// HScan incrementally iterates over the fields of a hash.
+func (s *RedisServer) HScan(ctx context.Context, req *connect.Request[v1.HScanRequest]) (*connect.Response[v1.HScanResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	count := int(req.Msg.Count)
+	if count == 0 {
+		count = defaultScanCount
+	}
+
+	fields, cursor, err := s.store.HScan(req.Msg.Key, req.Msg.Cursor, req.Msg.Match, count)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.HScanResponse{Cursor: cursor, Fields: fields}), nil
+}
@@ -0,0 +1,52 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// PFAdd adds elements to a HyperLogLog.
+func (s *RedisServer) PFAdd(ctx context.Context, req *connect.Request[v1.PFAddRequest]) (*connect.Response[v1.PFAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	changed, err := s.store.PFAdd(req.Msg.Key, req.Msg.Elements...)
+	if err != nil {
+		s.logger.Printf("Error adding to HyperLogLog %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.PFAddResponse{Changed: changed}), nil
+}
+
+// PFCount estimates the number of distinct elements in HyperLogLogs.
+func (s *RedisServer) PFCount(ctx context.Context, req *connect.Request[v1.PFCountRequest]) (*connect.Response[v1.PFCountResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	count, err := s.store.PFCount(req.Msg.Keys...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.PFCountResponse{Count: int64(count)}), nil
+}
+
+// PFMerge merges HyperLogLogs into a destination key.
+func (s *RedisServer) PFMerge(ctx context.Context, req *connect.Request[v1.PFMergeRequest]) (*connect.Response[v1.PFMergeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.store.PFMerge(req.Msg.Destination, req.Msg.Keys...); err != nil {
+		s.logger.Printf("Error merging HyperLogLogs into %s: %v", req.Msg.Destination, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.PFMergeResponse{Success: true}), nil
+}
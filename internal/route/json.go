@@ -0,0 +1,99 @@
+package route
+
+import (
+	"context"
+	"errors"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// JSONSet sets a value at a path in a JSON document.
+func (s *RedisServer) JSONSet(ctx context.Context, req *connect.Request[v1.JSONSetRequest]) (*connect.Response[v1.JSONSetResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Nx && req.Msg.Xx {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("nx and xx options are not compatible"))
+	}
+
+	ok, err := s.store.JSONSet(req.Msg.Key, jsonPath(req.Msg.Path), req.Msg.Value, req.Msg.Nx, req.Msg.Xx)
+	if err != nil {
+		s.logger.Printf("Error setting JSON %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.JSONSetResponse{Success: ok}), nil
+}
+
+// JSONGet retrieves the values at paths in a JSON document.
+func (s *RedisServer) JSONGet(ctx context.Context, req *connect.Request[v1.JSONGetRequest]) (*connect.Response[v1.JSONGetResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	paths := make([]string, len(req.Msg.Paths))
+	for i, path := range req.Msg.Paths {
+		paths[i] = jsonPath(path)
+	}
+	value, err := s.store.JSONGet(req.Msg.Key, paths...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.JSONGetResponse{Value: value}), nil
+}
+
+// JSONDel deletes the values at a path in a JSON document.
+func (s *RedisServer) JSONDel(ctx context.Context, req *connect.Request[v1.JSONDelRequest]) (*connect.Response[v1.JSONDelResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	count, err := s.store.JSONDel(req.Msg.Key, jsonPath(req.Msg.Path))
+	if err != nil {
+		s.logger.Printf("Error deleting from JSON %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.JSONDelResponse{DeletedCount: int64(count)}), nil
+}
+
+// JSONNumIncrBy increments the numbers at a path in a JSON document.
+func (s *RedisServer) JSONNumIncrBy(ctx context.Context, req *connect.Request[v1.JSONNumIncrByRequest]) (*connect.Response[v1.JSONNumIncrByResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	values, err := s.store.JSONNumIncrBy(req.Msg.Key, jsonPath(req.Msg.Path), req.Msg.Value)
+	if err != nil {
+		s.logger.Printf("Error incrementing JSON %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.JSONNumIncrByResponse{Values: values}), nil
+}
+
+// JSONArrAppend appends values to the arrays at a path in a JSON document.
+func (s *RedisServer) JSONArrAppend(ctx context.Context, req *connect.Request[v1.JSONArrAppendRequest]) (*connect.Response[v1.JSONArrAppendResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	lengths, err := s.store.JSONArrAppend(req.Msg.Key, jsonPath(req.Msg.Path), req.Msg.Values...)
+	if err != nil {
+		s.logger.Printf("Error appending to JSON %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.JSONArrAppendResponse{Lengths: lengths}), nil
+}
+
+// jsonPath returns path, defaulting to the root of the document.
+func jsonPath(path string) string {
+	if path == "" {
+		return "$"
+	}
+	return path
+}
@@ -0,0 +1,157 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// LPush inserts values at the head of a list.
+func (s *RedisServer) LPush(ctx context.Context, req *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	length, err := s.store.LPush(req.Msg.Key, req.Msg.Values...)
+	if err != nil {
+		s.logger.Printf("Error pushing to list %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.LPushResponse{Length: int64(length)}), nil
+}
+
+// RPush inserts values at the tail of a list.
+func (s *RedisServer) RPush(ctx context.Context, req *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	length, err := s.store.RPush(req.Msg.Key, req.Msg.Values...)
+	if err != nil {
+		s.logger.Printf("Error pushing to list %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.RPushResponse{Length: int64(length)}), nil
+}
+
+// LPop removes and returns elements from the head of a list.
+func (s *RedisServer) LPop(ctx context.Context, req *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	values, err := s.store.LPop(req.Msg.Key, int(req.Msg.Count))
+	if err != nil {
+		s.logger.Printf("Error popping from list %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.LPopResponse{Values: values}), nil
+}
+
+// RPop removes and returns elements from the tail of a list.
+func (s *RedisServer) RPop(ctx context.Context, req *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	values, err := s.store.RPop(req.Msg.Key, int(req.Msg.Count))
+	if err != nil {
+		s.logger.Printf("Error popping from list %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.RPopResponse{Values: values}), nil
+}
+
+// LRange retrieves a range of elements from a list.
+func (s *RedisServer) LRange(ctx context.Context, req *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	values, err := s.store.LRange(req.Msg.Key, req.Msg.Start, req.Msg.Stop)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.LRangeResponse{Values: values}), nil
+}
+
+// LLen returns the length of a list.
+func (s *RedisServer) LLen(ctx context.Context, req *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	length, err := s.store.LLen(req.Msg.Key)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.LLenResponse{Length: int64(length)}), nil
+}
+
+// LTrim trims a list to the given range.
+func (s *RedisServer) LTrim(ctx context.Context, req *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.store.LTrim(req.Msg.Key, req.Msg.Start, req.Msg.Stop); err != nil {
+		s.logger.Printf("Error trimming list %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.LTrimResponse{Success: true}), nil
+}
+
+// LIndex retrieves an element from a list by its index.
+func (s *RedisServer) LIndex(ctx context.Context, req *connect.Request[v1.LIndexRequest]) (*connect.Response[v1.LIndexResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.LIndex(req.Msg.Key, req.Msg.Index)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.LIndexResponse{Value: value}), nil
+}
+
+// BLPop removes and returns the head of the first non-empty list, waiting
+// until one is available.
+func (s *RedisServer) BLPop(ctx context.Context, req *connect.Request[v1.BLPopRequest]) (*connect.Response[v1.BLPopResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	key, value, err := s.store.BLPop(ctx, req.Msg.Keys, req.Msg.Timeout.AsDuration())
+	if err != nil {
+		s.logger.Printf("Error popping from lists %v: %v", req.Msg.Keys, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.BLPopResponse{Key: key, Value: value}), nil
+}
+
+// BRPop removes and returns the tail of the first non-empty list, waiting
+// until one is available.
+func (s *RedisServer) BRPop(ctx context.Context, req *connect.Request[v1.BRPopRequest]) (*connect.Response[v1.BRPopResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	key, value, err := s.store.BRPop(ctx, req.Msg.Keys, req.Msg.Timeout.AsDuration())
+	if err != nil {
+		s.logger.Printf("Error popping from lists %v: %v", req.Msg.Keys, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.BRPopResponse{Key: key, Value: value}), nil
+}
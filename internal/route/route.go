@@ -2,10 +2,10 @@ package route
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"errors"
+	"io"
 	"log"
-	"strconv"
+	"time"
 
 	v1 "redis/internal/gen/cloud/v1"
 	Kvstore "redis/internal/store"
@@ -20,10 +20,113 @@ type RedisServiceHandler interface {
 	Set(ctx context.Context, req *connect.Request[v1.SetRequest]) (*connect.Response[v1.SetResponse], error)
 	Del(ctx context.Context, req *connect.Request[v1.DelRequest]) (*connect.Response[v1.DelResponse], error)
 	Incr(ctx context.Context, req *connect.Request[v1.IncrRequest]) (*connect.Response[v1.IncrResponse], error)
+	IncrBy(ctx context.Context, req *connect.Request[v1.IncrByRequest]) (*connect.Response[v1.IncrByResponse], error)
+	Decr(ctx context.Context, req *connect.Request[v1.DecrRequest]) (*connect.Response[v1.DecrResponse], error)
+	DecrBy(ctx context.Context, req *connect.Request[v1.DecrByRequest]) (*connect.Response[v1.DecrByResponse], error)
+	IncrByFloat(ctx context.Context, req *connect.Request[v1.IncrByFloatRequest]) (*connect.Response[v1.IncrByFloatResponse], error)
 	Expire(ctx context.Context, req *connect.Request[v1.ExpireRequest]) (*connect.Response[v1.ExpireResponse], error)
+	ExpireAt(ctx context.Context, req *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error)
+	PExpireAt(ctx context.Context, req *connect.Request[v1.PExpireAtRequest]) (*connect.Response[v1.PExpireAtResponse], error)
+	Ttl(ctx context.Context, req *connect.Request[v1.TtlRequest]) (*connect.Response[v1.TtlResponse], error)
+	Pttl(ctx context.Context, req *connect.Request[v1.PttlRequest]) (*connect.Response[v1.PttlResponse], error)
+	Persist(ctx context.Context, req *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error)
+	HSet(ctx context.Context, req *connect.Request[v1.HSetRequest]) (*connect.Response[v1.HSetResponse], error)
+	HGet(ctx context.Context, req *connect.Request[v1.HGetRequest]) (*connect.Response[v1.HGetResponse], error)
+	HDel(ctx context.Context, req *connect.Request[v1.HDelRequest]) (*connect.Response[v1.HDelResponse], error)
+	HGetAll(ctx context.Context, req *connect.Request[v1.HGetAllRequest]) (*connect.Response[v1.HGetAllResponse], error)
+	HIncrBy(ctx context.Context, req *connect.Request[v1.HIncrByRequest]) (*connect.Response[v1.HIncrByResponse], error)
+	HScan(ctx context.Context, req *connect.Request[v1.HScanRequest]) (*connect.Response[v1.HScanResponse], error)
+	LPush(ctx context.Context, req *connect.Request[v1.LPushRequest]) (*connect.Response[v1.LPushResponse], error)
+	RPush(ctx context.Context, req *connect.Request[v1.RPushRequest]) (*connect.Response[v1.RPushResponse], error)
+	LPop(ctx context.Context, req *connect.Request[v1.LPopRequest]) (*connect.Response[v1.LPopResponse], error)
+	RPop(ctx context.Context, req *connect.Request[v1.RPopRequest]) (*connect.Response[v1.RPopResponse], error)
+	LRange(ctx context.Context, req *connect.Request[v1.LRangeRequest]) (*connect.Response[v1.LRangeResponse], error)
+	LLen(ctx context.Context, req *connect.Request[v1.LLenRequest]) (*connect.Response[v1.LLenResponse], error)
+	LTrim(ctx context.Context, req *connect.Request[v1.LTrimRequest]) (*connect.Response[v1.LTrimResponse], error)
+	LIndex(ctx context.Context, req *connect.Request[v1.LIndexRequest]) (*connect.Response[v1.LIndexResponse], error)
+	BLPop(ctx context.Context, req *connect.Request[v1.BLPopRequest]) (*connect.Response[v1.BLPopResponse], error)
+	BRPop(ctx context.Context, req *connect.Request[v1.BRPopRequest]) (*connect.Response[v1.BRPopResponse], error)
+	SAdd(ctx context.Context, req *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error)
+	SRem(ctx context.Context, req *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error)
+	SIsMember(ctx context.Context, req *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error)
+	SMembers(ctx context.Context, req *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error)
+	SCard(ctx context.Context, req *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error)
+	SPop(ctx context.Context, req *connect.Request[v1.SPopRequest]) (*connect.Response[v1.SPopResponse], error)
+	SRandMember(ctx context.Context, req *connect.Request[v1.SRandMemberRequest]) (*connect.Response[v1.SRandMemberResponse], error)
+	SInter(ctx context.Context, req *connect.Request[v1.SInterRequest]) (*connect.Response[v1.SInterResponse], error)
+	SUnion(ctx context.Context, req *connect.Request[v1.SUnionRequest]) (*connect.Response[v1.SUnionResponse], error)
+	SDiff(ctx context.Context, req *connect.Request[v1.SDiffRequest]) (*connect.Response[v1.SDiffResponse], error)
+	SInterStore(ctx context.Context, req *connect.Request[v1.SInterStoreRequest]) (*connect.Response[v1.SInterStoreResponse], error)
+	SUnionStore(ctx context.Context, req *connect.Request[v1.SUnionStoreRequest]) (*connect.Response[v1.SUnionStoreResponse], error)
+	SDiffStore(ctx context.Context, req *connect.Request[v1.SDiffStoreRequest]) (*connect.Response[v1.SDiffStoreResponse], error)
+	ZAdd(ctx context.Context, req *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error)
+	ZRem(ctx context.Context, req *connect.Request[v1.ZRemRequest]) (*connect.Response[v1.ZRemResponse], error)
+	ZScore(ctx context.Context, req *connect.Request[v1.ZScoreRequest]) (*connect.Response[v1.ZScoreResponse], error)
+	ZRank(ctx context.Context, req *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error)
+	ZRange(ctx context.Context, req *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error)
+	ZCount(ctx context.Context, req *connect.Request[v1.ZCountRequest]) (*connect.Response[v1.ZCountResponse], error)
+	ZPopMin(ctx context.Context, req *connect.Request[v1.ZPopMinRequest]) (*connect.Response[v1.ZPopMinResponse], error)
+	ZPopMax(ctx context.Context, req *connect.Request[v1.ZPopMaxRequest]) (*connect.Response[v1.ZPopMaxResponse], error)
+	XAdd(ctx context.Context, req *connect.Request[v1.XAddRequest]) (*connect.Response[v1.XAddResponse], error)
+	XRange(ctx context.Context, req *connect.Request[v1.XRangeRequest]) (*connect.Response[v1.XRangeResponse], error)
+	XRevRange(ctx context.Context, req *connect.Request[v1.XRevRangeRequest]) (*connect.Response[v1.XRevRangeResponse], error)
+	XLen(ctx context.Context, req *connect.Request[v1.XLenRequest]) (*connect.Response[v1.XLenResponse], error)
+	XTrim(ctx context.Context, req *connect.Request[v1.XTrimRequest]) (*connect.Response[v1.XTrimResponse], error)
+	XGroupCreate(ctx context.Context, req *connect.Request[v1.XGroupCreateRequest]) (*connect.Response[v1.XGroupCreateResponse], error)
+	XReadGroup(ctx context.Context, req *connect.Request[v1.XReadGroupRequest]) (*connect.Response[v1.XReadGroupResponse], error)
+	XAck(ctx context.Context, req *connect.Request[v1.XAckRequest]) (*connect.Response[v1.XAckResponse], error)
+	XPending(ctx context.Context, req *connect.Request[v1.XPendingRequest]) (*connect.Response[v1.XPendingResponse], error)
+	XClaim(ctx context.Context, req *connect.Request[v1.XClaimRequest]) (*connect.Response[v1.XClaimResponse], error)
+	SetBit(ctx context.Context, req *connect.Request[v1.SetBitRequest]) (*connect.Response[v1.SetBitResponse], error)
+	GetBit(ctx context.Context, req *connect.Request[v1.GetBitRequest]) (*connect.Response[v1.GetBitResponse], error)
+	BitCount(ctx context.Context, req *connect.Request[v1.BitCountRequest]) (*connect.Response[v1.BitCountResponse], error)
+	BitPos(ctx context.Context, req *connect.Request[v1.BitPosRequest]) (*connect.Response[v1.BitPosResponse], error)
+	BitOp(ctx context.Context, req *connect.Request[v1.BitOpRequest]) (*connect.Response[v1.BitOpResponse], error)
+	PFAdd(ctx context.Context, req *connect.Request[v1.PFAddRequest]) (*connect.Response[v1.PFAddResponse], error)
+	PFCount(ctx context.Context, req *connect.Request[v1.PFCountRequest]) (*connect.Response[v1.PFCountResponse], error)
+	PFMerge(ctx context.Context, req *connect.Request[v1.PFMergeRequest]) (*connect.Response[v1.PFMergeResponse], error)
+	GeoAdd(ctx context.Context, req *connect.Request[v1.GeoAddRequest]) (*connect.Response[v1.GeoAddResponse], error)
+	GeoDist(ctx context.Context, req *connect.Request[v1.GeoDistRequest]) (*connect.Response[v1.GeoDistResponse], error)
+	GeoSearch(ctx context.Context, req *connect.Request[v1.GeoSearchRequest]) (*connect.Response[v1.GeoSearchResponse], error)
+	JSONSet(ctx context.Context, req *connect.Request[v1.JSONSetRequest]) (*connect.Response[v1.JSONSetResponse], error)
+	JSONGet(ctx context.Context, req *connect.Request[v1.JSONGetRequest]) (*connect.Response[v1.JSONGetResponse], error)
+	JSONDel(ctx context.Context, req *connect.Request[v1.JSONDelRequest]) (*connect.Response[v1.JSONDelResponse], error)
+	JSONNumIncrBy(ctx context.Context, req *connect.Request[v1.JSONNumIncrByRequest]) (*connect.Response[v1.JSONNumIncrByResponse], error)
+	JSONArrAppend(ctx context.Context, req *connect.Request[v1.JSONArrAppendRequest]) (*connect.Response[v1.JSONArrAppendResponse], error)
+	BFReserve(ctx context.Context, req *connect.Request[v1.BFReserveRequest]) (*connect.Response[v1.BFReserveResponse], error)
+	BFAdd(ctx context.Context, req *connect.Request[v1.BFAddRequest]) (*connect.Response[v1.BFAddResponse], error)
+	BFExists(ctx context.Context, req *connect.Request[v1.BFExistsRequest]) (*connect.Response[v1.BFExistsResponse], error)
+	CFAdd(ctx context.Context, req *connect.Request[v1.CFAddRequest]) (*connect.Response[v1.CFAddResponse], error)
+	CFDel(ctx context.Context, req *connect.Request[v1.CFDelRequest]) (*connect.Response[v1.CFDelResponse], error)
+	CFExists(ctx context.Context, req *connect.Request[v1.CFExistsRequest]) (*connect.Response[v1.CFExistsResponse], error)
+	CMSInitByDim(ctx context.Context, req *connect.Request[v1.CMSInitByDimRequest]) (*connect.Response[v1.CMSInitByDimResponse], error)
+	CMSIncrBy(ctx context.Context, req *connect.Request[v1.CMSIncrByRequest]) (*connect.Response[v1.CMSIncrByResponse], error)
+	CMSQuery(ctx context.Context, req *connect.Request[v1.CMSQueryRequest]) (*connect.Response[v1.CMSQueryResponse], error)
+	TopKReserve(ctx context.Context, req *connect.Request[v1.TopKReserveRequest]) (*connect.Response[v1.TopKReserveResponse], error)
+	TopKAdd(ctx context.Context, req *connect.Request[v1.TopKAddRequest]) (*connect.Response[v1.TopKAddResponse], error)
+	TopKQuery(ctx context.Context, req *connect.Request[v1.TopKQueryRequest]) (*connect.Response[v1.TopKQueryResponse], error)
+	TopKList(ctx context.Context, req *connect.Request[v1.TopKListRequest]) (*connect.Response[v1.TopKListResponse], error)
+	TDigestCreate(ctx context.Context, req *connect.Request[v1.TDigestCreateRequest]) (*connect.Response[v1.TDigestCreateResponse], error)
+	TDigestAdd(ctx context.Context, req *connect.Request[v1.TDigestAddRequest]) (*connect.Response[v1.TDigestAddResponse], error)
+	TDigestQuantile(ctx context.Context, req *connect.Request[v1.TDigestQuantileRequest]) (*connect.Response[v1.TDigestQuantileResponse], error)
+	TDigestCDF(ctx context.Context, req *connect.Request[v1.TDigestCDFRequest]) (*connect.Response[v1.TDigestCDFResponse], error)
+	TSAdd(ctx context.Context, req *connect.Request[v1.TSAddRequest]) (*connect.Response[v1.TSAddResponse], error)
+	TSRange(ctx context.Context, req *connect.Request[v1.TSRangeRequest]) (*connect.Response[v1.TSRangeResponse], error)
+	TSMRange(ctx context.Context, req *connect.Request[v1.TSMRangeRequest]) (*connect.Response[v1.TSMRangeResponse], error)
+	Append(ctx context.Context, req *connect.Request[v1.AppendRequest]) (*connect.Response[v1.AppendResponse], error)
+	GetRange(ctx context.Context, req *connect.Request[v1.GetRangeRequest]) (*connect.Response[v1.GetRangeResponse], error)
+	SetRange(ctx context.Context, req *connect.Request[v1.SetRangeRequest]) (*connect.Response[v1.SetRangeResponse], error)
+	StrLen(ctx context.Context, req *connect.Request[v1.StrLenRequest]) (*connect.Response[v1.StrLenResponse], error)
+	GetDel(ctx context.Context, req *connect.Request[v1.GetDelRequest]) (*connect.Response[v1.GetDelResponse], error)
+	GetEx(ctx context.Context, req *connect.Request[v1.GetExRequest]) (*connect.Response[v1.GetExResponse], error)
+	MGet(ctx context.Context, req *connect.Request[v1.MGetRequest]) (*connect.Response[v1.MGetResponse], error)
+	MSet(ctx context.Context, req *connect.Request[v1.MSetRequest]) (*connect.Response[v1.MSetResponse], error)
+	MSetNX(ctx context.Context, req *connect.Request[v1.MSetNXRequest]) (*connect.Response[v1.MSetNXResponse], error)
 	Ping(ctx context.Context, req *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
 	Backup(ctx context.Context, req *connect.Request[v1.BackupRequest]) (*connect.Response[v1.BackupResponse], error)
 	Restore(ctx context.Context, req *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error)
+	BackupStream(ctx context.Context, req *connect.Request[v1.BackupStreamRequest], stream *connect.ServerStream[v1.BackupStreamResponse]) error
+	RestoreStream(ctx context.Context, stream *connect.ClientStream[v1.RestoreStreamRequest]) (*connect.Response[v1.RestoreStreamResponse], error)
 	Join(ctx context.Context, req *connect.Request[v1.JoinRequest]) (*connect.Response[v1.JoinResponse], error)
 }
 
@@ -61,12 +164,10 @@ func (s *RedisServer) Get(ctx context.Context, req *connect.Request[v1.GetReques
 
 	value, err := s.store.Get(req.Msg.Key)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, storeError(err)
 	}
 
-	b, _ := json.Marshal(value)
-
-	return connect.NewResponse(&v1.GetResponse{Value: b}), nil
+	return connect.NewResponse(&v1.GetResponse{Value: []byte(value)}), nil
 }
 
 // Set stores a key-value pair.
@@ -75,13 +176,41 @@ func (s *RedisServer) Set(ctx context.Context, req *connect.Request[v1.SetReques
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	err := s.store.Set(req.Msg.Key, string(req.Msg.Value))
+	if req.Msg.Nx && req.Msg.Xx {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("nx and xx options are not compatible"))
+	}
+	opts := Kvstore.SetOptions{NX: req.Msg.Nx, XX: req.Msg.Xx, Get: req.Msg.Get}
+	switch expiration := req.Msg.Expiration.(type) {
+	case *v1.SetRequest_Ttl:
+		ttl := expiration.Ttl.AsDuration()
+		if ttl <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("ttl must be positive"))
+		}
+		opts.Deadline = time.Now().Add(ttl)
+	case *v1.SetRequest_UnixTimeSeconds:
+		if expiration.UnixTimeSeconds <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unix_time_seconds must be positive"))
+		}
+		opts.Deadline = time.Unix(expiration.UnixTimeSeconds, 0)
+	case *v1.SetRequest_UnixTimeMilliseconds:
+		if expiration.UnixTimeMilliseconds <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unix_time_milliseconds must be positive"))
+		}
+		opts.Deadline = time.UnixMilli(expiration.UnixTimeMilliseconds)
+	case *v1.SetRequest_KeepTtl:
+		opts.KeepTTL = expiration.KeepTtl
+	}
+	res, err := s.store.SetWithOptions(req.Msg.Key, string(req.Msg.Value), opts)
 	if err != nil {
 		s.logger.Printf("Error setting key %s: %v", req.Msg.Key, err)
-		return nil, connect.NewError(connect.CodeInternal, err.(error))
+		return nil, storeError(err)
 	}
 
-	return connect.NewResponse(&v1.SetResponse{Success: true}), nil
+	resp := &v1.SetResponse{Success: res.Set}
+	if res.Existed {
+		resp.Previous = []byte(res.Previous)
+	}
+	return connect.NewResponse(resp), nil
 }
 
 // Del deletes one or more keys.
@@ -90,14 +219,13 @@ func (s *RedisServer) Del(ctx context.Context, req *connect.Request[v1.DelReques
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	err := s.store.Delete(req.Msg.Keys)
-
+	deleted, err := s.store.Delete(req.Msg.Keys...)
 	if err != nil {
-		s.logger.Printf("Error setting key %s: %v", req.Msg.Keys, err)
-		return nil, connect.NewError(connect.CodeInternal, err.(error))
+		s.logger.Printf("Error deleting keys %v: %v", req.Msg.Keys, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	return connect.NewResponse(&v1.DelResponse{DeletedCount: int32(1)}), nil
+	return connect.NewResponse(&v1.DelResponse{DeletedCount: int32(deleted)}), nil
 }
 
 // Incr increments the integer value of a key.
@@ -106,34 +234,182 @@ func (s *RedisServer) Incr(ctx context.Context, req *connect.Request[v1.IncrRequ
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	// This operation is not directly supported by the provided store interface.
-	// We need to implement it using Get and Set operations.
-	value, err := s.store.Get(req.Msg.Key)
+	value, err := s.store.Incr(req.Msg.Key)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		s.logger.Printf("Error incrementing key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
 	}
-	// Convert the value to an integer and increment it
-	intValue, err := strconv.ParseInt(value, 10, 64)
+
+	return connect.NewResponse(&v1.IncrResponse{Value: value}), nil
+}
+
+// IncrBy increments the integer value of a key by the given amount.
+func (s *RedisServer) IncrBy(ctx context.Context, req *connect.Request[v1.IncrByRequest]) (*connect.Response[v1.IncrByResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.IncrBy(req.Msg.Key, req.Msg.Increment)
 	if err != nil {
-		s.logger.Printf("Error parsing value for key %s: %v", req.Msg.Key, err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		s.logger.Printf("Error incrementing key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
 	}
-	intValue++
 
-	errr := s.store.Set(req.Msg.Key, strconv.FormatInt(intValue, 10))
-	if errr.(error) != nil {
-		s.logger.Printf("Error setting key %s: %v", req.Msg.Key, err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+	return connect.NewResponse(&v1.IncrByResponse{Value: value}), nil
+}
+
+// Decr decrements the integer value of a key.
+func (s *RedisServer) Decr(ctx context.Context, req *connect.Request[v1.DecrRequest]) (*connect.Response[v1.DecrResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.DecrBy(req.Msg.Key, 1)
+	if err != nil {
+		s.logger.Printf("Error decrementing key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.DecrResponse{Value: value}), nil
+}
+
+// DecrBy decrements the integer value of a key by the given amount.
+func (s *RedisServer) DecrBy(ctx context.Context, req *connect.Request[v1.DecrByRequest]) (*connect.Response[v1.DecrByResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.DecrBy(req.Msg.Key, req.Msg.Decrement)
+	if err != nil {
+		s.logger.Printf("Error decrementing key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.DecrByResponse{Value: value}), nil
+}
+
+// IncrByFloat increments the floating point value of a key by the given amount.
+func (s *RedisServer) IncrByFloat(ctx context.Context, req *connect.Request[v1.IncrByFloatRequest]) (*connect.Response[v1.IncrByFloatResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.IncrByFloat(req.Msg.Key, req.Msg.Increment)
+	if err != nil {
+		s.logger.Printf("Error incrementing key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
 	}
 
-	return connect.NewResponse(&v1.IncrResponse{Value: intValue}), nil
+	return connect.NewResponse(&v1.IncrByFloatResponse{Value: value}), nil
 }
 
 // Expire sets a timeout on a key.
 func (s *RedisServer) Expire(ctx context.Context, req *connect.Request[v1.ExpireRequest]) (*connect.Response[v1.ExpireResponse], error) {
-	// The provided store interface doesn't support expiration.
-	// This would require additional implementation in the store.
-	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("expire operation not supported"))
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	set, err := s.store.Expire(req.Msg.Key, req.Msg.Ttl.AsDuration())
+	if err != nil {
+		s.logger.Printf("Error setting expiration on key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ExpireResponse{Success: set}), nil
+}
+
+// ExpireAt sets the Unix time in seconds at which a key expires.
+func (s *RedisServer) ExpireAt(ctx context.Context, req *connect.Request[v1.ExpireAtRequest]) (*connect.Response[v1.ExpireAtResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	set, err := s.store.ExpireAt(req.Msg.Key, time.Unix(req.Msg.UnixTimeSeconds, 0))
+	if err != nil {
+		s.logger.Printf("Error setting expiration on key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ExpireAtResponse{Success: set}), nil
+}
+
+// PExpireAt sets the Unix time in milliseconds at which a key expires.
+func (s *RedisServer) PExpireAt(ctx context.Context, req *connect.Request[v1.PExpireAtRequest]) (*connect.Response[v1.PExpireAtResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	set, err := s.store.ExpireAt(req.Msg.Key, time.UnixMilli(req.Msg.UnixTimeMilliseconds))
+	if err != nil {
+		s.logger.Printf("Error setting expiration on key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.PExpireAtResponse{Success: set}), nil
+}
+
+// Ttl returns the remaining time to live of a key in seconds.
+func (s *RedisServer) Ttl(ctx context.Context, req *connect.Request[v1.TtlRequest]) (*connect.Response[v1.TtlResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	ttl, err := s.remainingTTL(req.Msg.Key, time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.TtlResponse{Ttl: ttl}), nil
+}
+
+// Pttl returns the remaining time to live of a key in milliseconds.
+func (s *RedisServer) Pttl(ctx context.Context, req *connect.Request[v1.PttlRequest]) (*connect.Response[v1.PttlResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	ttl, err := s.remainingTTL(req.Msg.Key, time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.PttlResponse{Ttl: ttl}), nil
+}
+
+// remainingTTL returns the time to live of key rounded to unit, -2 if the key
+// does not exist and -1 if it has no timeout.
+func (s *RedisServer) remainingTTL(key string, unit time.Duration) (int64, error) {
+	deadline, err := s.store.ExpireTime(key)
+	if errors.Is(err, Kvstore.ErrKeyNotFound) {
+		return -2, nil
+	}
+	if err != nil {
+		return 0, storeError(err)
+	}
+	if deadline.IsZero() {
+		return -1, nil
+	}
+
+	ttl := time.Until(deadline)
+	if ttl < 0 {
+		ttl = 0
+	}
+	return int64(ttl.Round(unit) / unit), nil
+}
+
+// Persist removes the timeout from a key.
+func (s *RedisServer) Persist(ctx context.Context, req *connect.Request[v1.PersistRequest]) (*connect.Response[v1.PersistResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	removed, err := s.store.Persist(req.Msg.Key)
+	if err != nil {
+		s.logger.Printf("Error persisting key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.PersistResponse{Success: removed}), nil
 }
 
 // Ping checks if the server is responsive.
@@ -143,16 +419,76 @@ func (s *RedisServer) Ping(ctx context.Context, req *connect.Request[v1.PingRequ
 
 // Backup creates a backup of the current dataset.
 func (s *RedisServer) Backup(ctx context.Context, req *connect.Request[v1.BackupRequest]) (*connect.Response[v1.BackupResponse], error) {
-	// The provided store interface doesn't support backup operations.
-	// This would require additional implementation in the store.
-	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("backup operation not supported"))
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	info, err := s.store.Backup(req.Msg.Filename)
+	if err != nil {
+		s.logger.Printf("Error creating backup %s: %v", req.Msg.Filename, err)
+		if errors.Is(err, Kvstore.ErrInvalidBackupName) {
+			return nil, storeError(err)
+		}
+		return connect.NewResponse(&v1.BackupResponse{Success: false, ErrorMessage: err.Error()}), nil
+	}
+
+	return connect.NewResponse(&v1.BackupResponse{
+		Success:   true,
+		KeyCount:  info.Keys,
+		RaftIndex: info.Index,
+		RaftTerm:  info.Term,
+	}), nil
 }
 
-// Restore rebuilds the dataset from a backup file.
+// Restore rebuilds the dataset from a backup file. It is meant for disaster
+// recovery; see store.Restore.
 func (s *RedisServer) Restore(ctx context.Context, req *connect.Request[v1.RestoreRequest]) (*connect.Response[v1.RestoreResponse], error) {
-	// The provided store interface doesn't support restore operations.
-	// This would require additional implementation in the store.
-	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("restore operation not supported"))
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	info, err := s.store.Restore(req.Msg.Filename)
+	if err != nil {
+		s.logger.Printf("Error restoring backup %s: %v", req.Msg.Filename, err)
+		if errors.Is(err, Kvstore.ErrInvalidBackupName) {
+			return nil, storeError(err)
+		}
+		return connect.NewResponse(&v1.RestoreResponse{Success: false, ErrorMessage: err.Error()}), nil
+	}
+
+	return connect.NewResponse(&v1.RestoreResponse{Success: true, KeyCount: info.Keys}), nil
+}
+
+// BackupStream creates a backup and streams it to the client in chunks.
+func (s *RedisServer) BackupStream(ctx context.Context, req *connect.Request[v1.BackupStreamRequest], stream *connect.ServerStream[v1.BackupStreamResponse]) error {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	info, err := s.store.BackupTo(&chunkWriter{stream: stream})
+	if err != nil {
+		s.logger.Printf("Error streaming backup: %v", err)
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	s.logger.Printf("Streamed backup with %d keys at index %d", info.Keys, info.Index)
+	return nil
+}
+
+// RestoreStream rebuilds the dataset from a backup streamed by the client. Like
+// Restore, it is meant for disaster recovery.
+func (s *RedisServer) RestoreStream(ctx context.Context, stream *connect.ClientStream[v1.RestoreStreamRequest]) (*connect.Response[v1.RestoreStreamResponse], error) {
+	info, err := s.store.RestoreFrom(&chunkReader{stream: stream, validator: s.validator})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+		s.logger.Printf("Error restoring streamed backup: %v", err)
+		return connect.NewResponse(&v1.RestoreStreamResponse{Success: false, ErrorMessage: err.Error()}), nil
+	}
+
+	return connect.NewResponse(&v1.RestoreStreamResponse{Success: true, KeyCount: info.Keys}), nil
 }
 
 // Join adds a new node to the cluster
@@ -168,3 +504,96 @@ func (s *RedisServer) Join(ctx context.Context, req *connect.Request[v1.JoinRequ
 		Success: true,
 	}), nil
 }
+
+// backupChunkSize is the maximum size of a chunk sent by BackupStream.
+const backupChunkSize = 64 * 1024
+
+// chunkWriter sends everything written to it over a BackupStream in chunks
+// of at most backupChunkSize bytes.
+type chunkWriter struct {
+	stream *connect.ServerStream[v1.BackupStreamResponse]
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), backupChunkSize)
+		if err := w.stream.Send(&v1.BackupStreamResponse{Chunk: p[:n]}); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// maxRestoreStreamBytes bounds the bytes accepted on a RestoreStream: the
+// largest backup payload plus room for the header and checksum.
+const maxRestoreStreamBytes = Kvstore.MaxBackupSize + 1024
+
+// chunkReader reads the chunks received on a RestoreStream as one byte stream.
+type chunkReader struct {
+	stream    *connect.ClientStream[v1.RestoreStreamRequest]
+	validator *protovalidate.Validator
+	buf       []byte
+	received  int64
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if !r.stream.Receive() {
+			if err := r.stream.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		if err := r.validator.Validate(r.stream.Msg()); err != nil {
+			return 0, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		r.buf = r.stream.Msg().Chunk
+		r.received += int64(len(r.buf))
+		if r.received > maxRestoreStreamBytes {
+			return 0, connect.NewError(connect.CodeResourceExhausted, errors.New("backup exceeds the maximum backup size"))
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// storeError maps an error returned by the store to a connect error.
+func storeError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, Kvstore.ErrKeyNotFound), errors.Is(err, Kvstore.ErrFieldNotFound),
+		errors.Is(err, Kvstore.ErrMemberNotFound), errors.Is(err, Kvstore.ErrGroupNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, Kvstore.ErrGroupExists), errors.Is(err, Kvstore.ErrKeyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, Kvstore.ErrInvalidRange), errors.Is(err, Kvstore.ErrInvalidStreamID),
+		errors.Is(err, Kvstore.ErrBitOffset), errors.Is(err, Kvstore.ErrInvalidBitOp),
+		errors.Is(err, Kvstore.ErrInvalidCoordinates), errors.Is(err, Kvstore.ErrInvalidUnit),
+		errors.Is(err, Kvstore.ErrInvalidPath), errors.Is(err, Kvstore.ErrInvalidJSON),
+		errors.Is(err, Kvstore.ErrInvalidFilter), errors.Is(err, Kvstore.ErrInvalidQuantile),
+		errors.Is(err, Kvstore.ErrInvalidAggregation), errors.Is(err, Kvstore.ErrInvalidLabelFilter),
+		errors.Is(err, Kvstore.ErrInvalidBackupName), errors.Is(err, Kvstore.ErrInvalidCount),
+		errors.Is(err, Kvstore.ErrInvalidArea):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, Kvstore.ErrWrongType), errors.Is(err, Kvstore.ErrStreamIDTooSmall),
+		errors.Is(err, Kvstore.ErrJSONRoot), errors.Is(err, Kvstore.ErrDuplicateSample),
+		errors.Is(err, Kvstore.ErrSampleTooOld):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, Kvstore.ErrIndexOutOfRange):
+		return connect.NewError(connect.CodeOutOfRange, err)
+	case errors.Is(err, Kvstore.ErrFilterFull), errors.Is(err, Kvstore.ErrStringTooLong):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	case errors.Is(err, Kvstore.ErrNotInteger), errors.Is(err, Kvstore.ErrOverflow),
+		errors.Is(err, Kvstore.ErrNotFloat), errors.Is(err, Kvstore.ErrNotFinite):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,391 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "redis/internal/gen/cloud/v1"
+	Kvstore "redis/internal/store"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// TestValidation checks that out-of-range requests are rejected before they
+// reach the store, which is nil here.
+func TestValidation(t *testing.T) {
+	s := NewRedisServer(nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"get empty key", func() error {
+			_, err := s.Get(ctx, connect.NewRequest(&v1.GetRequest{}))
+			return err
+		}},
+		{"set key pattern", func() error {
+			_, err := s.Set(ctx, connect.NewRequest(&v1.SetRequest{Key: "a b", Value: []byte("v")}))
+			return err
+		}},
+		{"set empty key", func() error {
+			_, err := s.Set(ctx, connect.NewRequest(&v1.SetRequest{Value: []byte("v")}))
+			return err
+		}},
+		{"set keep_ttl false", func() error {
+			_, err := s.Set(ctx, connect.NewRequest(&v1.SetRequest{Key: "k", Expiration: &v1.SetRequest_KeepTtl{KeepTtl: false}}))
+			return err
+		}},
+		{"set nx and xx", func() error {
+			_, err := s.Set(ctx, connect.NewRequest(&v1.SetRequest{Key: "k", Nx: true, Xx: true}))
+			return err
+		}},
+		{"set zero unix_time_seconds", func() error {
+			_, err := s.Set(ctx, connect.NewRequest(&v1.SetRequest{Key: "k", Expiration: &v1.SetRequest_UnixTimeSeconds{}}))
+			return err
+		}},
+		{"set zero unix_time_milliseconds", func() error {
+			_, err := s.Set(ctx, connect.NewRequest(&v1.SetRequest{Key: "k", Expiration: &v1.SetRequest_UnixTimeMilliseconds{}}))
+			return err
+		}},
+		{"set value too large", func() error {
+			_, err := s.Set(ctx, connect.NewRequest(&v1.SetRequest{Key: "k", Value: make([]byte, 524289)}))
+			return err
+		}},
+		{"del key pattern", func() error {
+			_, err := s.Del(ctx, connect.NewRequest(&v1.DelRequest{Keys: []string{"a", "a b"}}))
+			return err
+		}},
+		{"del no keys", func() error {
+			_, err := s.Del(ctx, connect.NewRequest(&v1.DelRequest{}))
+			return err
+		}},
+		{"del too many keys", func() error {
+			_, err := s.Del(ctx, connect.NewRequest(&v1.DelRequest{Keys: make([]string, 1001)}))
+			return err
+		}},
+		{"incr empty key", func() error {
+			_, err := s.Incr(ctx, connect.NewRequest(&v1.IncrRequest{}))
+			return err
+		}},
+		{"expire without ttl", func() error {
+			_, err := s.Expire(ctx, connect.NewRequest(&v1.ExpireRequest{Key: "k"}))
+			return err
+		}},
+		{"expire zero ttl", func() error {
+			_, err := s.Expire(ctx, connect.NewRequest(&v1.ExpireRequest{Key: "k", Ttl: durationpb.New(0)}))
+			return err
+		}},
+		{"expireat negative time", func() error {
+			_, err := s.ExpireAt(ctx, connect.NewRequest(&v1.ExpireAtRequest{Key: "k", UnixTimeSeconds: -1}))
+			return err
+		}},
+		{"pexpireat negative time", func() error {
+			_, err := s.PExpireAt(ctx, connect.NewRequest(&v1.PExpireAtRequest{Key: "k", UnixTimeMilliseconds: -1}))
+			return err
+		}},
+		{"ttl empty key", func() error {
+			_, err := s.Ttl(ctx, connect.NewRequest(&v1.TtlRequest{}))
+			return err
+		}},
+		{"persist key pattern", func() error {
+			_, err := s.Persist(ctx, connect.NewRequest(&v1.PersistRequest{Key: "a b"}))
+			return err
+		}},
+		{"backup path traversal", func() error {
+			_, err := s.Backup(ctx, connect.NewRequest(&v1.BackupRequest{Filename: "../../../tmp/evil.rdb"}))
+			return err
+		}},
+		{"backup wrong extension", func() error {
+			_, err := s.Backup(ctx, connect.NewRequest(&v1.BackupRequest{Filename: "dump.txt"}))
+			return err
+		}},
+		{"restore path traversal", func() error {
+			_, err := s.Restore(ctx, connect.NewRequest(&v1.RestoreRequest{Filename: "../dump.rdb"}))
+			return err
+		}},
+		{"hset no fields", func() error {
+			_, err := s.HSet(ctx, connect.NewRequest(&v1.HSetRequest{Key: "k"}))
+			return err
+		}},
+		{"hset empty field name", func() error {
+			_, err := s.HSet(ctx, connect.NewRequest(&v1.HSetRequest{Key: "k", Fields: map[string]string{"": "v"}}))
+			return err
+		}},
+		{"hdel no fields", func() error {
+			_, err := s.HDel(ctx, connect.NewRequest(&v1.HDelRequest{Key: "k"}))
+			return err
+		}},
+		{"hscan count above range", func() error {
+			_, err := s.HScan(ctx, connect.NewRequest(&v1.HScanRequest{Key: "k", Count: 1001}))
+			return err
+		}},
+		{"lpop count above range", func() error {
+			_, err := s.LPop(ctx, connect.NewRequest(&v1.LPopRequest{Key: "k", Count: 1001}))
+			return err
+		}},
+		{"rpush no values", func() error {
+			_, err := s.RPush(ctx, connect.NewRequest(&v1.RPushRequest{Key: "k"}))
+			return err
+		}},
+		{"blpop no keys", func() error {
+			_, err := s.BLPop(ctx, connect.NewRequest(&v1.BLPopRequest{}))
+			return err
+		}},
+		{"brpop negative timeout", func() error {
+			_, err := s.BRPop(ctx, connect.NewRequest(&v1.BRPopRequest{Keys: []string{"k"}, Timeout: durationpb.New(-time.Second)}))
+			return err
+		}},
+		{"srandmember count below range", func() error {
+			_, err := s.SRandMember(ctx, connect.NewRequest(&v1.SRandMemberRequest{Key: "k", Count: -2000}))
+			return err
+		}},
+		{"srandmember count above range", func() error {
+			_, err := s.SRandMember(ctx, connect.NewRequest(&v1.SRandMemberRequest{Key: "k", Count: 2000}))
+			return err
+		}},
+		{"spop negative count", func() error {
+			_, err := s.SPop(ctx, connect.NewRequest(&v1.SPopRequest{Key: "k", Count: -1}))
+			return err
+		}},
+		{"sinterstore no keys", func() error {
+			_, err := s.SInterStore(ctx, connect.NewRequest(&v1.SInterStoreRequest{Destination: "d"}))
+			return err
+		}},
+		{"zadd nx and xx", func() error {
+			_, err := s.ZAdd(ctx, connect.NewRequest(&v1.ZAddRequest{Key: "k", Members: []*v1.ZMember{{Member: "m"}}, Nx: true, Xx: true}))
+			return err
+		}},
+		{"zadd gt and lt", func() error {
+			_, err := s.ZAdd(ctx, connect.NewRequest(&v1.ZAddRequest{Key: "k", Members: []*v1.ZMember{{Member: "m"}}, Gt: true, Lt: true}))
+			return err
+		}},
+		{"zadd incr with two members", func() error {
+			_, err := s.ZAdd(ctx, connect.NewRequest(&v1.ZAddRequest{Key: "k", Members: []*v1.ZMember{{Member: "a"}, {Member: "b"}}, Incr: true}))
+			return err
+		}},
+		{"zadd no members", func() error {
+			_, err := s.ZAdd(ctx, connect.NewRequest(&v1.ZAddRequest{Key: "k"}))
+			return err
+		}},
+		{"xadd no fields", func() error {
+			_, err := s.XAdd(ctx, connect.NewRequest(&v1.XAddRequest{Key: "k"}))
+			return err
+		}},
+		{"xreadgroup empty consumer", func() error {
+			_, err := s.XReadGroup(ctx, connect.NewRequest(&v1.XReadGroupRequest{Key: "k", Group: "g"}))
+			return err
+		}},
+		{"setbit offset above range", func() error {
+			_, err := s.SetBit(ctx, connect.NewRequest(&v1.SetBitRequest{Key: "k", Offset: 4194304}))
+			return err
+		}},
+		{"setbit value 2", func() error {
+			_, err := s.SetBit(ctx, connect.NewRequest(&v1.SetBitRequest{Key: "k", Value: 2}))
+			return err
+		}},
+		{"bitop unknown operation", func() error {
+			_, err := s.BitOp(ctx, connect.NewRequest(&v1.BitOpRequest{Operation: "NAND", Destination: "d", Keys: []string{"k"}}))
+			return err
+		}},
+		{"pfadd key pattern", func() error {
+			_, err := s.PFAdd(ctx, connect.NewRequest(&v1.PFAddRequest{Key: "a b"}))
+			return err
+		}},
+		{"pfcount no keys", func() error {
+			_, err := s.PFCount(ctx, connect.NewRequest(&v1.PFCountRequest{}))
+			return err
+		}},
+		{"geoadd latitude above range", func() error {
+			_, err := s.GeoAdd(ctx, connect.NewRequest(&v1.GeoAddRequest{
+				Key:       "k",
+				Locations: []*v1.GeoLocation{{Member: "m", Point: &v1.GeoPoint{Latitude: 86}}},
+			}))
+			return err
+		}},
+		{"geoadd missing point", func() error {
+			_, err := s.GeoAdd(ctx, connect.NewRequest(&v1.GeoAddRequest{Key: "k", Locations: []*v1.GeoLocation{{Member: "m"}}}))
+			return err
+		}},
+		{"geosearch missing center", func() error {
+			_, err := s.GeoSearch(ctx, connect.NewRequest(&v1.GeoSearchRequest{Key: "k", By: &v1.GeoSearchRequest_ByRadius{ByRadius: 1}}))
+			return err
+		}},
+		{"geosearch negative radius", func() error {
+			_, err := s.GeoSearch(ctx, connect.NewRequest(&v1.GeoSearchRequest{
+				Key:  "k",
+				From: &v1.GeoSearchRequest_FromMember{FromMember: "m"},
+				By:   &v1.GeoSearchRequest_ByRadius{ByRadius: -1},
+			}))
+			return err
+		}},
+		{"geosearch unknown unit", func() error {
+			_, err := s.GeoSearch(ctx, connect.NewRequest(&v1.GeoSearchRequest{
+				Key:  "k",
+				From: &v1.GeoSearchRequest_FromMember{FromMember: "m"},
+				By:   &v1.GeoSearchRequest_ByRadius{ByRadius: 1},
+				Unit: "ly",
+			}))
+			return err
+		}},
+		{"json.set empty value", func() error {
+			_, err := s.JSONSet(ctx, connect.NewRequest(&v1.JSONSetRequest{Key: "k", Path: "$"}))
+			return err
+		}},
+		{"json.arrappend no values", func() error {
+			_, err := s.JSONArrAppend(ctx, connect.NewRequest(&v1.JSONArrAppendRequest{Key: "k", Path: "$"}))
+			return err
+		}},
+		{"bf.reserve error rate of one", func() error {
+			_, err := s.BFReserve(ctx, connect.NewRequest(&v1.BFReserveRequest{Key: "k", ErrorRate: 1, Capacity: 100}))
+			return err
+		}},
+		{"bf.add no items", func() error {
+			_, err := s.BFAdd(ctx, connect.NewRequest(&v1.BFAddRequest{Key: "k"}))
+			return err
+		}},
+		{"cf.exists no items", func() error {
+			_, err := s.CFExists(ctx, connect.NewRequest(&v1.CFExistsRequest{Key: "k"}))
+			return err
+		}},
+		{"cms zero width", func() error {
+			_, err := s.CMSInitByDim(ctx, connect.NewRequest(&v1.CMSInitByDimRequest{Key: "k", Width: 0, Depth: 4}))
+			return err
+		}},
+		{"cms depth above range", func() error {
+			_, err := s.CMSInitByDim(ctx, connect.NewRequest(&v1.CMSInitByDimRequest{Key: "k", Width: 100, Depth: 65}))
+			return err
+		}},
+		{"topk zero k", func() error {
+			_, err := s.TopKReserve(ctx, connect.NewRequest(&v1.TopKReserveRequest{Key: "k"}))
+			return err
+		}},
+		{"topk decay above range", func() error {
+			_, err := s.TopKReserve(ctx, connect.NewRequest(&v1.TopKReserveRequest{Key: "k", Topk: 1, Decay: 1.5}))
+			return err
+		}},
+		{"tdigest.quantile above range", func() error {
+			_, err := s.TDigestQuantile(ctx, connect.NewRequest(&v1.TDigestQuantileRequest{Key: "k", Quantiles: []float64{1.5}}))
+			return err
+		}},
+		{"ts.add negative timestamp", func() error {
+			timestamp := int64(-1)
+			_, err := s.TSAdd(ctx, connect.NewRequest(&v1.TSAddRequest{Key: "k", Timestamp: &timestamp}))
+			return err
+		}},
+		{"ts.range unknown aggregation", func() error {
+			_, err := s.TSRange(ctx, connect.NewRequest(&v1.TSRangeRequest{
+				Key:         "k",
+				Aggregation: &v1.TSAggregation{Type: "median", BucketMs: 1000},
+			}))
+			return err
+		}},
+		{"ts.mrange no filters", func() error {
+			_, err := s.TSMRange(ctx, connect.NewRequest(&v1.TSMRangeRequest{}))
+			return err
+		}},
+		{"append value too large", func() error {
+			_, err := s.Append(ctx, connect.NewRequest(&v1.AppendRequest{Key: "k", Value: make([]byte, 524289)}))
+			return err
+		}},
+		{"setrange offset above range", func() error {
+			_, err := s.SetRange(ctx, connect.NewRequest(&v1.SetRangeRequest{Key: "k", Offset: 1 << 29, Value: []byte("v")}))
+			return err
+		}},
+		{"getex zero unix_time_seconds", func() error {
+			_, err := s.GetEx(ctx, connect.NewRequest(&v1.GetExRequest{Key: "k", Expiration: &v1.GetExRequest_UnixTimeSeconds{}}))
+			return err
+		}},
+		{"getex zero unix_time_milliseconds", func() error {
+			_, err := s.GetEx(ctx, connect.NewRequest(&v1.GetExRequest{Key: "k", Expiration: &v1.GetExRequest_UnixTimeMilliseconds{}}))
+			return err
+		}},
+		{"getex persist false", func() error {
+			_, err := s.GetEx(ctx, connect.NewRequest(&v1.GetExRequest{Key: "k", Expiration: &v1.GetExRequest_Persist{}}))
+			return err
+		}},
+		{"mget no keys", func() error {
+			_, err := s.MGet(ctx, connect.NewRequest(&v1.MGetRequest{}))
+			return err
+		}},
+		{"mset key pattern", func() error {
+			_, err := s.MSet(ctx, connect.NewRequest(&v1.MSetRequest{Pairs: []*v1.KeyValue{{Key: "a b"}}}))
+			return err
+		}},
+		{"msetnx no pairs", func() error {
+			_, err := s.MSetNX(ctx, connect.NewRequest(&v1.MSetNXRequest{}))
+			return err
+		}},
+		{"join empty node id", func() error {
+			_, err := s.Join(ctx, connect.NewRequest(&v1.JoinRequest{RemoteAddr: "127.0.0.1:7000"}))
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+				t.Fatalf("got error %v, want code %v", err, connect.CodeInvalidArgument)
+			}
+		})
+	}
+}
+
+func TestStoreError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want connect.Code
+	}{
+		{Kvstore.ErrKeyNotFound, connect.CodeNotFound},
+		{Kvstore.ErrFieldNotFound, connect.CodeNotFound},
+		{Kvstore.ErrMemberNotFound, connect.CodeNotFound},
+		{Kvstore.ErrGroupNotFound, connect.CodeNotFound},
+		{Kvstore.ErrGroupExists, connect.CodeAlreadyExists},
+		{Kvstore.ErrKeyExists, connect.CodeAlreadyExists},
+		{Kvstore.ErrInvalidRange, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidStreamID, connect.CodeInvalidArgument},
+		{Kvstore.ErrBitOffset, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidBitOp, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidCoordinates, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidUnit, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidPath, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidJSON, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidFilter, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidQuantile, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidAggregation, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidLabelFilter, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidBackupName, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidCount, connect.CodeInvalidArgument},
+		{Kvstore.ErrInvalidArea, connect.CodeInvalidArgument},
+		{Kvstore.ErrStreamIDTooSmall, connect.CodeFailedPrecondition},
+		{Kvstore.ErrJSONRoot, connect.CodeFailedPrecondition},
+		{Kvstore.ErrDuplicateSample, connect.CodeFailedPrecondition},
+		{Kvstore.ErrSampleTooOld, connect.CodeFailedPrecondition},
+		{Kvstore.ErrWrongType, connect.CodeFailedPrecondition},
+		{Kvstore.ErrIndexOutOfRange, connect.CodeOutOfRange},
+		{Kvstore.ErrFilterFull, connect.CodeResourceExhausted},
+		{Kvstore.ErrStringTooLong, connect.CodeResourceExhausted},
+		{Kvstore.ErrNotInteger, connect.CodeFailedPrecondition},
+		{Kvstore.ErrOverflow, connect.CodeFailedPrecondition},
+		{Kvstore.ErrNotFloat, connect.CodeFailedPrecondition},
+		{Kvstore.ErrNotFinite, connect.CodeFailedPrecondition},
+		{context.Canceled, connect.CodeCanceled},
+		{context.DeadlineExceeded, connect.CodeDeadlineExceeded},
+		{fmt.Errorf("key k: %w", Kvstore.ErrKeyNotFound), connect.CodeNotFound},
+		{errors.New("not leader"), connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			if got := storeError(tt.err).Code(); got != tt.want {
+				t.Fatalf("got code %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
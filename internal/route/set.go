@@ -0,0 +1,201 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// SAdd adds members to a set.
+func (s *RedisServer) SAdd(ctx context.Context, req *connect.Request[v1.SAddRequest]) (*connect.Response[v1.SAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	added, err := s.store.SAdd(req.Msg.Key, req.Msg.Members...)
+	if err != nil {
+		s.logger.Printf("Error adding to set %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SAddResponse{AddedCount: int64(added)}), nil
+}
+
+// SRem removes members from a set.
+func (s *RedisServer) SRem(ctx context.Context, req *connect.Request[v1.SRemRequest]) (*connect.Response[v1.SRemResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	removed, err := s.store.SRem(req.Msg.Key, req.Msg.Members...)
+	if err != nil {
+		s.logger.Printf("Error removing from set %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SRemResponse{RemovedCount: int64(removed)}), nil
+}
+
+// SIsMember checks whether a member belongs to a set.
+func (s *RedisServer) SIsMember(ctx context.Context, req *connect.Request[v1.SIsMemberRequest]) (*connect.Response[v1.SIsMemberResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	ok, err := s.store.SIsMember(req.Msg.Key, req.Msg.Member)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SIsMemberResponse{IsMember: ok}), nil
+}
+
+// SMembers retrieves all members of a set.
+func (s *RedisServer) SMembers(ctx context.Context, req *connect.Request[v1.SMembersRequest]) (*connect.Response[v1.SMembersResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	members, err := s.store.SMembers(req.Msg.Key)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SMembersResponse{Members: members}), nil
+}
+
+// SCard returns the number of members of a set.
+func (s *RedisServer) SCard(ctx context.Context, req *connect.Request[v1.SCardRequest]) (*connect.Response[v1.SCardResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	n, err := s.store.SCard(req.Msg.Key)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SCardResponse{Cardinality: int64(n)}), nil
+}
+
+// SPop removes and returns random members of a set.
+func (s *RedisServer) SPop(ctx context.Context, req *connect.Request[v1.SPopRequest]) (*connect.Response[v1.SPopResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	members, err := s.store.SPop(req.Msg.Key, int(req.Msg.Count))
+	if err != nil {
+		s.logger.Printf("Error popping from set %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SPopResponse{Members: members}), nil
+}
+
+// SRandMember returns random members of a set without removing them.
+func (s *RedisServer) SRandMember(ctx context.Context, req *connect.Request[v1.SRandMemberRequest]) (*connect.Response[v1.SRandMemberResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	count := int(req.Msg.Count)
+	if count == 0 {
+		count = 1
+	}
+	members, err := s.store.SRandMember(req.Msg.Key, count)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SRandMemberResponse{Members: members}), nil
+}
+
+// SInter returns the intersection of sets.
+func (s *RedisServer) SInter(ctx context.Context, req *connect.Request[v1.SInterRequest]) (*connect.Response[v1.SInterResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	members, err := s.store.SInter(req.Msg.Keys...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SInterResponse{Members: members}), nil
+}
+
+// SUnion returns the union of sets.
+func (s *RedisServer) SUnion(ctx context.Context, req *connect.Request[v1.SUnionRequest]) (*connect.Response[v1.SUnionResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	members, err := s.store.SUnion(req.Msg.Keys...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SUnionResponse{Members: members}), nil
+}
+
+// SDiff returns the members of the first set that are in none of the others.
+func (s *RedisServer) SDiff(ctx context.Context, req *connect.Request[v1.SDiffRequest]) (*connect.Response[v1.SDiffResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	members, err := s.store.SDiff(req.Msg.Keys...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SDiffResponse{Members: members}), nil
+}
+
+// SInterStore stores the intersection of sets in a destination key.
+func (s *RedisServer) SInterStore(ctx context.Context, req *connect.Request[v1.SInterStoreRequest]) (*connect.Response[v1.SInterStoreResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	n, err := s.store.SInterStore(req.Msg.Destination, req.Msg.Keys...)
+	if err != nil {
+		s.logger.Printf("Error storing set %s: %v", req.Msg.Destination, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SInterStoreResponse{Cardinality: int64(n)}), nil
+}
+
+// SUnionStore stores the union of sets in a destination key.
+func (s *RedisServer) SUnionStore(ctx context.Context, req *connect.Request[v1.SUnionStoreRequest]) (*connect.Response[v1.SUnionStoreResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	n, err := s.store.SUnionStore(req.Msg.Destination, req.Msg.Keys...)
+	if err != nil {
+		s.logger.Printf("Error storing set %s: %v", req.Msg.Destination, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SUnionStoreResponse{Cardinality: int64(n)}), nil
+}
+
+// SDiffStore stores the difference of sets in a destination key.
+func (s *RedisServer) SDiffStore(ctx context.Context, req *connect.Request[v1.SDiffStoreRequest]) (*connect.Response[v1.SDiffStoreResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	n, err := s.store.SDiffStore(req.Msg.Destination, req.Msg.Keys...)
+	if err != nil {
+		s.logger.Printf("Error storing set %s: %v", req.Msg.Destination, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SDiffStoreResponse{Cardinality: int64(n)}), nil
+}
@@ -0,0 +1,231 @@
+package route
+
+import (
+	"context"
+	"errors"
+
+	v1 "redis/internal/gen/cloud/v1"
+	Kvstore "redis/internal/store"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// XAdd appends an entry to a stream.
+func (s *RedisServer) XAdd(ctx context.Context, req *connect.Request[v1.XAddRequest]) (*connect.Response[v1.XAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id := req.Msg.Id
+	if id == "" {
+		id = "*"
+	}
+	maxLen := int64(-1)
+	if req.Msg.MaxLen != nil {
+		maxLen = *req.Msg.MaxLen
+	}
+	added, err := s.store.XAdd(req.Msg.Key, id, req.Msg.Fields, maxLen)
+	if err != nil {
+		s.logger.Printf("Error adding to stream %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XAddResponse{Id: added.String()}), nil
+}
+
+// XRange retrieves stream entries within an ID range.
+func (s *RedisServer) XRange(ctx context.Context, req *connect.Request[v1.XRangeRequest]) (*connect.Response[v1.XRangeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	entries, err := s.store.XRange(req.Msg.Key, req.Msg.Start, req.Msg.End, int(req.Msg.Count))
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XRangeResponse{Entries: streamEntries(entries)}), nil
+}
+
+// XRevRange retrieves stream entries within an ID range, newest first.
+func (s *RedisServer) XRevRange(ctx context.Context, req *connect.Request[v1.XRevRangeRequest]) (*connect.Response[v1.XRevRangeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	entries, err := s.store.XRevRange(req.Msg.Key, req.Msg.End, req.Msg.Start, int(req.Msg.Count))
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XRevRangeResponse{Entries: streamEntries(entries)}), nil
+}
+
+// XLen returns the number of entries in a stream.
+func (s *RedisServer) XLen(ctx context.Context, req *connect.Request[v1.XLenRequest]) (*connect.Response[v1.XLenResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	length, err := s.store.XLen(req.Msg.Key)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XLenResponse{Length: int64(length)}), nil
+}
+
+// XTrim removes the oldest entries of a stream.
+func (s *RedisServer) XTrim(ctx context.Context, req *connect.Request[v1.XTrimRequest]) (*connect.Response[v1.XTrimResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var removed int
+	var err error
+	switch strategy := req.Msg.Strategy.(type) {
+	case *v1.XTrimRequest_MaxLen:
+		removed, err = s.store.XTrimMaxLen(req.Msg.Key, strategy.MaxLen)
+	case *v1.XTrimRequest_MinId:
+		removed, err = s.store.XTrimMinID(req.Msg.Key, strategy.MinId)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("max_len or min_id is required"))
+	}
+	if err != nil {
+		s.logger.Printf("Error trimming stream %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XTrimResponse{RemovedCount: int64(removed)}), nil
+}
+
+// XGroupCreate creates a consumer group on a stream.
+func (s *RedisServer) XGroupCreate(ctx context.Context, req *connect.Request[v1.XGroupCreateRequest]) (*connect.Response[v1.XGroupCreateResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id := req.Msg.Id
+	if id == "" {
+		id = "$"
+	}
+	if err := s.store.XGroupCreate(req.Msg.Key, req.Msg.Group, id, req.Msg.Mkstream); err != nil {
+		s.logger.Printf("Error creating group %s on stream %s: %v", req.Msg.Group, req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XGroupCreateResponse{}), nil
+}
+
+// XReadGroup reads stream entries on behalf of a consumer of a group.
+func (s *RedisServer) XReadGroup(ctx context.Context, req *connect.Request[v1.XReadGroupRequest]) (*connect.Response[v1.XReadGroupResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	id := req.Msg.Id
+	if id == "" {
+		id = ">"
+	}
+	opts := Kvstore.XReadGroupOptions{Count: int(req.Msg.Count), NoAck: req.Msg.Noack}
+
+	var entries []Kvstore.StreamEntry
+	var err error
+	if req.Msg.Block != nil && id == ">" {
+		entries, err = s.store.XReadGroupBlock(ctx, req.Msg.Group, req.Msg.Consumer, req.Msg.Key, opts, req.Msg.Block.AsDuration())
+	} else {
+		entries, err = s.store.XReadGroup(req.Msg.Group, req.Msg.Consumer, req.Msg.Key, id, opts)
+	}
+	if err != nil {
+		s.logger.Printf("Error reading group %s of stream %s: %v", req.Msg.Group, req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XReadGroupResponse{Entries: streamEntries(entries)}), nil
+}
+
+// XAck acknowledges entries delivered to a consumer group.
+func (s *RedisServer) XAck(ctx context.Context, req *connect.Request[v1.XAckRequest]) (*connect.Response[v1.XAckResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	acked, err := s.store.XAck(req.Msg.Key, req.Msg.Group, req.Msg.Ids...)
+	if err != nil {
+		s.logger.Printf("Error acknowledging entries of stream %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XAckResponse{AckedCount: int64(acked)}), nil
+}
+
+// XPending inspects the pending entries of a consumer group.
+func (s *RedisServer) XPending(ctx context.Context, req *connect.Request[v1.XPendingRequest]) (*connect.Response[v1.XPendingResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	summary, err := s.store.XPending(req.Msg.Key, req.Msg.Group)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	resp := &v1.XPendingResponse{
+		Count:     int64(summary.Count),
+		Consumers: make(map[string]int64, len(summary.Consumers)),
+	}
+	if summary.Count > 0 {
+		resp.LowestId, resp.HighestId = summary.Lowest.String(), summary.Highest.String()
+	}
+	for consumer, n := range summary.Consumers {
+		resp.Consumers[consumer] = int64(n)
+	}
+
+	if req.Msg.Count > 0 {
+		start, end := req.Msg.Start, req.Msg.End
+		if start == "" {
+			start = "-"
+		}
+		if end == "" {
+			end = "+"
+		}
+		entries, err := s.store.XPendingRange(req.Msg.Key, req.Msg.Group, start, end, int(req.Msg.Count), req.Msg.Consumer, req.Msg.MinIdle.AsDuration())
+		if err != nil {
+			return nil, storeError(err)
+		}
+		for _, e := range entries {
+			resp.Entries = append(resp.Entries, &v1.PendingEntry{
+				Id:            e.ID.String(),
+				Consumer:      e.Consumer,
+				Idle:          durationpb.New(e.Idle),
+				DeliveryCount: int64(e.Deliveries),
+			})
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// XClaim transfers idle pending entries to another consumer.
+func (s *RedisServer) XClaim(ctx context.Context, req *connect.Request[v1.XClaimRequest]) (*connect.Response[v1.XClaimResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	entries, err := s.store.XClaim(req.Msg.Key, req.Msg.Group, req.Msg.Consumer, req.Msg.MinIdle.AsDuration(), req.Msg.Ids...)
+	if err != nil {
+		s.logger.Printf("Error claiming entries of stream %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.XClaimResponse{Entries: streamEntries(entries)}), nil
+}
+
+// streamEntries converts stream entries to their API representation.
+func streamEntries(entries []Kvstore.StreamEntry) []*v1.StreamEntry {
+	out := make([]*v1.StreamEntry, len(entries))
+	for i, e := range entries {
+		out[i] = &v1.StreamEntry{Id: e.ID.String(), Fields: e.Fields}
+	}
+	return out
+}
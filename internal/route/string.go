@@ -0,0 +1,173 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v1 "redis/internal/gen/cloud/v1"
+	Kvstore "redis/internal/store"
+
+	"connectrpc.com/connect"
+)
+
+// Append appends a value to a string.
+func (s *RedisServer) Append(ctx context.Context, req *connect.Request[v1.AppendRequest]) (*connect.Response[v1.AppendResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	length, err := s.store.Append(req.Msg.Key, string(req.Msg.Value))
+	if err != nil {
+		s.logger.Printf("Error appending to key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.AppendResponse{Length: length}), nil
+}
+
+// GetRange returns a substring of a string.
+func (s *RedisServer) GetRange(ctx context.Context, req *connect.Request[v1.GetRangeRequest]) (*connect.Response[v1.GetRangeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.GetRange(req.Msg.Key, req.Msg.Start, req.Msg.End)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.GetRangeResponse{Value: []byte(value)}), nil
+}
+
+// SetRange overwrites part of a string starting at an offset.
+func (s *RedisServer) SetRange(ctx context.Context, req *connect.Request[v1.SetRangeRequest]) (*connect.Response[v1.SetRangeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	length, err := s.store.SetRange(req.Msg.Key, req.Msg.Offset, string(req.Msg.Value))
+	if err != nil {
+		s.logger.Printf("Error setting range of key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.SetRangeResponse{Length: length}), nil
+}
+
+// StrLen returns the length of a string.
+func (s *RedisServer) StrLen(ctx context.Context, req *connect.Request[v1.StrLenRequest]) (*connect.Response[v1.StrLenResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	length, err := s.store.StrLen(req.Msg.Key)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.StrLenResponse{Length: length}), nil
+}
+
+// GetDel returns the value of a key and deletes it.
+func (s *RedisServer) GetDel(ctx context.Context, req *connect.Request[v1.GetDelRequest]) (*connect.Response[v1.GetDelResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	value, err := s.store.GetDel(req.Msg.Key)
+	if err != nil {
+		s.logger.Printf("Error deleting key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.GetDelResponse{Value: []byte(value)}), nil
+}
+
+// GetEx returns the value of a key and updates its timeout.
+func (s *RedisServer) GetEx(ctx context.Context, req *connect.Request[v1.GetExRequest]) (*connect.Response[v1.GetExResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var deadline time.Time
+	var persist bool
+	switch expiration := req.Msg.Expiration.(type) {
+	case *v1.GetExRequest_Ttl:
+		ttl := expiration.Ttl.AsDuration()
+		if ttl <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("ttl must be positive"))
+		}
+		deadline = time.Now().Add(ttl)
+	case *v1.GetExRequest_UnixTimeSeconds:
+		if expiration.UnixTimeSeconds <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unix_time_seconds must be positive"))
+		}
+		deadline = time.Unix(expiration.UnixTimeSeconds, 0)
+	case *v1.GetExRequest_UnixTimeMilliseconds:
+		if expiration.UnixTimeMilliseconds <= 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unix_time_milliseconds must be positive"))
+		}
+		deadline = time.UnixMilli(expiration.UnixTimeMilliseconds)
+	case *v1.GetExRequest_Persist:
+		persist = expiration.Persist
+	}
+	value, err := s.store.GetEx(req.Msg.Key, deadline, persist)
+	if err != nil {
+		s.logger.Printf("Error updating expiration of key %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.GetExResponse{Value: []byte(value)}), nil
+}
+
+// MGet returns the values of several keys in one read.
+func (s *RedisServer) MGet(ctx context.Context, req *connect.Request[v1.MGetRequest]) (*connect.Response[v1.MGetResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	values, found := s.store.MGet(req.Msg.Keys...)
+	resp := &v1.MGetResponse{Values: make([]*v1.MGetValue, len(values))}
+	for i, value := range values {
+		resp.Values[i] = &v1.MGetValue{Found: found[i], Value: []byte(value)}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// MSet sets several keys at once.
+func (s *RedisServer) MSet(ctx context.Context, req *connect.Request[v1.MSetRequest]) (*connect.Response[v1.MSetResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.store.MSet(keyValues(req.Msg.Pairs)); err != nil {
+		s.logger.Printf("Error setting %d keys: %v", len(req.Msg.Pairs), err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.MSetResponse{Success: true}), nil
+}
+
+// MSetNX sets several keys at once if none of them exists.
+func (s *RedisServer) MSetNX(ctx context.Context, req *connect.Request[v1.MSetNXRequest]) (*connect.Response[v1.MSetNXResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	set, err := s.store.MSetNX(keyValues(req.Msg.Pairs))
+	if err != nil {
+		s.logger.Printf("Error setting %d keys: %v", len(req.Msg.Pairs), err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.MSetNXResponse{Success: set}), nil
+}
+
+func keyValues(pairs []*v1.KeyValue) []Kvstore.KeyValue {
+	out := make([]Kvstore.KeyValue, len(pairs))
+	for i, p := range pairs {
+		out[i] = Kvstore.KeyValue{Key: p.Key, Value: string(p.Value)}
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// defaultTDigestCompression is the compression of a t-digest when the
+// request does not set one.
+const defaultTDigestCompression = 100
+
+// TDigestCreate creates an empty t-digest.
+func (s *RedisServer) TDigestCreate(ctx context.Context, req *connect.Request[v1.TDigestCreateRequest]) (*connect.Response[v1.TDigestCreateResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	compression := req.Msg.Compression
+	if compression == 0 {
+		compression = defaultTDigestCompression
+	}
+	if err := s.store.TDigestCreate(req.Msg.Key, compression); err != nil {
+		s.logger.Printf("Error creating t-digest %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TDigestCreateResponse{Success: true}), nil
+}
+
+// TDigestAdd adds samples to a t-digest.
+func (s *RedisServer) TDigestAdd(ctx context.Context, req *connect.Request[v1.TDigestAddRequest]) (*connect.Response[v1.TDigestAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.store.TDigestAdd(req.Msg.Key, req.Msg.Values...); err != nil {
+		s.logger.Printf("Error adding to t-digest %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TDigestAddResponse{Success: true}), nil
+}
+
+// TDigestQuantile returns the estimated values at quantiles of a t-digest.
+func (s *RedisServer) TDigestQuantile(ctx context.Context, req *connect.Request[v1.TDigestQuantileRequest]) (*connect.Response[v1.TDigestQuantileResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	values, err := s.store.TDigestQuantile(req.Msg.Key, req.Msg.Quantiles...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TDigestQuantileResponse{Values: values}), nil
+}
+
+// TDigestCDF returns the estimated fractions of samples at most the given values.
+func (s *RedisServer) TDigestCDF(ctx context.Context, req *connect.Request[v1.TDigestCDFRequest]) (*connect.Response[v1.TDigestCDFResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	fractions, err := s.store.TDigestCDF(req.Msg.Key, req.Msg.Values...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TDigestCDFResponse{Fractions: fractions}), nil
+}
@@ -0,0 +1,100 @@
+package route
+
+import (
+	"context"
+	"math"
+	"time"
+
+	v1 "redis/internal/gen/cloud/v1"
+	Kvstore "redis/internal/store"
+
+	"connectrpc.com/connect"
+)
+
+// TSAdd adds a sample to a time series, creating the series if needed.
+func (s *RedisServer) TSAdd(ctx context.Context, req *connect.Request[v1.TSAddRequest]) (*connect.Response[v1.TSAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	timestamp := int64(-1)
+	if req.Msg.Timestamp != nil {
+		timestamp = *req.Msg.Timestamp
+	}
+	timestamp, err := s.store.TSAdd(req.Msg.Key, timestamp, req.Msg.Value, Kvstore.TSOptions{
+		Retention: time.Duration(req.Msg.RetentionMs) * time.Millisecond,
+		Labels:    req.Msg.Labels,
+	})
+	if err != nil {
+		s.logger.Printf("Error adding to time series %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TSAddResponse{Timestamp: timestamp}), nil
+}
+
+// TSRange returns the samples of a time series within a time range.
+func (s *RedisServer) TSRange(ctx context.Context, req *connect.Request[v1.TSRangeRequest]) (*connect.Response[v1.TSRangeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	from, to := timeRange(req.Msg.From, req.Msg.To)
+	samples, err := s.store.TSRange(req.Msg.Key, from, to, tsAggregation(req.Msg.Aggregation))
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TSRangeResponse{Samples: tsSamples(samples)}), nil
+}
+
+// TSMRange returns the samples within a time range of every time series
+// matching the label filters.
+func (s *RedisServer) TSMRange(ctx context.Context, req *connect.Request[v1.TSMRangeRequest]) (*connect.Response[v1.TSMRangeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	from, to := timeRange(req.Msg.From, req.Msg.To)
+	series, err := s.store.TSMRange(from, to, req.Msg.Filters, tsAggregation(req.Msg.Aggregation))
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	resp := &v1.TSMRangeResponse{Series: make([]*v1.TSSeries, len(series))}
+	for i, ts := range series {
+		resp.Series[i] = &v1.TSSeries{
+			Key:     ts.Key,
+			Labels:  ts.Labels,
+			Samples: tsSamples(ts.Samples),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// timeRange returns the bounds of a time range, defaulting to all samples.
+func timeRange(from, to *int64) (int64, int64) {
+	start, end := int64(math.MinInt64), int64(math.MaxInt64)
+	if from != nil {
+		start = *from
+	}
+	if to != nil {
+		end = *to
+	}
+	return start, end
+}
+
+func tsAggregation(agg *v1.TSAggregation) *Kvstore.TSAggregation {
+	if agg == nil {
+		return nil
+	}
+	return &Kvstore.TSAggregation{Type: agg.Type, Bucket: agg.BucketMs}
+}
+
+func tsSamples(samples []Kvstore.TSSample) []*v1.TSSample {
+	out := make([]*v1.TSSample, len(samples))
+	for i, sample := range samples {
+		out[i] = &v1.TSSample{Timestamp: sample.Timestamp, Value: sample.Value}
+	}
+	return out
+}
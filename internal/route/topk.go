@@ -0,0 +1,87 @@
+package route
+
+import (
+	"context"
+
+	v1 "redis/internal/gen/cloud/v1"
+
+	"connectrpc.com/connect"
+)
+
+// Defaults for Top-K sketches, as in RedisBloom.
+const (
+	defaultTopKWidth = 8
+	defaultTopKDepth = 7
+	defaultTopKDecay = 0.9
+)
+
+// TopKReserve creates an empty Top-K sketch.
+func (s *RedisServer) TopKReserve(ctx context.Context, req *connect.Request[v1.TopKReserveRequest]) (*connect.Response[v1.TopKReserveResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	width, depth, decay := int(req.Msg.Width), int(req.Msg.Depth), req.Msg.Decay
+	if width == 0 {
+		width = defaultTopKWidth
+	}
+	if depth == 0 {
+		depth = defaultTopKDepth
+	}
+	if decay == 0 {
+		decay = defaultTopKDecay
+	}
+	if err := s.store.TopKReserve(req.Msg.Key, int(req.Msg.Topk), width, depth, decay); err != nil {
+		s.logger.Printf("Error reserving Top-K sketch %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TopKReserveResponse{Success: true}), nil
+}
+
+// TopKAdd adds items to a Top-K sketch.
+func (s *RedisServer) TopKAdd(ctx context.Context, req *connect.Request[v1.TopKAddRequest]) (*connect.Response[v1.TopKAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	expelled, err := s.store.TopKAdd(req.Msg.Key, req.Msg.Items...)
+	if err != nil {
+		s.logger.Printf("Error adding to Top-K sketch %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TopKAddResponse{Expelled: expelled}), nil
+}
+
+// TopKQuery checks whether items are among the top k of a sketch.
+func (s *RedisServer) TopKQuery(ctx context.Context, req *connect.Request[v1.TopKQueryRequest]) (*connect.Response[v1.TopKQueryResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	found, err := s.store.TopKQuery(req.Msg.Key, req.Msg.Items...)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.TopKQueryResponse{Found: found}), nil
+}
+
+// TopKList returns the top k items of a sketch.
+func (s *RedisServer) TopKList(ctx context.Context, req *connect.Request[v1.TopKListRequest]) (*connect.Response[v1.TopKListResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	top, err := s.store.TopKList(req.Msg.Key)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	items := make([]*v1.TopKItem, len(top))
+	for i, it := range top {
+		items[i] = &v1.TopKItem{Item: it.Item, Count: it.Count}
+	}
+	return connect.NewResponse(&v1.TopKListResponse{Items: items}), nil
+}
@@ -0,0 +1,213 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	v1 "redis/internal/gen/cloud/v1"
+	Kvstore "redis/internal/store"
+
+	"connectrpc.com/connect"
+)
+
+// ZAdd adds members to a sorted set or updates their scores.
+func (s *RedisServer) ZAdd(ctx context.Context, req *connect.Request[v1.ZAddRequest]) (*connect.Response[v1.ZAddResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	switch {
+	case req.Msg.Nx && req.Msg.Xx:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("nx and xx options are not compatible"))
+	case req.Msg.Nx && (req.Msg.Gt || req.Msg.Lt), req.Msg.Gt && req.Msg.Lt:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("gt, lt and nx options are not compatible"))
+	case req.Msg.Incr && len(req.Msg.Members) > 1:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("incr option supports a single member"))
+	}
+
+	opts := Kvstore.ZAddOptions{
+		NX: req.Msg.Nx,
+		XX: req.Msg.Xx,
+		GT: req.Msg.Gt,
+		LT: req.Msg.Lt,
+		CH: req.Msg.Ch,
+	}
+	if req.Msg.Incr {
+		m := req.Msg.Members[0]
+		score, ok, err := s.store.ZAddIncr(req.Msg.Key, m.Member, m.Score, opts)
+		if err != nil {
+			s.logger.Printf("Error incrementing %s in sorted set %s: %v", m.Member, req.Msg.Key, err)
+			return nil, storeError(err)
+		}
+		resp := &v1.ZAddResponse{}
+		if ok {
+			resp.Score = &score
+		}
+		return connect.NewResponse(resp), nil
+	}
+
+	members := make([]Kvstore.ZMember, len(req.Msg.Members))
+	for i, m := range req.Msg.Members {
+		members[i] = Kvstore.ZMember{Member: m.Member, Score: m.Score}
+	}
+	count, err := s.store.ZAdd(req.Msg.Key, members, opts)
+	if err != nil {
+		s.logger.Printf("Error adding to sorted set %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ZAddResponse{Count: int64(count)}), nil
+}
+
+// ZRem removes members from a sorted set.
+func (s *RedisServer) ZRem(ctx context.Context, req *connect.Request[v1.ZRemRequest]) (*connect.Response[v1.ZRemResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	removed, err := s.store.ZRem(req.Msg.Key, req.Msg.Members...)
+	if err != nil {
+		s.logger.Printf("Error removing from sorted set %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ZRemResponse{RemovedCount: int64(removed)}), nil
+}
+
+// ZScore retrieves the score of a sorted set member.
+func (s *RedisServer) ZScore(ctx context.Context, req *connect.Request[v1.ZScoreRequest]) (*connect.Response[v1.ZScoreResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	score, err := s.store.ZScore(req.Msg.Key, req.Msg.Member)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ZScoreResponse{Score: score}), nil
+}
+
+// ZRank retrieves the rank of a sorted set member.
+func (s *RedisServer) ZRank(ctx context.Context, req *connect.Request[v1.ZRankRequest]) (*connect.Response[v1.ZRankResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	rank, err := s.store.ZRank(req.Msg.Key, req.Msg.Member, req.Msg.Rev)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ZRankResponse{Rank: int64(rank)}), nil
+}
+
+// ZRange retrieves sorted set members by rank, score or lex range.
+func (s *RedisServer) ZRange(ctx context.Context, req *connect.Request[v1.ZRangeRequest]) (*connect.Response[v1.ZRangeResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.ByScore && req.Msg.ByLex {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("by_score and by_lex are not compatible"))
+	}
+
+	offset, count := 0, -1
+	if limit := req.Msg.Limit; limit != nil {
+		if !req.Msg.ByScore && !req.Msg.ByLex {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("limit is only supported with by_score or by_lex"))
+		}
+		offset, count = int(limit.Offset), int(limit.Count)
+	}
+
+	// With rev the range is given from the highest bound to the lowest.
+	lo, hi := req.Msg.Start, req.Msg.Stop
+	if req.Msg.Rev {
+		lo, hi = hi, lo
+	}
+
+	var members []Kvstore.ZMember
+	var err error
+	switch {
+	case req.Msg.ByScore:
+		r, perr := Kvstore.ParseScoreRange(lo, hi)
+		if perr != nil {
+			return nil, storeError(perr)
+		}
+		members, err = s.store.ZRangeByScore(req.Msg.Key, r, req.Msg.Rev, offset, count)
+	case req.Msg.ByLex:
+		r, perr := Kvstore.ParseLexRange(lo, hi)
+		if perr != nil {
+			return nil, storeError(perr)
+		}
+		members, err = s.store.ZRangeByLex(req.Msg.Key, r, req.Msg.Rev, offset, count)
+	default:
+		start, serr := strconv.ParseInt(req.Msg.Start, 10, 64)
+		stop, perr := strconv.ParseInt(req.Msg.Stop, 10, 64)
+		if serr != nil || perr != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("start and stop must be integers"))
+		}
+		members, err = s.store.ZRange(req.Msg.Key, start, stop, req.Msg.Rev)
+	}
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ZRangeResponse{Members: zmembers(members)}), nil
+}
+
+// ZCount counts the sorted set members within a score range.
+func (s *RedisServer) ZCount(ctx context.Context, req *connect.Request[v1.ZCountRequest]) (*connect.Response[v1.ZCountResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	r, err := Kvstore.ParseScoreRange(req.Msg.Min, req.Msg.Max)
+	if err != nil {
+		return nil, storeError(err)
+	}
+	count, err := s.store.ZCount(req.Msg.Key, r)
+	if err != nil {
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ZCountResponse{Count: int64(count)}), nil
+}
+
+// ZPopMin removes and returns the members with the lowest scores.
+func (s *RedisServer) ZPopMin(ctx context.Context, req *connect.Request[v1.ZPopMinRequest]) (*connect.Response[v1.ZPopMinResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	members, err := s.store.ZPopMin(req.Msg.Key, int(req.Msg.Count))
+	if err != nil {
+		s.logger.Printf("Error popping from sorted set %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ZPopMinResponse{Members: zmembers(members)}), nil
+}
+
+// ZPopMax removes and returns the members with the highest scores.
+func (s *RedisServer) ZPopMax(ctx context.Context, req *connect.Request[v1.ZPopMaxRequest]) (*connect.Response[v1.ZPopMaxResponse], error) {
+	if err := s.validator.Validate(req.Msg); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	members, err := s.store.ZPopMax(req.Msg.Key, int(req.Msg.Count))
+	if err != nil {
+		s.logger.Printf("Error popping from sorted set %s: %v", req.Msg.Key, err)
+		return nil, storeError(err)
+	}
+
+	return connect.NewResponse(&v1.ZPopMaxResponse{Members: zmembers(members)}), nil
+}
+
+// zmembers converts sorted set members to their API representation.
+func zmembers(members []Kvstore.ZMember) []*v1.ZMember {
+	out := make([]*v1.ZMember, len(members))
+	for i, m := range members {
+		out[i] = &v1.ZMember{Member: m.Member, Score: m.Score}
+	}
+	return out
+}
@@ -0,0 +1,287 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc64"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/raft"
+)
+
+const (
+	backupMagic   = "MREDISDB"
+	backupVersion = 1
+
+	// MaxBackupSize bounds the snapshot payload of a backup, so a corrupt
+	// or hostile header cannot make a restore allocate unbounded memory.
+	MaxBackupSize = 1 << 30 // 1 GB
+)
+
+var crcTable = crc64.MakeTable(crc64.ECMA)
+
+// ErrInvalidBackupName is returned for a backup file name that is not a
+// plain name ending in .rdb.
+var ErrInvalidBackupName = errors.New("backup file name must be a plain file name ending in .rdb")
+
+// BackupInfo describes the contents of a backup file.
+type BackupInfo struct {
+	Version uint32 // Format version of the file
+	Index   uint64 // Raft index of the snapshot the backup was taken from
+	Term    uint64 // Raft term of the snapshot the backup was taken from
+	Keys    uint64 // Number of keys in the backup
+}
+
+// backupHeader is the fixed-size header at the start of a backup file. It is
+// followed by the snapshot payload and a CRC-64 of everything before it.
+type backupHeader struct {
+	Magic   [8]byte
+	Version uint32
+	Index   uint64
+	Term    uint64
+	Keys    uint64
+	Length  uint64 // Length of the snapshot payload in bytes
+}
+
+// Backup writes a point-in-time dump of the store to filename inside
+// BackupDir. The dump is taken from a Raft snapshot, so writes are only
+// blocked while the FSM copies its values, not while they are encoded or
+// the file is written.
+func (s *Store) Backup(filename string) (*BackupInfo, error) {
+	path, err := s.backupPath(filename)
+	if err != nil {
+		return nil, err
+	}
+	info, payload, err := s.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeBackupFile(path, info, payload); err != nil {
+		return nil, err
+	}
+	s.logger.Printf("wrote backup %s with %d keys at index %d", filename, info.Keys, info.Index)
+	return info, nil
+}
+
+// BackupTo writes a point-in-time dump of the store to w, in the same format
+// as Backup.
+func (s *Store) BackupTo(w io.Writer) (*BackupInfo, error) {
+	info, payload, err := s.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	if err := writeBackup(w, info, payload); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// snapshot takes a Raft user snapshot and returns its payload along with
+// the information stored in the backup header.
+func (s *Store) snapshot() (*BackupInfo, []byte, error) {
+	future := s.raft.Snapshot()
+	if err := future.Error(); err != nil {
+		return nil, nil, fmt.Errorf("snapshot: %s", err)
+	}
+	meta, rc, err := future.Open()
+	if err != nil {
+		return nil, nil, fmt.Errorf("open snapshot: %s", err)
+	}
+	defer rc.Close()
+
+	payload, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read snapshot: %s", err)
+	}
+	entries, err := readSnapshot(bytes.NewReader(payload))
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode snapshot: %s", err)
+	}
+
+	return &BackupInfo{
+		Version: backupVersion,
+		Index:   meta.Index,
+		Term:    meta.Term,
+		Keys:    uint64(len(entries)),
+	}, payload, nil
+}
+
+// Restore replaces the state of the whole cluster with the contents of the
+// backup file filename inside BackupDir. The file is verified on the leader
+// and then installed through Raft, so every node ends up with the same data.
+//
+// It is a disaster recovery operation: while followers install the snapshot
+// the leader commits ahead of them, so it should be run on a fresh cluster
+// and not during normal operation.
+func (s *Store) Restore(filename string) (*BackupInfo, error) {
+	path, err := s.backupPath(filename)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return s.RestoreFrom(f)
+}
+
+// RestoreFrom verifies the backup read from r and installs it as a Raft
+// snapshot. The leader applies it first and followers receive it through the
+// install snapshot process. Like Restore, it is meant for disaster recovery.
+func (s *Store) RestoreFrom(r io.Reader) (*BackupInfo, error) {
+	if s.raft.State() != raft.Leader {
+		return nil, fmt.Errorf("not leader")
+	}
+
+	info, payload, err := readBackup(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode every entry up front so a bad payload is rejected before the
+	// current state is thrown away.
+	entries, err := readSnapshot(bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("decode backup: %s", err)
+	}
+	if uint64(len(entries)) != info.Keys {
+		return nil, fmt.Errorf("backup holds %d keys, header says %d", len(entries), info.Keys)
+	}
+	for _, entry := range entries {
+		if _, err := decodeEntry(entry); err != nil {
+			return nil, fmt.Errorf("decode backup: %s", err)
+		}
+	}
+
+	// The index and term in the header describe the cluster the backup was
+	// taken from. Leave them out so Raft installs the snapshot just past its
+	// own last index rather than jumping the log forward to them.
+	meta := &raft.SnapshotMeta{
+		Version: raft.SnapshotVersionMax,
+		Size:    int64(len(payload)),
+	}
+	if err := s.raft.Restore(meta, bytes.NewReader(payload), raftTimeout); err != nil {
+		return nil, fmt.Errorf("restore: %s", err)
+	}
+	s.logger.Printf("restored backup with %d keys from index %d", info.Keys, info.Index)
+	return info, nil
+}
+
+// backupPath returns the path of the backup file filename inside BackupDir.
+// Clients choose the name, so it must not reach outside the directory.
+func (s *Store) backupPath(filename string) (string, error) {
+	if strings.ContainsAny(filename, `/\`) || strings.Contains(filename, "..") ||
+		!strings.HasSuffix(filename, ".rdb") || filename == ".rdb" {
+		return "", ErrInvalidBackupName
+	}
+	dir := filepath.Clean(s.BackupDir)
+	path := filepath.Join(dir, filename)
+	if filepath.Dir(path) != dir {
+		return "", ErrInvalidBackupName
+	}
+	return path, nil
+}
+
+// writeBackupFile atomically writes a backup file to path.
+func (s *Store) writeBackupFile(path string, info *BackupInfo, payload []byte) error {
+	if err := os.MkdirAll(s.BackupDir, 0700); err != nil {
+		return fmt.Errorf("create backup directory: %s", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := writeBackup(tmp, info, payload); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// writeBackup encodes a backup of payload to w.
+func writeBackup(w io.Writer, info *BackupInfo, payload []byte) error {
+	if len(payload) > MaxBackupSize {
+		return fmt.Errorf("snapshot of %d bytes exceeds the maximum backup size", len(payload))
+	}
+	header := backupHeader{
+		Version: info.Version,
+		Index:   info.Index,
+		Term:    info.Term,
+		Keys:    info.Keys,
+		Length:  uint64(len(payload)),
+	}
+	copy(header.Magic[:], backupMagic)
+
+	h := crc64.New(crcTable)
+	mw := io.MultiWriter(w, h)
+	if err := binary.Write(mw, binary.BigEndian, &header); err != nil {
+		return err
+	}
+	if _, err := mw.Write(payload); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.BigEndian, h.Sum64())
+}
+
+// readBackup decodes a backup from r, verifying its format version and
+// checksum. It returns the header information and the snapshot payload.
+func readBackup(r io.Reader) (*BackupInfo, []byte, error) {
+	h := crc64.New(crcTable)
+	tr := io.TeeReader(r, h)
+
+	var header backupHeader
+	if err := binary.Read(tr, binary.BigEndian, &header); err != nil {
+		return nil, nil, fmt.Errorf("read backup header: %w", err)
+	}
+	if string(header.Magic[:]) != backupMagic {
+		return nil, nil, errors.New("not a backup file")
+	}
+	if header.Version != backupVersion {
+		return nil, nil, fmt.Errorf("unsupported backup version %d", header.Version)
+	}
+
+	if header.Length > MaxBackupSize {
+		return nil, nil, fmt.Errorf("backup payload of %d bytes exceeds the maximum backup size", header.Length)
+	}
+
+	// Read only as much as arrives rather than trusting the header, which
+	// is not verified until the checksum is read.
+	payload, err := io.ReadAll(io.LimitReader(tr, int64(header.Length)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read backup payload: %w", err)
+	}
+	if uint64(len(payload)) != header.Length {
+		return nil, nil, fmt.Errorf("read backup payload: %w", io.ErrUnexpectedEOF)
+	}
+
+	var sum uint64
+	if err := binary.Read(r, binary.BigEndian, &sum); err != nil {
+		return nil, nil, fmt.Errorf("read backup checksum: %w", err)
+	}
+	if sum != h.Sum64() {
+		return nil, nil, errors.New("backup checksum mismatch")
+	}
+
+	return &BackupInfo{
+		Version: header.Version,
+		Index:   header.Index,
+		Term:    header.Term,
+		Keys:    header.Keys,
+	}, payload, nil
+}
@@ -0,0 +1,281 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBackup(t *testing.T) {
+	s := openStore(t)
+	s.BackupDir = t.TempDir()
+
+	if err := s.Set("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("b", "2"); err != nil {
+		t.Fatal(err)
+	}
+	info, err := s.Backup("dump.rdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Keys != 2 || info.Version != backupVersion || info.Index == 0 {
+		t.Fatalf("got backup info %+v, want 2 keys at version %d", info, backupVersion)
+	}
+
+	f, err := os.Open(filepath.Join(s.BackupDir, "dump.rdb"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gotInfo, payload, err := readBackup(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *gotInfo != *info {
+		t.Fatalf("got header %+v, want %+v", gotInfo, info)
+	}
+
+	restored := restore(t, payload)
+	if v, err := restored.Get("a"); err != nil || v != "1" {
+		t.Fatalf("got a = %q, error %v, want 1", v, err)
+	}
+
+	// No temporary files are left behind.
+	files, err := os.ReadDir(s.BackupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("got %d files in the backup directory, want 1", len(files))
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	s := openStore(t)
+	s.BackupDir = t.TempDir()
+
+	if err := s.Set("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Backup("dump.rdb"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Change the store after the backup, then restore it.
+	if err := s.Set("a", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("b", "3"); err != nil {
+		t.Fatal(err)
+	}
+	info, err := s.Restore("dump.rdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Keys != 1 {
+		t.Fatalf("got %d keys restored, want 1", info.Keys)
+	}
+
+	if v, err := s.Get("a"); err != nil || v != "1" {
+		t.Fatalf("got a = %q, error %v, want 1", v, err)
+	}
+	if _, err := s.Get("b"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("got error %v for b, want %v", err, ErrKeyNotFound)
+	}
+
+	// Writes still go through after the restore.
+	if err := s.Set("c", "4"); err != nil {
+		t.Fatalf("set after restore: %v", err)
+	}
+}
+
+func TestRestoreRejectsCorruptBackup(t *testing.T) {
+	s := openStore(t)
+	s.BackupDir = t.TempDir()
+
+	if err := s.Set("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Backup("dump.rdb"); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(s.BackupDir, "dump.rdb")
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[binary.Size(backupHeader{})] ^= 1
+	if err := os.WriteFile(path, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Set("a", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Restore("dump.rdb"); err == nil {
+		t.Fatal("restored a corrupt backup")
+	}
+	if v, err := s.Get("a"); err != nil || v != "2" {
+		t.Fatalf("got a = %q, error %v, want 2", v, err)
+	}
+}
+
+func TestBackupToRestoreFrom(t *testing.T) {
+	s := openStore(t)
+	if err := s.Set("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := s.BackupTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("a", "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A backup whose header disagrees with its payload is rejected and
+	// leaves the store as it is.
+	info, payload, err := readBackup(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info.Keys++
+	var bad bytes.Buffer
+	if err := writeBackup(&bad, info, payload); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RestoreFrom(&bad); err == nil {
+		t.Fatal("restored a backup with the wrong key count")
+	}
+	if v, err := s.Get("a"); err != nil || v != "2" {
+		t.Fatalf("got a = %q, error %v, want 2", v, err)
+	}
+
+	if _, err := s.RestoreFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := s.Get("a"); err != nil || v != "1" {
+		t.Fatalf("got a = %q, error %v, want 1", v, err)
+	}
+
+	// The index in the header belongs to the cluster the backup came from,
+	// so it must not move the Raft log forward.
+	info.Keys--
+	info.Index, info.Term = 1000000, 50
+	var ahead bytes.Buffer
+	if err := writeBackup(&ahead, info, payload); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RestoreFrom(&ahead); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.raft.LastIndex(); got >= info.Index {
+		t.Fatalf("got last index %d after restore, want below %d", got, info.Index)
+	}
+	if err := s.Set("a", "3"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReadBackup checks that truncated or corrupt backups are rejected. A
+// nil wantErr accepts any error.
+func TestReadBackup(t *testing.T) {
+	info := &BackupInfo{Version: backupVersion, Index: 7, Term: 2, Keys: 1}
+	payload := []byte(`{"version":1,"entries":[{"key":"a","type":"string","value":"MQ=="}]}`)
+	var buf bytes.Buffer
+	if err := writeBackup(&buf, info, payload); err != nil {
+		t.Fatal(err)
+	}
+	valid := buf.Bytes()
+	headerSize := binary.Size(backupHeader{})
+
+	gotInfo, gotPayload, err := readBackup(bytes.NewReader(valid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *gotInfo != *info || !bytes.Equal(gotPayload, payload) {
+		t.Fatalf("got %+v %s, want %+v %s", gotInfo, gotPayload, info, payload)
+	}
+
+	corrupt := func(fn func(b []byte) []byte) []byte {
+		return fn(append([]byte(nil), valid...))
+	}
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{"empty", nil, io.EOF},
+		{"short header", valid[:headerSize-1], io.ErrUnexpectedEOF},
+		{"bad magic", corrupt(func(b []byte) []byte { b[0] = 'X'; return b }), nil},
+		{"future version", corrupt(func(b []byte) []byte { b[11] = backupVersion + 1; return b }), nil},
+		{"flipped payload byte", corrupt(func(b []byte) []byte { b[headerSize] ^= 1; return b }), nil},
+		{"flipped checksum byte", corrupt(func(b []byte) []byte { b[len(b)-1] ^= 1; return b }), nil},
+		{"short payload", valid[:headerSize+len(payload)-1], io.ErrUnexpectedEOF},
+		{"missing checksum", valid[:len(valid)-8], io.EOF},
+		{"oversized length", corrupt(func(b []byte) []byte {
+			binary.BigEndian.PutUint64(b[headerSize-8:], MaxBackupSize+1)
+			return b
+		}), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := readBackup(bytes.NewReader(tt.data))
+			if err == nil {
+				t.Fatal("read a corrupt backup")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBackupPath(t *testing.T) {
+	dir := t.TempDir()
+	s := &Store{BackupDir: dir}
+
+	tests := []struct {
+		filename string
+		wantErr  bool
+	}{
+		{"dump.rdb", false},
+		{"dump-2024_01.rdb", false},
+		{".hidden.rdb", false},
+		{".rdb", true},
+		{"dump", true},
+		{"dump.rdb.tmp", true},
+		{"../dump.rdb", true},
+		{"..rdb", true},
+		{"sub/dump.rdb", true},
+		{`sub\dump.rdb`, true},
+		{"/tmp/dump.rdb", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			path, err := s.backupPath(tt.filename)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidBackupName) {
+					t.Fatalf("got path %q, error %v, want %v", path, err, ErrInvalidBackupName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := filepath.Join(dir, tt.filename); path != want {
+				t.Fatalf("got path %q, want %q", path, want)
+			}
+		})
+	}
+}
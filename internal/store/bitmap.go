@@ -0,0 +1,300 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrBitOffset is returned when a bit offset is out of range.
+	ErrBitOffset = errors.New("bit offset is not an integer or out of range")
+
+	// ErrInvalidBitOp is returned for an unknown BITOP operation or a NOT
+	// with more than one source key.
+	ErrInvalidBitOp = errors.New("invalid BITOP operation")
+)
+
+// maxBitOffset is the largest bit offset SETBIT and GETBIT accept. It keeps
+// strings grown by SETBIT within the 512 KB limit on values.
+const maxBitOffset = 524288*8 - 1
+
+// SetBit sets or clears the bit at offset in the string stored at key and
+// returns the bit's previous value. The string is created or grown with zero
+// bytes as needed. Bit 0 is the most significant bit of the first byte.
+func (s *Store) SetBit(key string, offset int64, value int) (int, error) {
+	if offset < 0 || offset > maxBitOffset {
+		return 0, ErrBitOffset
+	}
+	if value != 0 && value != 1 {
+		return 0, ErrNotInteger
+	}
+	resp, err := s.apply(&command{
+		Op:     "setbit",
+		Key:    key,
+		Offset: offset,
+		Bit:    value,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// GetBit returns the bit at offset in the string stored at key. Bits past
+// the end of the string, or of a missing key, are zero.
+func (s *Store) GetBit(key string, offset int64) (int, error) {
+	if offset < 0 || offset > maxBitOffset {
+		return 0, ErrBitOffset
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, err := s.str(key)
+	if err != nil {
+		return 0, err
+	}
+	return bitAt(v, offset), nil
+}
+
+// BitCount returns the number of set bits in the string stored at key
+// between start and end inclusive. The bounds are byte offsets, or bit
+// offsets with bit, and negative ones count from the end.
+func (s *Store) BitCount(key string, start, end int64, bit bool) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, err := s.str(key)
+	if err != nil {
+		return 0, err
+	}
+	lo, hi, ok := bitRange(start, end, len(v), bit)
+	if !ok {
+		return 0, nil
+	}
+
+	var count int64
+	for i := lo; i <= hi; {
+		if i%8 == 0 && i+7 <= hi {
+			count += int64(bits.OnesCount8(v[i/8]))
+			i += 8
+			continue
+		}
+		count += int64(bitAt(v, i))
+		i++
+	}
+	return count, nil
+}
+
+// BitPos returns the offset of the first bit set to value in the string
+// stored at key between start and end inclusive, or -1 if there is none.
+// The bounds are interpreted as by BitCount. When looking for a clear bit
+// without an explicit end, the string is treated as padded with zeros.
+func (s *Store) BitPos(key string, value int, start, end int64, endSet, bit bool) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.peek(key)
+	if !ok {
+		if value == 0 {
+			return 0, nil
+		}
+		return -1, nil
+	}
+	v, err := item.str()
+	if err != nil {
+		return 0, err
+	}
+	if !endSet {
+		end = -1
+	}
+	lo, hi, ok := bitRange(start, end, len(v), bit)
+	if !ok {
+		return -1, nil
+	}
+
+	skip := byte(0xff) // Bytes that cannot contain the bit
+	if value == 1 {
+		skip = 0
+	}
+	for i := lo; i <= hi; {
+		if i%8 == 0 && i+7 <= hi && v[i/8] == skip {
+			i += 8
+			continue
+		}
+		if bitAt(v, i) == value {
+			return i, nil
+		}
+		i++
+	}
+	if value == 0 && !endSet {
+		return hi + 1, nil
+	}
+	return -1, nil
+}
+
+// BitOp stores the result of a bitwise AND, OR, XOR or NOT of the strings
+// stored at keys in destination, and returns its length. Shorter strings
+// are treated as padded with zero bytes. The operation runs as a single
+// Raft entry.
+func (s *Store) BitOp(op, destination string, keys ...string) (int, error) {
+	op = strings.ToLower(op)
+	switch op {
+	case "and", "or", "xor":
+	case "not":
+		if len(keys) != 1 {
+			return 0, fmt.Errorf("%w: NOT takes a single source key", ErrInvalidBitOp)
+		}
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrInvalidBitOp, op)
+	}
+
+	resp, err := s.apply(&command{
+		Op:    "bitop",
+		Key:   destination,
+		Keys:  keys,
+		Flags: []string{op},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// str returns the string stored at key, or an empty string if the key does
+// not exist. It must be called with the lock held.
+func (s *Store) str(key string) (string, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return "", nil
+	}
+	return item.str()
+}
+
+// bitAt returns the bit at offset in v, which is zero past the end.
+func bitAt(v string, offset int64) int {
+	i := offset / 8
+	if i >= int64(len(v)) {
+		return 0
+	}
+	return int(v[i]>>(7-offset%8)) & 1
+}
+
+// byteRange converts inclusive start and end offsets, which may be negative,
+// into a range over n elements the way Redis does for strings. It reports
+// false if the range is empty.
+func byteRange(start, end, n int64) (int64, int64, bool) {
+	if start < 0 {
+		start += n
+	}
+	if end < 0 {
+		end += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if end < 0 {
+		end = 0
+	}
+	if end >= n {
+		end = n - 1
+	}
+	if n == 0 || start > end {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// bitRange converts a byte range, or with bit a bit range, over a string of
+// n bytes to an inclusive range of bit offsets.
+func bitRange(start, end int64, n int, bit bool) (int64, int64, bool) {
+	if bit {
+		return byteRange(start, end, int64(n)*8)
+	}
+	lo, hi, ok := byteRange(start, end, int64(n))
+	return lo * 8, hi*8 + 7, ok
+}
+
+func (f *fsm) applySetBit(key string, offset int64, value int, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: ""}
+	}
+	v, err := item.str()
+	if err != nil {
+		return err
+	}
+
+	b := []byte(v)
+	if i := int(offset / 8); i >= len(b) {
+		b = append(b, make([]byte, i+1-len(b))...)
+	}
+	old := bitAt(v, offset)
+	mask := byte(1) << (7 - offset%8)
+	if value == 1 {
+		b[offset/8] |= mask
+	} else {
+		b[offset/8] &^= mask
+	}
+
+	item.value = string(b)
+	f.cache.Add(key, item)
+	return old
+}
+
+func (f *fsm) applyBitOp(op, destination string, keys []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	srcs := make([]string, len(keys))
+	n := 0
+	for i, key := range keys {
+		if item, ok := f.lookup(key, now); ok {
+			v, err := item.str()
+			if err != nil {
+				return err
+			}
+			srcs[i] = v
+		}
+		n = max(n, len(srcs[i]))
+	}
+
+	result := make([]byte, n)
+	for i := range result {
+		var b byte
+		for j, src := range srcs {
+			var c byte
+			if i < len(src) {
+				c = src[i]
+			}
+			switch {
+			case j == 0:
+				b = c
+			case op == "and":
+				b &= c
+			case op == "or":
+				b |= c
+			case op == "xor":
+				b ^= c
+			}
+		}
+		if op == "not" {
+			b = ^b
+		}
+		result[i] = b
+	}
+
+	// The destination is overwritten whatever its type, and loses its TTL.
+	if n == 0 {
+		f.cache.Remove(destination)
+		return 0
+	}
+	f.cache.Add(destination, cacheItem{value: string(result)})
+	return n
+}
@@ -0,0 +1,164 @@
+package store
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetBit(t *testing.T) {
+	s := openStore(t)
+
+	if old, err := s.SetBit("b", 7, 1); err != nil || old != 0 {
+		t.Fatalf("SetBit = %d, %v, want 0", old, err)
+	}
+	if v, err := s.Get("b"); err != nil || v != "\x01" {
+		t.Fatalf("Get = %q, %v, want \\x01", v, err)
+	}
+	if old, err := s.SetBit("b", 7, 0); err != nil || old != 1 {
+		t.Fatalf("SetBit = %d, %v, want 1", old, err)
+	}
+	if _, err := s.SetBit("b", 17, 1); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := s.Get("b"); err != nil || v != "\x00\x00\x40" {
+		t.Fatalf("Get after growing = %q, %v, want \\x00\\x00\\x40", v, err)
+	}
+	if bit, err := s.GetBit("b", 17); err != nil || bit != 1 {
+		t.Fatalf("GetBit(17) = %d, %v, want 1", bit, err)
+	}
+	if bit, err := s.GetBit("b", 1000); err != nil || bit != 0 {
+		t.Fatalf("GetBit past the end = %d, %v, want 0", bit, err)
+	}
+
+	// The last bit of the largest value can be set, the one after it cannot.
+	if _, err := s.SetBit("max", maxBitOffset, 1); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.StrLen("max"); err != nil || n != 524288 {
+		t.Fatalf("StrLen after setting the last bit = %d, %v, want 524288", n, err)
+	}
+	for _, offset := range []int64{-1, maxBitOffset + 1, 1<<32 - 1} {
+		if _, err := s.SetBit("b", offset, 1); !errors.Is(err, ErrBitOffset) {
+			t.Errorf("SetBit(%d) = %v, want %v", offset, err, ErrBitOffset)
+		}
+		if _, err := s.GetBit("b", offset); !errors.Is(err, ErrBitOffset) {
+			t.Errorf("GetBit(%d) = %v, want %v", offset, err, ErrBitOffset)
+		}
+	}
+
+	if _, err := s.HSet("h", map[string]string{"f": "v"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SetBit("h", 0, 1); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("SetBit on a hash = %v, want %v", err, ErrWrongType)
+	}
+}
+
+// TestBitCountPos uses the examples from the Redis documentation.
+func TestBitCountPos(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.Set("foobar", "foobar"); err != nil {
+		t.Fatal(err)
+	}
+	counts := []struct {
+		start, end int64
+		bit        bool
+		want       int64
+	}{
+		{0, -1, false, 26},
+		{0, 0, false, 4},
+		{1, 1, false, 6},
+		{5, 30, true, 17},
+		{-2, -1, false, 7},
+		{10, 20, false, 0},
+	}
+	for _, tt := range counts {
+		if got, err := s.BitCount("foobar", tt.start, tt.end, tt.bit); err != nil || got != tt.want {
+			t.Errorf("BitCount(%d, %d, %v) = %d, %v, want %d", tt.start, tt.end, tt.bit, got, err, tt.want)
+		}
+	}
+
+	for key, value := range map[string]string{"a": "\xff\xf0\x00", "b": "\x00\xff\xf0", "c": "\x00\x00\x00"} {
+		if err := s.Set(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	positions := []struct {
+		key        string
+		value      int
+		start, end int64
+		endSet     bool
+		bit        bool
+		want       int64
+	}{
+		{"a", 0, 0, 0, false, false, 12},
+		{"b", 1, 0, 0, false, false, 8},
+		{"b", 1, 2, 0, false, false, 16},
+		{"b", 1, 2, -1, true, false, 16},
+		{"b", 1, 7, 15, true, true, 8},
+		{"c", 1, 0, 0, false, false, -1},
+		{"b", 1, 7, -3, true, true, 8},
+		{"b", 1, 0, 6, true, true, -1},
+		{"a", 0, 0, 1, true, false, 12},
+		{"missing", 0, 0, 0, false, false, 0},
+		{"missing", 1, 0, 0, false, false, -1},
+	}
+	for _, tt := range positions {
+		got, err := s.BitPos(tt.key, tt.value, tt.start, tt.end, tt.endSet, tt.bit)
+		if err != nil || got != tt.want {
+			t.Errorf("BitPos(%s, %d, %d, %d) = %d, %v, want %d", tt.key, tt.value, tt.start, tt.end, got, err, tt.want)
+		}
+	}
+	if err := s.Set("ff", "\xff\xff"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.BitPos("ff", 0, 0, 0, false, false); err != nil || got != 16 {
+		t.Errorf("BitPos of a clear bit without an end = %d, %v, want 16", got, err)
+	}
+}
+
+func TestBitOp(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.Set("key1", "foobar"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("key2", "abcdef"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		op   string
+		keys []string
+		want string
+	}{
+		{"AND", []string{"key1", "key2"}, "`bc`ab"},
+		{"OR", []string{"key1", "key2"}, "goofev"},
+		{"XOR", []string{"key1", "key2"}, "\x07\x0d\x0c\x06\x04\x14"},
+		{"NOT", []string{"key1"}, "\x99\x90\x90\x9d\x9e\x8d"},
+		{"OR", []string{"key1", "missing"}, "foobar"},
+	}
+	for _, tt := range tests {
+		n, err := s.BitOp(tt.op, "dest", tt.keys...)
+		if err != nil || n != len(tt.want) {
+			t.Fatalf("BitOp(%s) = %d, %v, want %d", tt.op, n, err, len(tt.want))
+		}
+		if got, err := s.Get("dest"); err != nil || got != tt.want {
+			t.Fatalf("BitOp(%s) stored %q, %v, want %q", tt.op, got, err, tt.want)
+		}
+	}
+
+	if n, err := s.BitOp("AND", "dest", "missing"); err != nil || n != 0 {
+		t.Fatalf("BitOp of missing keys = %d, %v, want 0", n, err)
+	}
+	if _, err := s.Get("dest"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("an empty result did not delete the destination: %v", err)
+	}
+	if _, err := s.BitOp("NOT", "dest", "key1", "key2"); !errors.Is(err, ErrInvalidBitOp) {
+		t.Fatalf("BitOp NOT with two keys = %v, want %v", err, ErrInvalidBitOp)
+	}
+	if _, err := s.BitOp("NAND", "dest", "key1"); !errors.Is(err, ErrInvalidBitOp) {
+		t.Fatalf("BitOp NAND = %v, want %v", err, ErrInvalidBitOp)
+	}
+}
@@ -0,0 +1,258 @@
+package store
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+var (
+	// ErrFilterFull is returned when adding to a non-scaling filter that is at
+	// capacity.
+	ErrFilterFull = errors.New("non-scaling filter is full")
+	// ErrInvalidFilter is returned for filter parameters out of range.
+	ErrInvalidFilter = errors.New("invalid filter parameters")
+)
+
+// Defaults for Bloom filters created by BFAdd, as in RedisBloom.
+const (
+	bloomErrorRate = 0.01
+	bloomCapacity  = 100
+	bloomExpansion = 2
+)
+
+const (
+	bloomMaxBits = 1 << 30 // 128 MB per layer
+	bloomSeed    = 0xc70f6907
+)
+
+// bloomValue is the value of a Bloom filter key. It is a scalable Bloom
+// filter: once a layer holds its capacity, a layer with expansion times the
+// capacity and half the error rate is added. A zero expansion makes the
+// filter non-scaling.
+type bloomValue struct {
+	ErrorRate float64       `json:"error_rate"`
+	Expansion int           `json:"expansion"`
+	Layers    []*bloomLayer `json:"layers"`
+}
+
+// bloomLayer is a single Bloom filter of a scalable filter.
+type bloomLayer struct {
+	Bits     []byte `json:"bits"`
+	Hashes   int    `json:"hashes"`
+	Capacity int64  `json:"capacity"`
+	Count    int64  `json:"count"`
+}
+
+func (b *bloomValue) clone() *bloomValue {
+	c := *b
+	c.Layers = make([]*bloomLayer, len(b.Layers))
+	for i, l := range b.Layers {
+		cl := *l
+		cl.Bits = slices.Clone(l.Bits)
+		c.Layers[i] = &cl
+	}
+	return &c
+}
+
+// newBloomLayer sizes a layer for capacity items at errorRate.
+func newBloomLayer(capacity int64, errorRate float64) *bloomLayer {
+	bits := int64(math.Ceil(bloomBits(capacity, errorRate)))
+	return &bloomLayer{
+		Bits:     make([]byte, (bits+7)/8),
+		Hashes:   max(1, int(math.Ceil(-math.Log2(errorRate)))),
+		Capacity: capacity,
+	}
+}
+
+// bloomHashes returns the two hashes an item's bit positions are derived
+// from. They depend only on the item, so every replica sets the same bits.
+func bloomHashes(item string) (uint64, uint64) {
+	h1 := murmurHash64A([]byte(item), bloomSeed)
+	h2 := murmurHash64A([]byte(item), h1)
+	return h1, h2 | 1
+}
+
+func (b *bloomLayer) has(h1, h2 uint64) bool {
+	m := uint64(len(b.Bits)) * 8
+	for i := 0; i < b.Hashes; i++ {
+		pos := (h1 + uint64(i)*h2) % m
+		if b.Bits[pos/8]&(1<<(pos%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *bloomLayer) add(h1, h2 uint64) {
+	m := uint64(len(b.Bits)) * 8
+	for i := 0; i < b.Hashes; i++ {
+		pos := (h1 + uint64(i)*h2) % m
+		b.Bits[pos/8] |= 1 << (pos % 8)
+	}
+	b.Count++
+}
+
+func (f *bloomValue) has(h1, h2 uint64) bool {
+	for _, l := range f.Layers {
+		if l.has(h1, h2) {
+			return true
+		}
+	}
+	return false
+}
+
+// add adds an item with the given hashes and reports whether it was new.
+func (f *bloomValue) add(h1, h2 uint64) (bool, error) {
+	if f.has(h1, h2) {
+		return false, nil
+	}
+	last := f.Layers[len(f.Layers)-1]
+	if last.Count >= last.Capacity {
+		if f.Expansion == 0 {
+			return false, ErrFilterFull
+		}
+		capacity := last.Capacity * int64(f.Expansion)
+		errorRate := f.ErrorRate * math.Pow(0.5, float64(len(f.Layers)))
+		if capacity/last.Capacity != int64(f.Expansion) || bloomBits(capacity, errorRate) > bloomMaxBits {
+			return false, ErrFilterFull
+		}
+		last = newBloomLayer(capacity, errorRate)
+		f.Layers = append(f.Layers, last)
+	}
+	last.add(h1, h2)
+	return true, nil
+}
+
+// bloomBits returns the number of bits a layer needs.
+func bloomBits(capacity int64, errorRate float64) float64 {
+	return -float64(capacity) * math.Log(errorRate) / (math.Ln2 * math.Ln2)
+}
+
+// checkBloom validates the parameters of a Bloom filter.
+func checkBloom(errorRate float64, capacity int64, expansion int) error {
+	if !(errorRate > 0 && errorRate < 1) || capacity < 1 || expansion < 0 ||
+		bloomBits(capacity, errorRate) > bloomMaxBits {
+		return ErrInvalidFilter
+	}
+	return nil
+}
+
+// BFReserve creates an empty Bloom filter at key for capacity items with the
+// given false positive rate. When the filter fills up a layer with expansion
+// times the capacity is added; a zero expansion makes the filter
+// non-scaling. It fails if the key exists.
+func (s *Store) BFReserve(key string, errorRate float64, capacity int64, expansion int) error {
+	if err := checkBloom(errorRate, capacity, expansion); err != nil {
+		return err
+	}
+	_, err := s.apply(&command{
+		Op:        "bfreserve",
+		Key:       key,
+		ErrorRate: errorRate,
+		Capacity:  capacity,
+		Expansion: expansion,
+	})
+	return err
+}
+
+// BFAdd adds items to the Bloom filter stored at key, creating a filter
+// with the default parameters if the key does not exist. It reports for
+// each item whether it was added, that is whether it may not have been in
+// the filter before. If a non-scaling filter fills up, the items before it
+// stay added and ErrFilterFull is returned.
+func (s *Store) BFAdd(key string, items ...string) ([]bool, error) {
+	resp, err := s.apply(&command{
+		Op:     "bfadd",
+		Key:    key,
+		Values: items,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]bool), nil
+}
+
+// BFExists reports for each item whether it may be in the Bloom filter
+// stored at key. A missing key holds no items.
+func (s *Store) BFExists(key string, items ...string) ([]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := s.bloom(key)
+	if err != nil {
+		return nil, err
+	}
+	exists := make([]bool, len(items))
+	if f == nil {
+		return exists, nil
+	}
+	for i, item := range items {
+		exists[i] = f.has(bloomHashes(item))
+	}
+	return exists, nil
+}
+
+// bloom returns the Bloom filter stored at key, or nil if the key does not
+// exist. It must be called with the lock held.
+func (s *Store) bloom(key string) (*bloomValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	f, ok := item.value.(*bloomValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return f, nil
+}
+
+func (f *fsm) applyBFReserve(key string, errorRate float64, capacity int64, expansion int, now time.Time) interface{} {
+	if err := checkBloom(errorRate, capacity, expansion); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.lookup(key, now); ok {
+		return ErrKeyExists
+	}
+	f.cache.Add(key, cacheItem{value: &bloomValue{
+		ErrorRate: errorRate,
+		Expansion: expansion,
+		Layers:    []*bloomLayer{newBloomLayer(capacity, errorRate)},
+	}})
+	return nil
+}
+
+func (f *fsm) applyBFAdd(key string, items []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: &bloomValue{
+			ErrorRate: bloomErrorRate,
+			Expansion: bloomExpansion,
+			Layers:    []*bloomLayer{newBloomLayer(bloomCapacity, bloomErrorRate)},
+		}}
+	}
+	bf, ok := item.value.(*bloomValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	added := make([]bool, len(items))
+	for i, it := range items {
+		var err error
+		if added[i], err = bf.add(bloomHashes(it)); err != nil {
+			// Items added before the filter filled up stay added.
+			f.cache.Add(key, item)
+			return err
+		}
+	}
+	f.cache.Add(key, item)
+	return added
+}
@@ -0,0 +1,143 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBloom(t *testing.T) {
+	s := openStore(t)
+
+	added, err := s.BFAdd("bf", "a", "b", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []bool{true, true, false}; fmt.Sprint(added) != fmt.Sprint(want) {
+		t.Fatalf("BFAdd = %v, want %v", added, want)
+	}
+	exists, err := s.BFExists("bf", "a", "b", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []bool{true, true, false}; fmt.Sprint(exists) != fmt.Sprint(want) {
+		t.Fatalf("BFExists = %v, want %v", exists, want)
+	}
+	if exists, err := s.BFExists("missing", "a"); err != nil || exists[0] {
+		t.Fatalf("BFExists on a missing key = %v, %v, want false", exists, err)
+	}
+
+	if err := s.BFReserve("bf", 0.01, 100, 2); !errors.Is(err, ErrKeyExists) {
+		t.Fatalf("BFReserve on an existing key = %v, want %v", err, ErrKeyExists)
+	}
+	if err := s.Set("str", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.BFAdd("str", "a"); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("BFAdd on a string = %v, want %v", err, ErrWrongType)
+	}
+}
+
+func TestBFReserve(t *testing.T) {
+	s := openStore(t)
+
+	tests := []struct {
+		name      string
+		errorRate float64
+		capacity  int64
+		expansion int
+		wantErr   error
+	}{
+		{"valid", 0.01, 1000, 2, nil},
+		{"non-scaling", 0.001, 10, 0, nil},
+		{"zero error rate", 0, 1000, 2, ErrInvalidFilter},
+		{"error rate of one", 1, 1000, 2, ErrInvalidFilter},
+		{"zero capacity", 0.01, 0, 2, ErrInvalidFilter},
+		{"negative expansion", 0.01, 1000, -1, ErrInvalidFilter},
+		{"too large", 1e-9, 1 << 40, 2, ErrInvalidFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.BFReserve(tt.name, tt.errorRate, tt.capacity, tt.expansion)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBloomScaling(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.BFReserve("scaling", 0.01, 100, 2); err != nil {
+		t.Fatal(err)
+	}
+	items := make([]string, 1000)
+	for i := range items {
+		items[i] = fmt.Sprintf("item-%d", i)
+	}
+	if _, err := s.BFAdd("scaling", items...); err != nil {
+		t.Fatal(err)
+	}
+	s.mu.Lock()
+	f, _ := s.bloom("scaling")
+	s.mu.Unlock()
+	if len(f.Layers) < 2 {
+		t.Fatalf("got %d layers after adding past the capacity, want more than 1", len(f.Layers))
+	}
+	exists, err := s.BFExists("scaling", items...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, ok := range exists {
+		if !ok {
+			t.Fatalf("added item %s is missing", items[i])
+		}
+	}
+
+	var others []string
+	for i := range items {
+		others = append(others, fmt.Sprintf("other-%d", i))
+	}
+	exists, err = s.BFExists("scaling", others...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	positives := 0
+	for _, ok := range exists {
+		if ok {
+			positives++
+		}
+	}
+	if positives > len(others)/20 {
+		t.Fatalf("got %d false positives in %d items", positives, len(others))
+	}
+
+	if err := s.BFReserve("fixed", 0.01, 10, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.BFAdd("fixed", items[:20]...); !errors.Is(err, ErrFilterFull) {
+		t.Fatalf("BFAdd past a non-scaling capacity = %v, want %v", err, ErrFilterFull)
+	}
+	if exists, err := s.BFExists("fixed", items[0]); err != nil || !exists[0] {
+		t.Fatal("items added before the filter filled up were dropped")
+	}
+}
+
+func TestApplyBloomDeterministic(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	var bits [][]byte
+	for i := 0; i < 2; i++ {
+		f := (*fsm)(New(true))
+		f.applyBFAdd("bf", []string{"a", "b", "c"}, now)
+		item, _ := f.lookup("bf", now)
+		bits = append(bits, item.value.(*bloomValue).Layers[0].Bits)
+	}
+	if !bytes.Equal(bits[0], bits[1]) {
+		t.Fatal("replicas set different bits")
+	}
+}
@@ -0,0 +1,170 @@
+package store
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// cmsMaxCounters bounds the size of a Count-Min Sketch.
+const cmsMaxCounters = 1 << 24 // 128 MB
+
+// cmsValue is the value of a Count-Min Sketch key: depth rows of width
+// counters, each row indexed by a differently seeded hash of the item.
+type cmsValue struct {
+	Width    int      `json:"width"`
+	Depth    int      `json:"depth"`
+	Counters []uint64 `json:"counters"`
+}
+
+// CMSIncrement is an item and the amount to increment its count by.
+type CMSIncrement struct {
+	Item      string
+	Increment uint64
+}
+
+func (c *cmsValue) clone() *cmsValue {
+	cc := *c
+	cc.Counters = slices.Clone(c.Counters)
+	return &cc
+}
+
+// index returns the position of item's counter in row.
+func (c *cmsValue) index(row int, item string) int {
+	h := murmurHash64A([]byte(item), uint64(row))
+	return row*c.Width + int(h%uint64(c.Width))
+}
+
+// count returns the estimated count of item, the minimum of its counters.
+func (c *cmsValue) count(item string) uint64 {
+	n := uint64(math.MaxUint64)
+	for row := 0; row < c.Depth; row++ {
+		n = min(n, c.Counters[c.index(row, item)])
+	}
+	return n
+}
+
+// CMSInitByDim creates a Count-Min Sketch at key with the given width and
+// depth. It fails if the key exists.
+func (s *Store) CMSInitByDim(key string, width, depth int) error {
+	if width < 1 || depth < 1 || width > cmsMaxCounters/depth {
+		return ErrInvalidFilter
+	}
+	_, err := s.apply(&command{
+		Op:    "cmsinitbydim",
+		Key:   key,
+		Width: width,
+		Depth: depth,
+	})
+	return err
+}
+
+// CMSIncrBy applies increments to the Count-Min Sketch stored at key and
+// returns the estimated count of each item afterwards. Either all counts
+// are incremented or, if a counter would overflow, none.
+func (s *Store) CMSIncrBy(key string, increments []CMSIncrement) ([]uint64, error) {
+	items := make([]string, len(increments))
+	amounts := make([]uint64, len(increments))
+	for i, inc := range increments {
+		items[i], amounts[i] = inc.Item, inc.Increment
+	}
+	resp, err := s.apply(&command{
+		Op:         "cmsincrby",
+		Key:        key,
+		Values:     items,
+		Increments: amounts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]uint64), nil
+}
+
+// CMSQuery returns the estimated count of each item in the Count-Min Sketch
+// stored at key.
+func (s *Store) CMSQuery(key string, items ...string) ([]uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.cms(key)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, ErrKeyNotFound
+	}
+	counts := make([]uint64, len(items))
+	for i, item := range items {
+		counts[i] = c.count(item)
+	}
+	return counts, nil
+}
+
+// cms returns the Count-Min Sketch stored at key, or nil if the key does not
+// exist. It must be called with the lock held.
+func (s *Store) cms(key string) (*cmsValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	c, ok := item.value.(*cmsValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return c, nil
+}
+
+func (f *fsm) applyCMSInitByDim(key string, width, depth int, now time.Time) interface{} {
+	if width < 1 || depth < 1 || width > cmsMaxCounters/depth {
+		return ErrInvalidFilter
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.lookup(key, now); ok {
+		return ErrKeyExists
+	}
+	f.cache.Add(key, cacheItem{value: &cmsValue{
+		Width:    width,
+		Depth:    depth,
+		Counters: make([]uint64, width*depth),
+	}})
+	return nil
+}
+
+func (f *fsm) applyCMSIncrBy(key string, items []string, increments []uint64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return ErrKeyNotFound
+	}
+	c, ok := item.value.(*cmsValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	// Check every counter for overflow before changing any.
+	added := make(map[int]uint64)
+	for i, it := range items {
+		for row := 0; row < c.Depth; row++ {
+			j := c.index(row, it)
+			if added[j] > math.MaxUint64-increments[i] || c.Counters[j] > math.MaxUint64-added[j]-increments[i] {
+				return ErrOverflow
+			}
+			added[j] += increments[i]
+		}
+	}
+
+	counts := make([]uint64, len(items))
+	for i, it := range items {
+		for row := 0; row < c.Depth; row++ {
+			c.Counters[c.index(row, it)] += increments[i]
+		}
+		counts[i] = c.count(it)
+	}
+	f.cache.Add(key, item)
+	return counts
+}
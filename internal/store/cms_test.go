@@ -0,0 +1,50 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestCMS(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.CMSInitByDim("cms", 2000, 5); err != nil {
+		t.Fatal(err)
+	}
+	counts, err := s.CMSIncrBy("cms", []CMSIncrement{{"a", 3}, {"b", 1}, {"a", 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{3, 1, 5}; fmt.Sprint(counts) != fmt.Sprint(want) {
+		t.Fatalf("CMSIncrBy = %v, want %v", counts, want)
+	}
+	counts, err = s.CMSQuery("cms", "a", "b", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{5, 1, 0}; fmt.Sprint(counts) != fmt.Sprint(want) {
+		t.Fatalf("CMSQuery = %v, want %v", counts, want)
+	}
+
+	// A counter that would overflow leaves every count unchanged.
+	if _, err := s.CMSIncrBy("cms", []CMSIncrement{{"b", 1}, {"a", math.MaxUint64}}); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("CMSIncrBy overflowing = %v, want %v", err, ErrOverflow)
+	}
+	if counts, err := s.CMSQuery("cms", "b"); err != nil || counts[0] != 1 {
+		t.Fatalf("CMSQuery after a failed increment = %v, %v, want [1]", counts, err)
+	}
+
+	if err := s.CMSInitByDim("cms", 10, 1); !errors.Is(err, ErrKeyExists) {
+		t.Fatalf("CMSInitByDim on an existing key = %v, want %v", err, ErrKeyExists)
+	}
+	if _, err := s.CMSIncrBy("missing", []CMSIncrement{{"a", 1}}); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("CMSIncrBy on a missing key = %v, want %v", err, ErrKeyNotFound)
+	}
+	for _, dim := range [][2]int{{0, 1}, {1, 0}, {cmsMaxCounters, 2}} {
+		if err := s.CMSInitByDim("bad", dim[0], dim[1]); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("CMSInitByDim(%d, %d) = %v, want %v", dim[0], dim[1], err, ErrInvalidFilter)
+		}
+	}
+}
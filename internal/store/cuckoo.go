@@ -0,0 +1,279 @@
+package store
+
+import (
+	"slices"
+	"time"
+)
+
+// Cuckoo filter parameters. Filters created by CFAdd start with room for
+// cuckooCapacity items; when an item cannot be placed a layer with twice
+// the buckets is added.
+const (
+	cuckooCapacity   = 1024
+	cuckooBucketSize = 4
+	cuckooMaxKicks   = 500
+	cuckooMaxBuckets = 1 << 25 // 128 MB per layer
+	cuckooSeed       = 0x5bd1e995
+)
+
+// cuckooValue is the value of a Cuckoo filter key. Each item is stored as
+// an 8-bit fingerprint in one of two candidate buckets.
+type cuckooValue struct {
+	Layers []*cuckooLayer `json:"layers"`
+	Count  int64          `json:"count"`
+}
+
+func (c *cuckooValue) clone() *cuckooValue {
+	cc := *c
+	cc.Layers = make([]*cuckooLayer, len(c.Layers))
+	for i, l := range c.Layers {
+		cc.Layers[i] = &cuckooLayer{Buckets: slices.Clone(l.Buckets)}
+	}
+	return &cc
+}
+
+// cuckooLayer holds cuckooBucketSize fingerprint slots per bucket. The
+// number of buckets is a power of two so the alternate bucket of a
+// fingerprint can be found from either bucket. Empty slots are zero.
+type cuckooLayer struct {
+	Buckets []byte `json:"buckets"`
+}
+
+// cuckooHash returns the hash and non-zero fingerprint of an item.
+func cuckooHash(item string) (uint64, byte) {
+	h := murmurHash64A([]byte(item), cuckooSeed)
+	fp := byte(h >> 56)
+	if fp == 0 {
+		fp = 1
+	}
+	return h, fp
+}
+
+func (l *cuckooLayer) numBuckets() uint64 {
+	return uint64(len(l.Buckets) / cuckooBucketSize)
+}
+
+// indexes returns the two candidate buckets of an item.
+func (l *cuckooLayer) indexes(h uint64, fp byte) (uint64, uint64) {
+	i1 := h & (l.numBuckets() - 1)
+	return i1, l.alt(i1, fp)
+}
+
+// alt returns the other candidate bucket of a fingerprint stored in bucket i.
+func (l *cuckooLayer) alt(i uint64, fp byte) uint64 {
+	return (i ^ murmurHash64A([]byte{fp}, cuckooSeed)) & (l.numBuckets() - 1)
+}
+
+func (l *cuckooLayer) bucket(i uint64) []byte {
+	return l.Buckets[i*cuckooBucketSize : (i+1)*cuckooBucketSize]
+}
+
+// place stores fp in a free slot of bucket i and reports whether there was
+// one.
+func (l *cuckooLayer) place(i uint64, fp byte) bool {
+	b := l.bucket(i)
+	for j := range b {
+		if b[j] == 0 {
+			b[j] = fp
+			return true
+		}
+	}
+	return false
+}
+
+// has reports whether bucket i holds fp, and in which slot.
+func (l *cuckooLayer) has(i uint64, fp byte) (int, bool) {
+	for j, v := range l.bucket(i) {
+		if v == fp {
+			return j, true
+		}
+	}
+	return 0, false
+}
+
+// insert adds fp to one of its candidate buckets, relocating other
+// fingerprints to their alternate buckets as needed. Victims are chosen in
+// slot order rather than at random so every replica makes the same moves.
+// If no room is found the layer is left unchanged.
+func (l *cuckooLayer) insert(h uint64, fp byte) bool {
+	i1, i2 := l.indexes(h, fp)
+	if l.place(i1, fp) || l.place(i2, fp) {
+		return true
+	}
+
+	type kick struct {
+		i    uint64
+		slot int
+		fp   byte
+	}
+	var kicks []kick
+	i := i2
+	for n := 0; n < cuckooMaxKicks; n++ {
+		slot := n % cuckooBucketSize
+		b := l.bucket(i)
+		kicks = append(kicks, kick{i, slot, b[slot]})
+		fp, b[slot] = b[slot], fp
+		i = l.alt(i, fp)
+		if l.place(i, fp) {
+			return true
+		}
+	}
+	for n := len(kicks) - 1; n >= 0; n-- {
+		k := kicks[n]
+		l.bucket(k.i)[k.slot] = k.fp
+	}
+	return false
+}
+
+func (f *cuckooValue) has(h uint64, fp byte) bool {
+	for _, l := range f.Layers {
+		i1, i2 := l.indexes(h, fp)
+		if _, ok := l.has(i1, fp); ok {
+			return true
+		}
+		if _, ok := l.has(i2, fp); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// add adds an item, adding a layer if the last one is too full.
+func (f *cuckooValue) add(h uint64, fp byte) error {
+	last := f.Layers[len(f.Layers)-1]
+	if !last.insert(h, fp) {
+		buckets := 2 * last.numBuckets()
+		if buckets > cuckooMaxBuckets {
+			return ErrFilterFull
+		}
+		last = &cuckooLayer{Buckets: make([]byte, buckets*cuckooBucketSize)}
+		f.Layers = append(f.Layers, last)
+		last.insert(h, fp)
+	}
+	f.Count++
+	return nil
+}
+
+// remove removes one copy of an item, starting from the newest layer.
+func (f *cuckooValue) remove(h uint64, fp byte) bool {
+	for n := len(f.Layers) - 1; n >= 0; n-- {
+		l := f.Layers[n]
+		i1, i2 := l.indexes(h, fp)
+		for _, i := range []uint64{i1, i2} {
+			if slot, ok := l.has(i, fp); ok {
+				l.bucket(i)[slot] = 0
+				f.Count--
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newCuckoo returns an empty Cuckoo filter with room for capacity items.
+func newCuckoo(capacity int64) *cuckooValue {
+	buckets := uint64(1)
+	for buckets*cuckooBucketSize < uint64(capacity) {
+		buckets *= 2
+	}
+	return &cuckooValue{Layers: []*cuckooLayer{{Buckets: make([]byte, buckets*cuckooBucketSize)}}}
+}
+
+// CFAdd adds items to the Cuckoo filter stored at key, creating the filter
+// if the key does not exist. An item added twice is stored twice, so it
+// must also be deleted twice.
+func (s *Store) CFAdd(key string, items ...string) error {
+	_, err := s.apply(&command{
+		Op:     "cfadd",
+		Key:    key,
+		Values: items,
+	})
+	return err
+}
+
+// CFDel removes one copy of item from the Cuckoo filter stored at key and
+// reports whether it was found.
+func (s *Store) CFDel(key, item string) (bool, error) {
+	resp, err := s.apply(&command{
+		Op:    "cfdel",
+		Key:   key,
+		Value: item,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.(bool), nil
+}
+
+// CFExists reports for each item whether it may be in the Cuckoo filter
+// stored at key. A missing key holds no items.
+func (s *Store) CFExists(key string, items ...string) ([]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := s.cuckoo(key)
+	if err != nil {
+		return nil, err
+	}
+	exists := make([]bool, len(items))
+	if f == nil {
+		return exists, nil
+	}
+	for i, item := range items {
+		exists[i] = f.has(cuckooHash(item))
+	}
+	return exists, nil
+}
+
+// cuckoo returns the Cuckoo filter stored at key, or nil if the key does
+// not exist. It must be called with the lock held.
+func (s *Store) cuckoo(key string) (*cuckooValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	f, ok := item.value.(*cuckooValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return f, nil
+}
+
+func (f *fsm) applyCFAdd(key string, items []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: newCuckoo(cuckooCapacity)}
+	}
+	cf, ok := item.value.(*cuckooValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	for _, it := range items {
+		if err := cf.add(cuckooHash(it)); err != nil {
+			// Items added before the filter filled up stay added.
+			f.cache.Add(key, item)
+			return err
+		}
+	}
+	f.cache.Add(key, item)
+	return nil
+}
+
+func (f *fsm) applyCFDel(key, member string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return false
+	}
+	cf, ok := item.value.(*cuckooValue)
+	if !ok {
+		return ErrWrongType
+	}
+	return cf.remove(cuckooHash(member))
+}
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCuckoo(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.CFAdd("cf", "a", "b", "a"); err != nil {
+		t.Fatal(err)
+	}
+	exists, err := s.CFExists("cf", "a", "b", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []bool{true, true, false}; fmt.Sprint(exists) != fmt.Sprint(want) {
+		t.Fatalf("CFExists = %v, want %v", exists, want)
+	}
+
+	// a was added twice, so it takes two deletes to remove it.
+	for i, want := range []bool{true, true, false} {
+		if ok, err := s.CFDel("cf", "a"); err != nil || ok != want {
+			t.Fatalf("CFDel #%d = %v, %v, want %v", i+1, ok, err, want)
+		}
+	}
+	if exists, err := s.CFExists("cf", "a", "b"); err != nil || exists[0] || !exists[1] {
+		t.Fatalf("CFExists after deleting a = %v, %v, want [false true]", exists, err)
+	}
+	if ok, err := s.CFDel("missing", "a"); err != nil || ok {
+		t.Fatalf("CFDel on a missing key = %v, %v, want false", ok, err)
+	}
+
+	if err := s.Set("str", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CFAdd("str", "a"); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("CFAdd on a string = %v, want %v", err, ErrWrongType)
+	}
+}
+
+func TestCuckooGrowth(t *testing.T) {
+	s := openStore(t)
+
+	items := make([]string, 3*cuckooCapacity)
+	for i := range items {
+		items[i] = fmt.Sprintf("item-%d", i)
+	}
+	for i := 0; i < len(items); i += 1000 {
+		if err := s.CFAdd("cf", items[i:min(i+1000, len(items))]...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s.mu.Lock()
+	f, _ := s.cuckoo("cf")
+	s.mu.Unlock()
+	if len(f.Layers) < 2 {
+		t.Fatalf("got %d layers after adding past the capacity, want more than 1", len(f.Layers))
+	}
+	if f.Count != int64(len(items)) {
+		t.Fatalf("got count %d, want %d", f.Count, len(items))
+	}
+	exists, err := s.CFExists("cf", items...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, ok := range exists {
+		if !ok {
+			t.Fatalf("added item %s is missing", items[i])
+		}
+	}
+}
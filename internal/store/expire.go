@@ -0,0 +1,122 @@
+package store
+
+import (
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	expireCycleInterval = 100 * time.Millisecond // How often the leader runs an expiry cycle
+	expireCycleBudget   = 25 * time.Millisecond  // Upper bound on the time spent in one cycle
+	expireSampleSize    = 20                     // Keys with a deadline sampled per round
+)
+
+var (
+	expiredKeysTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "mini_redis_expired_keys_total",
+		Help: "Number of expired keys removed by the active expiry cycle.",
+	})
+	expireCyclesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "mini_redis_expire_cycles_total",
+		Help: "Number of active expiry cycles run by the leader.",
+	})
+)
+
+// runExpireCycles periodically removes expired keys while this node is the
+// leader. Deletes are proposed through Raft so every replica drops the same
+// keys; followers rely on the leader and never remove keys on their own.
+// It returns once the store is closed.
+func (s *Store) runExpireCycles() {
+	ticker := time.NewTicker(expireCycleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if s.raft.State() != raft.Leader {
+				continue
+			}
+			s.expireCycle()
+		}
+	}
+}
+
+// expireCycle works like the active expire of Redis: it samples keys that
+// have a deadline and reaps the expired ones, repeating while more than a
+// quarter of the sample was expired and the time budget allows.
+func (s *Store) expireCycle() {
+	expireCyclesTotal.Inc()
+	start := time.Now()
+
+	for time.Since(start) < expireCycleBudget {
+		expired, sampled := s.sampleExpired(expireSampleSize)
+		if len(expired) > 0 {
+			if _, err := s.apply(&command{Op: "reap", Keys: expired}); err != nil {
+				s.logger.Printf("failed to reap expired keys: %v", err)
+				return
+			}
+		}
+		if sampled == 0 || len(expired)*4 <= sampled {
+			return
+		}
+	}
+}
+
+// sampleExpired picks up to n random keys that have a deadline and returns
+// those that have expired, along with the number of keys sampled. It only
+// visits the keys tracked as volatile rather than the whole cache, and
+// forgets those that were overwritten without a deadline.
+func (s *Store) sampleExpired(n int) ([]string, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var expired []string
+	sampled := 0
+	// Map iteration starts at a random key, which is random enough here.
+	for key := range s.volatile {
+		if sampled == n {
+			break
+		}
+		item, ok := s.cache.Peek(key)
+		if !ok || item.expiration.IsZero() {
+			delete(s.volatile, key)
+			continue
+		}
+		sampled++
+		if item.expired(now) {
+			expired = append(expired, key)
+		}
+	}
+	return expired, sampled
+}
+
+// trackDeadline records key as volatile if item has a deadline, so the
+// expiry cycle samples it. It must be called with the lock held.
+func (f *fsm) trackDeadline(key string, item cacheItem) {
+	if !item.expiration.IsZero() {
+		f.volatile[key] = struct{}{}
+	}
+}
+
+// applyReap removes the given keys that have expired at now. Keys that were
+// rewritten or persisted since they were sampled are left alone.
+func (f *fsm) applyReap(keys []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	removed := 0
+	for _, key := range keys {
+		if item, ok := f.cache.Peek(key); ok && item.expired(now) {
+			f.cache.Remove(key)
+			removed++
+		}
+	}
+	expiredKeysTotal.Add(float64(removed))
+	return removed
+}
@@ -0,0 +1,116 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpireCycleReapsKeys(t *testing.T) {
+	s := openStore(t)
+
+	for _, key := range []string{"a", "b", "c"} {
+		if err := s.Set(key, "v"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Expire(key, 50*time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Set("keep", "v"); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mu.Lock()
+		n := s.cache.Len()
+		s.mu.Unlock()
+		if n == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("cache holds %d keys, want 1 after the expiry cycle", n)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if v, err := s.Get("keep"); err != nil || v != "v" {
+		t.Fatalf("Get(keep) = %q, %v, want %q", v, err, "v")
+	}
+}
+
+// TestApplyReap checks that a reap only removes keys that are still expired
+// at the log time, so a key rewritten after it was sampled survives.
+func TestApplyReap(t *testing.T) {
+	f := (*fsm)(New(true))
+	now := time.Unix(1000, 0)
+
+	f.applySet("expired", "v", nil, time.Time{}, now)
+	f.applyExpire("expired", now.Add(time.Second), now)
+	f.applySet("rewritten", "v", nil, time.Time{}, now)
+	f.applyExpire("rewritten", now.Add(time.Second), now)
+	f.applySet("rewritten", "v2", nil, time.Time{}, now)
+	f.applySet("volatile", "v", nil, time.Time{}, now)
+	f.applyExpire("volatile", now.Add(time.Hour), now)
+
+	later := now.Add(2 * time.Second)
+	if got := f.applyReap([]string{"expired", "rewritten", "volatile", "missing"}, later); got != 1 {
+		t.Fatalf("applyReap removed %v keys, want 1", got)
+	}
+	for key, want := range map[string]bool{"expired": false, "rewritten": true, "volatile": true} {
+		if got := f.cache.Contains(key); got != want {
+			t.Errorf("Contains(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+// TestSampleExpiredVolatile checks that only keys with a deadline are
+// sampled, and that keys which lost their deadline or left the cache are
+// forgotten.
+func TestSampleExpiredVolatile(t *testing.T) {
+	s := New(true)
+	f := (*fsm)(s)
+	now := time.Now()
+
+	f.applySet("expired", "v", nil, now.Add(-time.Second), now.Add(-2*time.Second))
+	f.applySet("volatile", "v", nil, now.Add(time.Hour), now)
+	f.applySet("persisted", "v", nil, now.Add(time.Hour), now)
+	f.applyPersist("persisted", now)
+	f.applySet("deleted", "v", nil, now.Add(time.Hour), now)
+	f.applyDelete([]string{"deleted"}, now)
+	f.applySet("plain", "v", nil, time.Time{}, now)
+
+	s.mu.Lock()
+	if _, ok := s.volatile["deleted"]; ok {
+		t.Errorf("deleted key is still tracked")
+	}
+	s.mu.Unlock()
+
+	expired, sampled := s.sampleExpired(expireSampleSize)
+	if len(expired) != 1 || expired[0] != "expired" {
+		t.Errorf("expired = %v, want [expired]", expired)
+	}
+	if sampled != 2 {
+		t.Errorf("sampled = %d, want 2", sampled)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.volatile) != 2 {
+		t.Errorf("tracked %d keys, want 2", len(s.volatile))
+	}
+}
+
+func TestCloseStopsExpireCycles(t *testing.T) {
+	s := New(true)
+	done := make(chan struct{})
+	go func() {
+		s.runExpireCycles()
+		close(done)
+	}()
+
+	close(s.stop)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("runExpireCycles did not return after the store was closed")
+	}
+}
@@ -0,0 +1,441 @@
+package store
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+var (
+	// ErrInvalidCoordinates is returned for a longitude or latitude that
+	// cannot be geohash encoded.
+	ErrInvalidCoordinates = errors.New("invalid longitude,latitude pair")
+
+	// ErrInvalidUnit is returned for an unknown distance unit.
+	ErrInvalidUnit = errors.New("unsupported unit provided, use m, km, ft or mi")
+
+	// ErrInvalidArea is returned for a negative or non-finite search radius,
+	// or a box that is not positive in both dimensions.
+	ErrInvalidArea = errors.New("invalid search radius or box size")
+)
+
+// Geo members are stored in sorted sets, scored by a 52-bit geohash that
+// interleaves 26 bits of latitude and longitude, as in Redis. Latitudes are
+// limited to what the Web Mercator projection covers.
+const (
+	geoStepMax     = 26
+	geoLatMin      = -85.05112878
+	geoLatMax      = 85.05112878
+	geoLonMin      = -180.0
+	geoLonMax      = 180.0
+	geoEarthRadius = 6372797.560856 // Meters
+	geoMercatorMax = 20037726.37    // Meters
+)
+
+// geoUnits converts distance units to meters.
+var geoUnits = map[string]float64{
+	"m":  1,
+	"km": 1000,
+	"ft": 0.3048,
+	"mi": 1609.34,
+}
+
+// GeoLocation is a member of a geo index and its coordinates.
+type GeoLocation struct {
+	Member    string
+	Longitude float64
+	Latitude  float64
+}
+
+// GeoResult is a member found by GeoSearch.
+type GeoResult struct {
+	GeoLocation
+	Distance float64 // From the center of the search, in the unit of the search
+	Hash     uint64  // Geohash score of the member
+}
+
+// GeoSort orders the results of GeoSearch.
+type GeoSort int
+
+const (
+	GeoSortNone GeoSort = iota
+	GeoSortAsc
+	GeoSortDesc
+)
+
+// GeoQuery describes a GeoSearch. The center is the position of Member if
+// it is set, or Longitude and Latitude otherwise. The search area is a
+// circle if Radius is positive, or a Width by Height box otherwise.
+type GeoQuery struct {
+	Member    string
+	Longitude float64
+	Latitude  float64
+
+	Radius float64
+	Width  float64
+	Height float64
+	Unit   string // m, km, ft or mi; defaults to m
+
+	Sort  GeoSort
+	Count int  // Maximum number of results, zero for no limit
+	Any   bool // Return as soon as Count results are found, unsorted
+}
+
+// geohash is a cell of the geohash grid at some step.
+type geohash struct {
+	bits uint64
+	step uint
+}
+
+func geoValid(lon, lat float64) bool {
+	return lon >= geoLonMin && lon <= geoLonMax && lat >= geoLatMin && lat <= geoLatMax
+}
+
+// geoEncode returns the cell containing the coordinates.
+func geoEncode(lon, lat float64, step uint) geohash {
+	latOffset := (lat - geoLatMin) / (geoLatMax - geoLatMin)
+	lonOffset := (lon - geoLonMin) / (geoLonMax - geoLonMin)
+	scale := float64(uint64(1) << step)
+	latBits := min(uint64(latOffset*scale), uint64(scale)-1)
+	lonBits := min(uint64(lonOffset*scale), uint64(scale)-1)
+	return geohash{bits: interleave(latBits, lonBits), step: step}
+}
+
+// geoDecode returns the bounds of a cell.
+func geoDecode(h geohash) (minLon, minLat, maxLon, maxLat float64) {
+	latBits, lonBits := deinterleave(h.bits)
+	scale := float64(uint64(1) << h.step)
+	minLat = geoLatMin + float64(latBits)/scale*(geoLatMax-geoLatMin)
+	maxLat = geoLatMin + float64(latBits+1)/scale*(geoLatMax-geoLatMin)
+	minLon = geoLonMin + float64(lonBits)/scale*(geoLonMax-geoLonMin)
+	maxLon = geoLonMin + float64(lonBits+1)/scale*(geoLonMax-geoLonMin)
+	return minLon, minLat, maxLon, maxLat
+}
+
+// geoPosition returns the coordinates of a member from its score: the
+// center of its cell.
+func geoPosition(score float64) (lon, lat float64) {
+	minLon, minLat, maxLon, maxLat := geoDecode(geohash{bits: uint64(score), step: geoStepMax})
+	lon = min(max((minLon+maxLon)/2, geoLonMin), geoLonMax)
+	lat = min(max((minLat+maxLat)/2, geoLatMin), geoLatMax)
+	return lon, lat
+}
+
+// interleave places the bits of x at the even positions and the bits of y
+// at the odd positions of the result.
+func interleave(x, y uint64) uint64 {
+	return spread(x) | spread(y)<<1
+}
+
+func deinterleave(v uint64) (x, y uint64) {
+	return squash(v), squash(v >> 1)
+}
+
+func spread(v uint64) uint64 {
+	v &= 0xffffffff
+	v = (v | v<<16) & 0x0000ffff0000ffff
+	v = (v | v<<8) & 0x00ff00ff00ff00ff
+	v = (v | v<<4) & 0x0f0f0f0f0f0f0f0f
+	v = (v | v<<2) & 0x3333333333333333
+	v = (v | v<<1) & 0x5555555555555555
+	return v
+}
+
+func squash(v uint64) uint64 {
+	v &= 0x5555555555555555
+	v = (v | v>>1) & 0x3333333333333333
+	v = (v | v>>2) & 0x0f0f0f0f0f0f0f0f
+	v = (v | v>>4) & 0x00ff00ff00ff00ff
+	v = (v | v>>8) & 0x0000ffff0000ffff
+	v = (v | v>>16) & 0x00000000ffffffff
+	return v
+}
+
+func degToRad(d float64) float64 { return d * math.Pi / 180 }
+func radToDeg(r float64) float64 { return r * 180 / math.Pi }
+
+// geoDistance returns the haversine distance in meters between two points.
+func geoDistance(lon1, lat1, lon2, lat2 float64) float64 {
+	lat1r, lat2r := degToRad(lat1), degToRad(lat2)
+	u := math.Sin((lat2r - lat1r) / 2)
+	v := math.Sin(degToRad(lon2-lon1) / 2)
+	a := u*u + math.Cos(lat1r)*math.Cos(lat2r)*v*v
+	return 2 * geoEarthRadius * math.Asin(math.Sqrt(a))
+}
+
+// geoSteps returns the coarsest step whose cells, with their neighbors,
+// cover a search of the given radius.
+func geoSteps(radius, lat float64) uint {
+	if radius == 0 {
+		return geoStepMax
+	}
+	step := 1
+	for radius < geoMercatorMax {
+		radius *= 2
+		step++
+	}
+	step -= 2 // Make sure the range is included in most of the base cases
+
+	// Cells are narrower near the poles.
+	if lat > 66 || lat < -66 {
+		step--
+		if lat > 80 || lat < -80 {
+			step--
+		}
+	}
+	return uint(min(max(step, 1), geoStepMax))
+}
+
+// geoArea is the area of a search, in meters around a center point.
+type geoArea struct {
+	lon, lat      float64
+	radius        float64 // Zero for a box
+	width, height float64
+}
+
+// valid reports whether the area is a circle with a finite radius, or a box
+// with positive finite sides. A zero radius searches the center point only.
+func (a geoArea) valid() bool {
+	for _, v := range []float64{a.radius, a.width, a.height} {
+		if math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+			return false
+		}
+	}
+	if a.width == 0 && a.height == 0 {
+		return true
+	}
+	return a.radius == 0 && a.width > 0 && a.height > 0
+}
+
+// bounds returns the bounding box of the area. Near the poles the box
+// spans every longitude.
+func (a geoArea) bounds() (minLon, minLat, maxLon, maxLat float64) {
+	var latDelta, lonDelta float64
+	if a.radius > 0 {
+		latDelta = radToDeg(a.radius / geoEarthRadius)
+		lonDelta = radToDeg(math.Asin(math.Sin(a.radius/geoEarthRadius) / math.Cos(degToRad(a.lat))))
+	} else {
+		// Points are in the box if they are within half its width of the
+		// center's meridian at their own latitude, which reaches the most
+		// longitudes at the edge farthest from the equator.
+		latDelta = radToDeg(a.height / 2 / geoEarthRadius)
+		edge := math.Abs(a.lat) + latDelta
+		lonDelta = radToDeg(2 * math.Asin(math.Sin(a.width/4/geoEarthRadius)/math.Cos(degToRad(edge))))
+	}
+	if math.Abs(a.lat)+latDelta >= 90 || math.IsNaN(lonDelta) || lonDelta > 180 {
+		lonDelta = 180
+	}
+	return a.lon - lonDelta, a.lat - latDelta, a.lon + lonDelta, a.lat + latDelta
+}
+
+// contains returns the distance in meters of a point from the center, or
+// false if it is outside the area.
+func (a geoArea) contains(lon, lat float64) (float64, bool) {
+	if a.radius > 0 {
+		d := geoDistance(a.lon, a.lat, lon, lat)
+		return d, d <= a.radius
+	}
+	if geoEarthRadius*math.Abs(degToRad(lat)-degToRad(a.lat)) > a.height/2 {
+		return 0, false
+	}
+	if geoDistance(a.lon, lat, lon, lat) > a.width/2 {
+		return 0, false
+	}
+	return geoDistance(a.lon, a.lat, lon, lat), true
+}
+
+// cells returns the geohash cells to scan for the area: the cell of the
+// center and those of its neighbors that overlap the area.
+func (a geoArea) cells() []geohash {
+	minLon, minLat, maxLon, maxLat := a.bounds()
+	radius := a.radius
+	if radius == 0 {
+		radius = math.Sqrt(a.width*a.width/4 + a.height*a.height/4)
+	}
+	step := geoSteps(radius, a.lat)
+
+	center := geoEncode(a.lon, a.lat, step)
+	cMinLon, cMinLat, cMaxLon, cMaxLat := geoDecode(center)
+	width, height := cMaxLon-cMinLon, cMaxLat-cMinLat
+
+	// While the neighbors do not reach the edges of the area, which happens
+	// near the poles, use larger cells.
+	for step > 1 && (cMaxLat+height < maxLat || cMinLat-height > minLat ||
+		cMaxLon+width < maxLon || cMinLon-width > minLon) {
+		step--
+		center = geoEncode(a.lon, a.lat, step)
+		cMinLon, cMinLat, cMaxLon, cMaxLat = geoDecode(center)
+		width, height = cMaxLon-cMinLon, cMaxLat-cMinLat
+	}
+
+	cells := []geohash{center}
+	seen := map[uint64]bool{center.bits: true}
+	for _, dlat := range []int{-1, 0, 1} {
+		for _, dlon := range []int{-1, 0, 1} {
+			// Skip neighbors the area does not reach.
+			switch {
+			case dlat == 0 && dlon == 0,
+				dlat < 0 && cMinLat < minLat, dlat > 0 && cMaxLat > maxLat,
+				dlon < 0 && cMinLon < minLon, dlon > 0 && cMaxLon > maxLon:
+				continue
+			}
+			lat := (cMinLat+cMaxLat)/2 + float64(dlat)*height
+			lon := (cMinLon+cMaxLon)/2 + float64(dlon)*width
+			if lat < geoLatMin || lat > geoLatMax {
+				continue
+			}
+			if lon < geoLonMin {
+				lon += 360
+			} else if lon > geoLonMax {
+				lon -= 360
+			}
+			cell := geoEncode(lon, lat, step)
+			if !seen[cell.bits] {
+				seen[cell.bits] = true
+				cells = append(cells, cell)
+			}
+		}
+	}
+	return cells
+}
+
+// GeoAdd adds members with their coordinates to the geo index stored at
+// key, which is a sorted set, and returns the number of members added, or
+// with CH added or moved. Only the NX, XX and CH options apply.
+func (s *Store) GeoAdd(key string, locations []GeoLocation, opts ZAddOptions) (int, error) {
+	members := make([]ZMember, len(locations))
+	for i, l := range locations {
+		if !geoValid(l.Longitude, l.Latitude) {
+			return 0, ErrInvalidCoordinates
+		}
+		h := geoEncode(l.Longitude, l.Latitude, geoStepMax)
+		members[i] = ZMember{Member: l.Member, Score: float64(h.bits)}
+	}
+	opts.GT, opts.LT = false, false
+	return s.ZAdd(key, members, opts)
+}
+
+// GeoDist returns the distance between two members of the geo index stored
+// at key, in the given unit.
+func (s *Store) GeoDist(key, member1, member2, unit string) (float64, error) {
+	toMeters, err := geoUnit(unit)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z, err := s.zset(key)
+	if err != nil {
+		return 0, err
+	}
+	if z == nil {
+		return 0, ErrKeyNotFound
+	}
+	score1, ok1 := z.scores[member1]
+	score2, ok2 := z.scores[member2]
+	if !ok1 || !ok2 {
+		return 0, ErrMemberNotFound
+	}
+	lon1, lat1 := geoPosition(score1)
+	lon2, lat2 := geoPosition(score2)
+	return geoDistance(lon1, lat1, lon2, lat2) / toMeters, nil
+}
+
+// GeoSearch returns the members of the geo index stored at key within the
+// area described by q.
+func (s *Store) GeoSearch(key string, q GeoQuery) ([]GeoResult, error) {
+	toMeters, err := geoUnit(q.Unit)
+	if err != nil {
+		return nil, err
+	}
+	if q.Member == "" && !geoValid(q.Longitude, q.Latitude) {
+		return nil, ErrInvalidCoordinates
+	}
+	area := geoArea{
+		lon:    q.Longitude,
+		lat:    q.Latitude,
+		radius: q.Radius * toMeters,
+		width:  q.Width * toMeters,
+		height: q.Height * toMeters,
+	}
+	if !area.valid() {
+		return nil, ErrInvalidArea
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z, err := s.zset(key)
+	if err != nil {
+		return nil, err
+	}
+	if z == nil {
+		if q.Member != "" {
+			return nil, ErrMemberNotFound
+		}
+		return nil, nil
+	}
+
+	if q.Member != "" {
+		score, ok := z.scores[q.Member]
+		if !ok {
+			return nil, ErrMemberNotFound
+		}
+		area.lon, area.lat = geoPosition(score)
+	}
+
+	var results []GeoResult
+	for _, cell := range area.cells() {
+		shift := 2 * (geoStepMax - cell.step)
+		r := ScoreRange{
+			Min:          float64(cell.bits << shift),
+			Max:          float64((cell.bits + 1) << shift),
+			MaxExclusive: true,
+		}
+		for _, m := range z.collect(r, false, 0, -1) {
+			lon, lat := geoPosition(m.Score)
+			d, ok := area.contains(lon, lat)
+			if !ok {
+				continue
+			}
+			results = append(results, GeoResult{
+				GeoLocation: GeoLocation{Member: m.Member, Longitude: lon, Latitude: lat},
+				Distance:    d / toMeters,
+				Hash:        uint64(m.Score),
+			})
+			if q.Any && len(results) == q.Count {
+				return results, nil
+			}
+		}
+	}
+
+	// Limiting the results only makes sense for the nearest ones.
+	sortBy := q.Sort
+	if sortBy == GeoSortNone && q.Count > 0 && !q.Any {
+		sortBy = GeoSortAsc
+	}
+	switch sortBy {
+	case GeoSortAsc:
+		sort.SliceStable(results, func(i, j int) bool { return results[i].Distance < results[j].Distance })
+	case GeoSortDesc:
+		sort.SliceStable(results, func(i, j int) bool { return results[i].Distance > results[j].Distance })
+	}
+	if q.Count > 0 && len(results) > q.Count {
+		results = results[:q.Count]
+	}
+	return results, nil
+}
+
+// geoUnit returns the number of meters in a unit.
+func geoUnit(unit string) (float64, error) {
+	if unit == "" {
+		return 1, nil
+	}
+	toMeters, ok := geoUnits[unit]
+	if !ok {
+		return 0, ErrInvalidUnit
+	}
+	return toMeters, nil
+}
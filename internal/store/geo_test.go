@@ -0,0 +1,193 @@
+package store
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestGeoEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		lon, lat float64
+	}{
+		{"origin", 0, 0},
+		{"palermo", 13.361389, 38.115556},
+		{"south west corner", geoLonMin, geoLatMin},
+		{"north east corner", geoLonMax, geoLatMax},
+		{"antimeridian", -179.999, 12.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := geoEncode(tt.lon, tt.lat, geoStepMax)
+			lon, lat := geoPosition(float64(h.bits))
+			if d := geoDistance(tt.lon, tt.lat, lon, lat); d > 1 {
+				t.Fatalf("decoded %f,%f is %fm from %f,%f", lon, lat, d, tt.lon, tt.lat)
+			}
+			minLon, minLat, maxLon, maxLat := geoDecode(h)
+			if tt.lon < minLon || tt.lon > maxLon || tt.lat < minLat || tt.lat > maxLat {
+				t.Fatalf("cell %f,%f - %f,%f does not contain the point", minLon, minLat, maxLon, maxLat)
+			}
+		})
+	}
+}
+
+// TestGeoCells checks that every point within an area is in one of the
+// cells scanned for it.
+func TestGeoCells(t *testing.T) {
+	tests := []struct {
+		name string
+		area geoArea
+	}{
+		{"small radius", geoArea{lon: 13.36, lat: 38.11, radius: 500}},
+		{"large radius", geoArea{lon: 13.36, lat: 38.11, radius: 500000}},
+		{"huge radius", geoArea{lon: -70, lat: -30, radius: 5000000}},
+		{"radius on a cell edge", geoArea{lon: 0, lat: 0, radius: 10000}},
+		{"radius across the antimeridian", geoArea{lon: 179.95, lat: 10, radius: 20000}},
+		{"radius near the north pole", geoArea{lon: 20, lat: 84, radius: 100000}},
+		{"radius near the south pole", geoArea{lon: -120, lat: -84.5, radius: 30000}},
+		{"small box", geoArea{lon: 2.35, lat: 48.85, width: 1000, height: 400}},
+		{"wide box", geoArea{lon: 2.35, lat: 48.85, width: 800000, height: 20000}},
+		{"tall box", geoArea{lon: 2.35, lat: 48.85, width: 20000, height: 800000}},
+		{"box near the pole", geoArea{lon: 100, lat: 80, width: 300000, height: 100000}},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cells := tt.area.cells()
+			covered := func(h uint64) bool {
+				for _, c := range cells {
+					if h>>(2*(geoStepMax-c.step)) == c.bits {
+						return true
+					}
+				}
+				return false
+			}
+
+			minLon, minLat, maxLon, maxLat := tt.area.bounds()
+			found := 0
+			for i := 0; i < 20000; i++ {
+				lon := minLon + r.Float64()*(maxLon-minLon)
+				lat := minLat + r.Float64()*(maxLat-minLat)
+				if lon < geoLonMin {
+					lon += 360
+				} else if lon > geoLonMax {
+					lon -= 360
+				}
+				if !geoValid(lon, lat) {
+					continue
+				}
+				h := geoEncode(lon, lat, geoStepMax)
+				if _, ok := tt.area.contains(geoPosition(float64(h.bits))); !ok {
+					continue
+				}
+				found++
+				if !covered(h.bits) {
+					t.Fatalf("%f,%f is in the area but not in cells %v", lon, lat, cells)
+				}
+			}
+			if found == 0 {
+				t.Fatal("no sampled point was in the area")
+			}
+		})
+	}
+}
+
+func TestGeoSearch(t *testing.T) {
+	s := openStore(t)
+	_, err := s.GeoAdd("sicily", []GeoLocation{
+		{Member: "Palermo", Longitude: 13.361389, Latitude: 38.115556},
+		{Member: "Catania", Longitude: 15.087269, Latitude: 37.502669},
+		{Member: "Agrigento", Longitude: 13.583333, Latitude: 37.316667},
+	}, ZAddOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		q       GeoQuery
+		want    string
+		wantErr error
+	}{
+		{"radius from point", GeoQuery{Longitude: 15, Latitude: 37, Radius: 200, Unit: "km", Sort: GeoSortAsc}, "Catania Agrigento Palermo", nil},
+		{"smaller radius", GeoQuery{Longitude: 15, Latitude: 37, Radius: 100, Unit: "km", Sort: GeoSortAsc}, "Catania", nil},
+		{"descending", GeoQuery{Longitude: 15, Latitude: 37, Radius: 200, Unit: "km", Sort: GeoSortDesc}, "Palermo Agrigento Catania", nil},
+		{"count sorts nearest first", GeoQuery{Longitude: 15, Latitude: 37, Radius: 200, Unit: "km", Count: 2}, "Catania Agrigento", nil},
+		{"from member", GeoQuery{Member: "Palermo", Radius: 100, Unit: "km", Sort: GeoSortAsc}, "Palermo Agrigento", nil},
+		{"box", GeoQuery{Longitude: 15, Latitude: 37, Width: 400, Height: 400, Unit: "km", Sort: GeoSortAsc}, "Catania Agrigento Palermo", nil},
+		{"narrow box", GeoQuery{Longitude: 14, Latitude: 37.5, Width: 400, Height: 30, Unit: "km", Sort: GeoSortAsc}, "Catania", nil},
+		{"nothing in range", GeoQuery{Longitude: 0, Latitude: 0, Radius: 100, Unit: "km"}, "", nil},
+		{"missing member", GeoQuery{Member: "Rome", Radius: 100}, "", ErrMemberNotFound},
+		{"invalid center", GeoQuery{Longitude: 0, Latitude: 89, Radius: 100}, "", ErrInvalidCoordinates},
+		{"unknown unit", GeoQuery{Longitude: 15, Latitude: 37, Radius: 100, Unit: "ly"}, "", ErrInvalidUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := s.GeoSearch("sicily", tt.q)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			var got []string
+			for _, r := range results {
+				got = append(got, r.Member)
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	results, err := s.GeoSearch("sicily", GeoQuery{Longitude: 15, Latitude: 37, Radius: 200, Unit: "km", Sort: GeoSortAsc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Distances as reported by Redis for the same search.
+	want := map[string]float64{"Catania": 56.4413, "Palermo": 190.4424}
+	for _, r := range results {
+		if d, ok := want[r.Member]; ok && math.Abs(r.Distance-d) > 0.001 {
+			t.Errorf("distance to %s: got %.4f, want %.4f", r.Member, r.Distance, d)
+		}
+	}
+}
+
+func TestGeoSearchArea(t *testing.T) {
+	s := openStore(t)
+	if _, err := s.GeoAdd("geo", []GeoLocation{{Member: "a", Longitude: 13.361389, Latitude: 38.115556}}, ZAddOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		q       GeoQuery
+		wantErr error
+	}{
+		{"radius", GeoQuery{Member: "a", Radius: 10}, nil},
+		{"zero radius", GeoQuery{Member: "a"}, nil},
+		{"box", GeoQuery{Member: "a", Width: 10, Height: 10}, nil},
+		{"negative radius", GeoQuery{Member: "a", Radius: -1}, ErrInvalidArea},
+		{"infinite radius", GeoQuery{Member: "a", Radius: math.Inf(1)}, ErrInvalidArea},
+		{"NaN radius", GeoQuery{Member: "a", Radius: math.NaN()}, ErrInvalidArea},
+		{"overflowing radius", GeoQuery{Member: "a", Radius: math.MaxFloat64, Unit: "km"}, ErrInvalidArea},
+		{"zero width", GeoQuery{Member: "a", Height: 10}, ErrInvalidArea},
+		{"negative height", GeoQuery{Member: "a", Width: 10, Height: -10}, ErrInvalidArea},
+		{"radius and box", GeoQuery{Member: "a", Radius: 10, Width: 10, Height: 10}, ErrInvalidArea},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := s.GeoSearch("geo", tt.q)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (len(results) != 1 || results[0].Member != "a") {
+				t.Fatalf("got %v, want member a", results)
+			}
+		})
+	}
+}
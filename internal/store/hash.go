@@ -0,0 +1,233 @@
+package store
+
+import (
+	"errors"
+	"maps"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ErrFieldNotFound is returned when a hash field does not exist.
+var ErrFieldNotFound = errors.New("field not found")
+
+// hashValue is the value of a hash key, mapping fields to values.
+type hashValue map[string]string
+
+func (h hashValue) clone() hashValue {
+	return maps.Clone(h)
+}
+
+// HSet sets the given fields of the hash stored at key, creating the hash if
+// it does not exist. It returns the number of fields that were added.
+func (s *Store) HSet(key string, fields map[string]string) (int, error) {
+	resp, err := s.apply(&command{
+		Op:          "hset",
+		Key:         key,
+		FieldValues: fields,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// HGet returns the value of field in the hash stored at key.
+func (s *Store) HGet(key, field string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, err := s.hash(key)
+	if err != nil {
+		return "", err
+	}
+	if h == nil {
+		return "", ErrKeyNotFound
+	}
+	v, ok := h[field]
+	if !ok {
+		return "", ErrFieldNotFound
+	}
+	return v, nil
+}
+
+// HDel removes the given fields from the hash stored at key and returns the
+// number of fields that were removed. The key is deleted once the hash is empty.
+func (s *Store) HDel(key string, fields ...string) (int, error) {
+	resp, err := s.apply(&command{
+		Op:     "hdel",
+		Key:    key,
+		Fields: fields,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// HGetAll returns all fields and values of the hash stored at key. A missing
+// key yields an empty map.
+func (s *Store) HGetAll(key string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, err := s.hash(key)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string, len(h))
+	for k, v := range h {
+		fields[k] = v
+	}
+	return fields, nil
+}
+
+// HIncrBy atomically increments the integer value of field in the hash stored
+// at key by delta and returns the new value. A missing field is treated as 0.
+func (s *Store) HIncrBy(key, field string, delta int64) (int64, error) {
+	resp, err := s.apply(&command{
+		Op:    "hincrby",
+		Key:   key,
+		Field: field,
+		Delta: delta,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int64), nil
+}
+
+// HScan iterates over the fields of the hash stored at key in field order.
+// It examines up to count fields that sort after cursor and returns those
+// matching the glob-style pattern match, along with the cursor to pass to
+// the next call. An empty cursor starts the iteration, and an empty returned
+// cursor ends it. Fields present for the whole iteration are returned once.
+func (s *Store) HScan(key, cursor, match string, count int) (map[string]string, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, err := s.hash(key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	names := make([]string, 0, len(h))
+	for k := range h {
+		if cursor == "" || k > cursor {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+
+	next := ""
+	if count > 0 && len(names) > count {
+		names = names[:count]
+		next = names[count-1]
+	}
+	fields := make(map[string]string)
+	for _, k := range names {
+		if match == "" || globMatch(match, k) {
+			fields[k] = h[k]
+		}
+	}
+	return fields, next, nil
+}
+
+// hash returns the hash stored at key, or nil if the key does not exist. It
+// must be called with the lock held.
+func (s *Store) hash(key string) (hashValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	h, ok := item.value.(hashValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return h, nil
+}
+
+// hashItem returns the item holding the hash stored at key, creating an
+// empty hash if the key does not exist. It must be called with the lock held.
+func (f *fsm) hashItem(key string, now time.Time) (cacheItem, hashValue, error) {
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: hashValue{}}
+	}
+	h, ok := item.value.(hashValue)
+	if !ok {
+		return cacheItem{}, nil, ErrWrongType
+	}
+	return item, h, nil
+}
+
+func (f *fsm) applyHSet(key string, fields map[string]string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, h, err := f.hashItem(key, now)
+	if err != nil {
+		return err
+	}
+	added := 0
+	for k, v := range fields {
+		if _, ok := h[k]; !ok {
+			added++
+		}
+		h[k] = v
+	}
+	if len(h) > 0 {
+		f.cache.Add(key, item)
+	}
+	return added
+}
+
+func (f *fsm) applyHDel(key string, fields []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return 0
+	}
+	h, ok := item.value.(hashValue)
+	if !ok {
+		return ErrWrongType
+	}
+	removed := 0
+	for _, k := range fields {
+		if _, ok := h[k]; ok {
+			delete(h, k)
+			removed++
+		}
+	}
+	if len(h) == 0 {
+		f.cache.Remove(key)
+	}
+	return removed
+}
+
+func (f *fsm) applyHIncrBy(key, field string, delta int64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, h, err := f.hashItem(key, now)
+	if err != nil {
+		return err
+	}
+	var n int64
+	if v, ok := h[field]; ok {
+		if n, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return ErrNotInteger
+		}
+	}
+	if (delta > 0 && n > math.MaxInt64-delta) || (delta < 0 && n < math.MinInt64-delta) {
+		return ErrOverflow
+	}
+	n += delta
+
+	h[field] = strconv.FormatInt(n, 10)
+	f.cache.Add(key, item)
+	return n
+}
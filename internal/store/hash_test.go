@@ -0,0 +1,141 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	s := openStore(t)
+
+	if n, err := s.HSet("h", map[string]string{"a": "1", "b": "2"}); err != nil || n != 2 {
+		t.Fatalf("HSet = %d, %v, want 2", n, err)
+	}
+	if n, err := s.HSet("h", map[string]string{"b": "3", "c": "4"}); err != nil || n != 1 {
+		t.Fatalf("HSet = %d, %v, want 1", n, err)
+	}
+	if v, err := s.HGet("h", "b"); err != nil || v != "3" {
+		t.Fatalf("HGet = %q, %v, want 3", v, err)
+	}
+	if _, err := s.HGet("h", "missing"); !errors.Is(err, ErrFieldNotFound) {
+		t.Fatalf("HGet(missing field) = %v, want %v", err, ErrFieldNotFound)
+	}
+	if _, err := s.HGet("missing", "a"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("HGet(missing key) = %v, want %v", err, ErrKeyNotFound)
+	}
+
+	if n, err := s.HIncrBy("h", "a", 9); err != nil || n != 10 {
+		t.Fatalf("HIncrBy = %d, %v, want 10", n, err)
+	}
+	if n, err := s.HIncrBy("h", "new", -2); err != nil || n != -2 {
+		t.Fatalf("HIncrBy(new field) = %d, %v, want -2", n, err)
+	}
+	if _, err := s.HSet("h", map[string]string{"text": "x"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.HIncrBy("h", "text", 1); !errors.Is(err, ErrNotInteger) {
+		t.Fatalf("HIncrBy(text) = %v, want %v", err, ErrNotInteger)
+	}
+
+	want := map[string]string{"a": "10", "b": "3", "c": "4", "new": "-2", "text": "x"}
+	if got, err := s.HGetAll("h"); err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("HGetAll = %v, %v, want %v", got, err, want)
+	}
+	if got, err := s.HGetAll("missing"); err != nil || len(got) != 0 {
+		t.Fatalf("HGetAll(missing) = %v, %v, want an empty map", got, err)
+	}
+
+	if n, err := s.HDel("h", "a", "b", "missing"); err != nil || n != 2 {
+		t.Fatalf("HDel = %d, %v, want 2", n, err)
+	}
+	if n, err := s.HDel("h", "c", "new", "text"); err != nil || n != 3 {
+		t.Fatalf("HDel = %d, %v, want 3", n, err)
+	}
+	if _, err := s.HGetAll("h"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get("h"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("the emptied hash was not deleted: %v", err)
+	}
+}
+
+func TestHashWrongType(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.Set("str", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.HSet("hash", map[string]string{"f": "v"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"hset on string", func() error { _, err := s.HSet("str", map[string]string{"f": "v"}); return err }},
+		{"hget on string", func() error { _, err := s.HGet("str", "f"); return err }},
+		{"hdel on string", func() error { _, err := s.HDel("str", "f"); return err }},
+		{"hgetall on string", func() error { _, err := s.HGetAll("str"); return err }},
+		{"hincrby on string", func() error { _, err := s.HIncrBy("str", "f", 1); return err }},
+		{"hscan on string", func() error { _, _, err := s.HScan("str", "", "", 10); return err }},
+		{"get on hash", func() error { _, err := s.Get("hash"); return err }},
+		{"incr on hash", func() error { _, err := s.Incr("hash"); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, ErrWrongType) {
+				t.Fatalf("got error %v, want %v", err, ErrWrongType)
+			}
+		})
+	}
+}
+
+func TestHScan(t *testing.T) {
+	s := openStore(t)
+
+	fields := make(map[string]string)
+	for i := 0; i < 25; i++ {
+		fields[fmt.Sprintf("f%02d", i)] = fmt.Sprint(i)
+	}
+	if _, err := s.HSet("h", fields); err != nil {
+		t.Fatal(err)
+	}
+
+	// Walk the hash in pages of 10 and collect every field once.
+	var seen []string
+	cursor, calls := "", 0
+	for {
+		page, next, err := s.HScan("h", cursor, "", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for k := range page {
+			seen = append(seen, k)
+		}
+		calls++
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if calls != 3 || len(seen) != len(fields) {
+		t.Fatalf("got %d fields in %d calls, want %d in 3", len(seen), calls, len(fields))
+	}
+
+	page, next, err := s.HScan("h", "", "f1?", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for k := range page {
+		got = append(got, k)
+	}
+	sort.Strings(got)
+	if next != "" || len(got) != 10 || got[0] != "f10" || got[9] != "f19" {
+		t.Fatalf("HScan MATCH f1? = %v, cursor %q, want f10..f19", got, next)
+	}
+}
@@ -0,0 +1,426 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/bits"
+	"slices"
+	"time"
+)
+
+// ErrInvalidHLL is returned when a HyperLogLog cannot be decoded.
+var ErrInvalidHLL = errors.New("invalid HyperLogLog encoding")
+
+// HyperLogLog parameters and encodings, as in Redis. Registers are stored
+// either densely, 6 bits each, or sparsely as runs of equal registers. A
+// sparse HyperLogLog is converted to dense once it grows past
+// hllSparseMaxBytes or a register exceeds what the sparse encoding holds.
+const (
+	hllP              = 14
+	hllQ              = 64 - hllP
+	hllRegisters      = 1 << hllP
+	hllBits           = 6
+	hllRegisterMax    = 1<<hllBits - 1
+	hllDenseSize      = (hllRegisters*hllBits + 7) / 8 // 12 KB
+	hllSparseMaxBytes = 3000
+	hllSparseValMax   = 32
+	hllSeed           = 0xadc83b19
+
+	hllHeaderSize = 16
+	hllDense      = 0
+	hllSparse     = 1
+)
+
+// hllValue is the value of a HyperLogLog key.
+type hllValue struct {
+	encoding  byte
+	registers []byte // Packed dense registers, or the sparse opcodes
+}
+
+func newHLL() *hllValue {
+	h := &hllValue{encoding: hllSparse}
+	h.registers, _ = hllEncodeSparse(make([]byte, hllRegisters))
+	return h
+}
+
+func (h *hllValue) clone() *hllValue {
+	return &hllValue{encoding: h.encoding, registers: slices.Clone(h.registers)}
+}
+
+// MarshalJSON encodes the HyperLogLog in the Redis format: a "HYLL" header
+// followed by the registers.
+func (h *hllValue) MarshalJSON() ([]byte, error) {
+	b := make([]byte, hllHeaderSize, hllHeaderSize+len(h.registers))
+	copy(b, "HYLL")
+	b[4] = h.encoding
+	b[15] = 0x80 // No cached cardinality
+	return json.Marshal(append(b, h.registers...))
+}
+
+func (h *hllValue) UnmarshalJSON(data []byte) error {
+	var b []byte
+	if err := json.Unmarshal(data, &b); err != nil {
+		return err
+	}
+	if len(b) < hllHeaderSize || string(b[:4]) != "HYLL" {
+		return ErrInvalidHLL
+	}
+	h.encoding, h.registers = b[4], b[hllHeaderSize:]
+	switch h.encoding {
+	case hllDense:
+		if len(h.registers) != hllDenseSize {
+			return ErrInvalidHLL
+		}
+	case hllSparse:
+		if _, err := hllDecodeSparse(h.registers); err != nil {
+			return err
+		}
+	default:
+		return ErrInvalidHLL
+	}
+	return nil
+}
+
+// unpack returns one byte per register.
+func (h *hllValue) unpack() []byte {
+	if h.encoding == hllSparse {
+		regs, _ := hllDecodeSparse(h.registers)
+		return regs
+	}
+	regs := make([]byte, hllRegisters)
+	for i := range regs {
+		regs[i] = hllDenseGet(h.registers, i)
+	}
+	return regs
+}
+
+// pack stores registers, keeping the sparse encoding while it fits.
+func (h *hllValue) pack(regs []byte) {
+	if h.encoding == hllSparse {
+		if sparse, ok := hllEncodeSparse(regs); ok {
+			h.registers = sparse
+			return
+		}
+		h.encoding = hllDense
+	}
+	h.registers = make([]byte, hllDenseSize)
+	for i, v := range regs {
+		hllDenseSet(h.registers, i, v)
+	}
+}
+
+func hllDenseGet(r []byte, i int) byte {
+	bit := i * hllBits
+	b, fb := bit/8, uint(bit%8)
+	v := uint(r[b]) >> fb
+	if b+1 < len(r) {
+		v |= uint(r[b+1]) << (8 - fb)
+	}
+	return byte(v & hllRegisterMax)
+}
+
+func hllDenseSet(r []byte, i int, v byte) {
+	bit := i * hllBits
+	b, fb := bit/8, uint(bit%8)
+	r[b] &^= byte(hllRegisterMax << fb)
+	r[b] |= v << fb
+	if b+1 < len(r) {
+		r[b+1] &^= byte(hllRegisterMax >> (8 - fb))
+		r[b+1] |= v >> (8 - fb)
+	}
+}
+
+// Sparse opcodes: ZERO is 00xxxxxx for up to 64 zero registers, XZERO is
+// 01xxxxxx xxxxxxxx for up to 16384, and VAL is 1vvvvvxx for up to 4
+// registers set to a value of up to 32.
+func hllDecodeSparse(b []byte) ([]byte, error) {
+	regs := make([]byte, 0, hllRegisters)
+	for i := 0; i < len(b); i++ {
+		op := b[i]
+		switch {
+		case op&0xc0 == 0x00:
+			regs = append(regs, make([]byte, int(op&0x3f)+1)...)
+		case op&0xc0 == 0x40:
+			if i+1 >= len(b) {
+				return nil, ErrInvalidHLL
+			}
+			i++
+			regs = append(regs, make([]byte, (int(op&0x3f)<<8|int(b[i]))+1)...)
+		default:
+			v := (op>>2)&0x1f + 1
+			for n := int(op&0x03) + 1; n > 0; n-- {
+				regs = append(regs, v)
+			}
+		}
+		if len(regs) > hllRegisters {
+			return nil, ErrInvalidHLL
+		}
+	}
+	if len(regs) != hllRegisters {
+		return nil, ErrInvalidHLL
+	}
+	return regs, nil
+}
+
+// hllEncodeSparse encodes registers sparsely. It reports false if they
+// need the dense encoding.
+func hllEncodeSparse(regs []byte) ([]byte, bool) {
+	var b []byte
+	for i := 0; i < len(regs); {
+		v := regs[i]
+		run := 1
+		for i+run < len(regs) && regs[i+run] == v {
+			run++
+		}
+		i += run
+
+		switch {
+		case v == 0 && run <= 64:
+			b = append(b, byte(run-1))
+		case v == 0:
+			b = append(b, 0x40|byte((run-1)>>8), byte(run-1))
+		case v > hllSparseValMax:
+			return nil, false
+		default:
+			for ; run > 0; run -= 4 {
+				b = append(b, 0x80|(v-1)<<2|byte(min(run, 4)-1))
+			}
+		}
+		if len(b) > hllSparseMaxBytes {
+			return nil, false
+		}
+	}
+	return b, true
+}
+
+// hllPosition returns the register an element maps to and the length of
+// the run of zeros in its hash, plus one.
+func hllPosition(element string) (int, byte) {
+	hash := murmurHash64A([]byte(element), hllSeed)
+	index := int(hash & (hllRegisters - 1))
+	hash >>= hllP
+	hash |= 1 << hllQ // Make sure the count terminates
+	return index, byte(bits.TrailingZeros64(hash) + 1)
+}
+
+// hllCount estimates the cardinality of registers with the estimator by
+// Otmar Ertl that Redis uses.
+func hllCount(regs []byte) uint64 {
+	var histogram [hllQ + 2]int
+	for _, v := range regs {
+		histogram[v]++
+	}
+
+	m := float64(hllRegisters)
+	z := m * hllTau((m-float64(histogram[hllQ+1]))/m)
+	for j := hllQ; j >= 1; j-- {
+		z += float64(histogram[j])
+		z *= 0.5
+	}
+	z += m * hllSigma(float64(histogram[0])/m)
+	return uint64(math.Round(0.5 / math.Ln2 * m * m / z))
+}
+
+func hllSigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+	y := 1.0
+	z := x
+	for {
+		x *= x
+		zPrev := z
+		z += x * y
+		y += y
+		if zPrev == z {
+			return z
+		}
+	}
+}
+
+func hllTau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+	y := 1.0
+	z := 1 - x
+	for {
+		x = math.Sqrt(x)
+		zPrev := z
+		y *= 0.5
+		z -= math.Pow(1-x, 2) * y
+		if zPrev == z {
+			return z / 3
+		}
+	}
+}
+
+// murmurHash64A is the 64-bit MurmurHash2 variant Redis uses to hash
+// HyperLogLog elements.
+func murmurHash64A(key []byte, seed uint64) uint64 {
+	const m = 0xc6a4a7935bd1e995
+	const r = 47
+
+	h := seed ^ uint64(len(key))*m
+	for len(key) >= 8 {
+		k := binary.LittleEndian.Uint64(key)
+		k *= m
+		k ^= k >> r
+		k *= m
+		h ^= k
+		h *= m
+		key = key[8:]
+	}
+	if len(key) > 0 {
+		for i := len(key) - 1; i >= 0; i-- {
+			h ^= uint64(key[i]) << (8 * i)
+		}
+		h *= m
+	}
+	h ^= h >> r
+	h *= m
+	h ^= h >> r
+	return h
+}
+
+// PFAdd adds elements to the HyperLogLog stored at key, creating it if it
+// does not exist. It reports whether the estimated cardinality may have
+// changed.
+func (s *Store) PFAdd(key string, elements ...string) (bool, error) {
+	resp, err := s.apply(&command{
+		Op:     "pfadd",
+		Key:    key,
+		Values: elements,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.(bool), nil
+}
+
+// PFCount returns the estimated cardinality of the union of the
+// HyperLogLogs stored at keys. Missing keys count as empty.
+func (s *Store) PFCount(keys ...string) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	union := make([]byte, hllRegisters)
+	for _, key := range keys {
+		h, err := s.hll(key)
+		if err != nil {
+			return 0, err
+		}
+		if h != nil {
+			hllUnion(union, h.unpack())
+		}
+	}
+	return hllCount(union), nil
+}
+
+// PFMerge stores the union of the HyperLogLogs stored at keys in
+// destination, including destination itself if it exists.
+func (s *Store) PFMerge(destination string, keys ...string) error {
+	_, err := s.apply(&command{
+		Op:   "pfmerge",
+		Key:  destination,
+		Keys: keys,
+	})
+	return err
+}
+
+// hll returns the HyperLogLog stored at key, or nil if the key does not
+// exist. It must be called with the lock held.
+func (s *Store) hll(key string) (*hllValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	h, ok := item.value.(*hllValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return h, nil
+}
+
+// hllUnion sets each register of dst to the maximum of it and src.
+func hllUnion(dst, src []byte) {
+	for i, v := range src {
+		dst[i] = max(dst[i], v)
+	}
+}
+
+func (f *fsm) applyPFAdd(key string, elements []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	created := !ok
+	if created {
+		item = cacheItem{value: newHLL()}
+	}
+	h, ok := item.value.(*hllValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	regs := h.unpack()
+	changed := false
+	for _, e := range elements {
+		i, count := hllPosition(e)
+		if count > regs[i] {
+			regs[i] = count
+			changed = true
+		}
+	}
+	if changed {
+		h.pack(regs)
+	}
+	if created || changed {
+		f.cache.Add(key, item)
+	}
+	return created || changed
+}
+
+func (f *fsm) applyPFMerge(destination string, keys []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(destination, now)
+	if !ok {
+		item = cacheItem{value: newHLL()}
+	}
+	dst, ok := item.value.(*hllValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	// Check every source before touching the destination, so a wrong type
+	// leaves it unchanged.
+	srcs := make([]*hllValue, 0, len(keys))
+	for _, key := range keys {
+		src, ok := f.lookup(key, now)
+		if !ok {
+			continue
+		}
+		h, ok := src.value.(*hllValue)
+		if !ok {
+			return ErrWrongType
+		}
+		srcs = append(srcs, h)
+	}
+
+	regs := dst.unpack()
+	encoding := dst.encoding
+	for _, h := range srcs {
+		if h.encoding == hllDense {
+			encoding = hllDense
+		}
+		hllUnion(regs, h.unpack())
+	}
+	dst.encoding = encoding
+	dst.pack(regs)
+	f.cache.Add(destination, item)
+	return nil
+}
@@ -0,0 +1,199 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestHLLPack(t *testing.T) {
+	tests := []struct {
+		name string
+		regs func(regs []byte)
+		want byte
+	}{
+		{"empty", func(regs []byte) {}, hllSparse},
+		{"few registers", func(regs []byte) {
+			regs[0], regs[100], regs[hllRegisters-1] = 1, 5, hllSparseValMax
+		}, hllSparse},
+		{"long runs", func(regs []byte) {
+			for i := 1000; i < 3000; i++ {
+				regs[i] = 3
+			}
+		}, hllSparse},
+		{"value too large for sparse", func(regs []byte) {
+			regs[7] = hllSparseValMax + 1
+		}, hllDense},
+		{"too many registers for sparse", func(regs []byte) {
+			for i := 0; i < hllRegisters; i += 2 {
+				regs[i] = byte(i%hllSparseValMax) + 1
+			}
+		}, hllDense},
+		{"every register at max", func(regs []byte) {
+			for i := range regs {
+				regs[i] = hllRegisterMax
+			}
+		}, hllDense},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regs := make([]byte, hllRegisters)
+			tt.regs(regs)
+
+			h := newHLL()
+			h.pack(regs)
+			if h.encoding != tt.want {
+				t.Fatalf("got encoding %d, want %d", h.encoding, tt.want)
+			}
+			if !bytes.Equal(h.unpack(), regs) {
+				t.Fatal("unpacked registers differ from the packed ones")
+			}
+
+			// The encoding also survives a snapshot.
+			b, err := h.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded hllValue
+			if err := decoded.UnmarshalJSON(b); err != nil {
+				t.Fatal(err)
+			}
+			if decoded.encoding != tt.want || !bytes.Equal(decoded.unpack(), regs) {
+				t.Fatal("decoded HyperLogLog differs from the encoded one")
+			}
+		})
+	}
+}
+
+func TestPFAddPromotion(t *testing.T) {
+	s := openStore(t)
+
+	tests := []struct {
+		total int
+		want  byte
+	}{
+		{10, hllSparse},
+		{100, hllSparse},
+		{1000, hllSparse},
+		{5000, hllDense},
+		{50000, hllDense},
+	}
+
+	added := 0
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.total), func(t *testing.T) {
+			var elements []string
+			for ; added < tt.total; added++ {
+				elements = append(elements, fmt.Sprintf("element-%d", added))
+			}
+			if _, err := s.PFAdd("hll", elements...); err != nil {
+				t.Fatal(err)
+			}
+
+			s.mu.Lock()
+			item, _ := s.peek("hll")
+			s.mu.Unlock()
+			if enc := item.value.(*hllValue).encoding; enc != tt.want {
+				t.Fatalf("got encoding %d, want %d", enc, tt.want)
+			}
+			n, err := s.PFCount("hll")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e := math.Abs(float64(n)-float64(tt.total)) / float64(tt.total); e > 0.03 {
+				t.Fatalf("got count %d, want %d within 3%%", n, tt.total)
+			}
+		})
+	}
+}
+
+func TestPFMerge(t *testing.T) {
+	s := openStore(t)
+
+	add := func(key string, from, to int) {
+		t.Helper()
+		var elements []string
+		for i := from; i < to; i++ {
+			elements = append(elements, fmt.Sprintf("element-%d", i))
+		}
+		if _, err := s.PFAdd(key, elements...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add("sparse1", 0, 100)
+	add("sparse2", 50, 150)
+	add("dense", 1000, 11000)
+
+	tests := []struct {
+		name    string
+		dst     string
+		keys    []string
+		want    uint64
+		wantEnc byte
+	}{
+		{"sparse sources", "m1", []string{"sparse1", "sparse2"}, 150, hllSparse},
+		{"dense source", "m2", []string{"sparse1", "dense"}, 10100, hllDense},
+		{"missing source", "m3", []string{"sparse1", "missing"}, 100, hllSparse},
+		{"into existing destination", "sparse2", []string{"sparse1"}, 150, hllSparse},
+		{"no sources", "m4", nil, 0, hllSparse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.PFMerge(tt.dst, tt.keys...); err != nil {
+				t.Fatal(err)
+			}
+			s.mu.Lock()
+			item, _ := s.peek(tt.dst)
+			s.mu.Unlock()
+			if enc := item.value.(*hllValue).encoding; enc != tt.wantEnc {
+				t.Fatalf("got encoding %d, want %d", enc, tt.wantEnc)
+			}
+			n, err := s.PFCount(tt.dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(float64(n)-float64(tt.want)) > 0.03*float64(tt.want) {
+				t.Fatalf("got count %d, want %d within 3%%", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestPFMergeWrongTypeLeavesDestination(t *testing.T) {
+	s := openStore(t)
+
+	dense := make([]string, 3000)
+	for i := range dense {
+		dense[i] = fmt.Sprintf("element-%d", i)
+	}
+	if _, err := s.PFAdd("dense", dense...); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PFAdd("dst", "a", "b", "c"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set("str", "x"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.PFMerge("dst", "dense", "str"); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("got error %v, want %v", err, ErrWrongType)
+	}
+
+	s.mu.Lock()
+	item, _ := s.peek("dst")
+	s.mu.Unlock()
+	if enc := item.value.(*hllValue).encoding; enc != hllSparse {
+		t.Fatalf("destination encoding changed to %d", enc)
+	}
+	if _, err := s.PFAdd("dst", "d"); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.PFCount("dst"); err != nil || n != 4 {
+		t.Fatalf("got count %d, error %v, want 4", n, err)
+	}
+}
@@ -0,0 +1,743 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidPath is returned when a JSON path cannot be parsed.
+	ErrInvalidPath = errors.New("invalid JSON path")
+	// ErrInvalidJSON is returned when a value is not valid JSON.
+	ErrInvalidJSON = errors.New("invalid JSON value")
+	// ErrJSONRoot is returned when a new document is set at a path other than
+	// the root.
+	ErrJSONRoot = errors.New("new documents must be created at the root")
+)
+
+// jsonValue is the value of a JSON key. Objects are decoded as
+// map[string]interface{}, arrays as *[]interface{} so they can grow in
+// place, and numbers as json.Number so integers keep their precision.
+type jsonValue struct {
+	root interface{}
+}
+
+func (d *jsonValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.root)
+}
+
+func (d *jsonValue) clone() *jsonValue {
+	return &jsonValue{root: jsonCopy(d.root)}
+}
+
+func (d *jsonValue) UnmarshalJSON(b []byte) error {
+	v, err := parseJSON(string(b))
+	if err != nil {
+		return err
+	}
+	d.root = v
+	return nil
+}
+
+// jsonDeleted marks array elements removed by a delete until the arrays
+// are compacted.
+type jsonDeleted struct{}
+
+// parseJSON decodes a single JSON value.
+func parseJSON(text string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(text))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, ErrInvalidJSON
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, ErrInvalidJSON
+	}
+	return jsonNode(v), nil
+}
+
+// jsonNode converts the arrays of a decoded value to *[]interface{}.
+func jsonNode(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = jsonNode(e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = jsonNode(e)
+		}
+		return &v
+	default:
+		return v
+	}
+}
+
+// jsonCopy returns a deep copy of v.
+func jsonCopy(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = jsonCopy(e)
+		}
+		return m
+	case *[]interface{}:
+		a := make([]interface{}, len(*v))
+		for i, e := range *v {
+			a[i] = jsonCopy(e)
+		}
+		return &a
+	default:
+		return v
+	}
+}
+
+// jsonText encodes v without escaping HTML characters, so documents read
+// back the way they were written.
+func jsonText(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// jsonSegment is one step of a JSON path.
+type jsonSegment struct {
+	name      string
+	index     int
+	isIndex   bool
+	wildcard  bool
+	recursive bool // Applies to the current value and all its descendants
+}
+
+// parseJSONPath parses a JSONPath expression. It supports the root $,
+// child names as .name or ['name'], array indexes as [n] with negative
+// indexes counting from the end, the wildcards .* and [*], and recursive
+// descent with ..name, ..* and ..[n].
+func parseJSONPath(path string) ([]jsonSegment, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, ErrInvalidPath
+	}
+
+	var segs []jsonSegment
+	for i := 1; i < len(path); {
+		var seg jsonSegment
+		switch {
+		case strings.HasPrefix(path[i:], ".."):
+			seg.recursive = true
+			i += 2
+		case path[i] == '.':
+			i++
+			if i < len(path) && path[i] == '[' {
+				return nil, ErrInvalidPath
+			}
+		case path[i] != '[':
+			return nil, ErrInvalidPath
+		}
+
+		if i < len(path) && path[i] == '[' {
+			n, err := parseJSONBracket(path[i:], &seg)
+			if err != nil {
+				return nil, err
+			}
+			i += n
+		} else {
+			j := i
+			for j < len(path) && path[j] != '.' && path[j] != '[' {
+				j++
+			}
+			switch name := path[i:j]; name {
+			case "":
+				return nil, ErrInvalidPath
+			case "*":
+				seg.wildcard = true
+			default:
+				seg.name = name
+			}
+			i = j
+		}
+		segs = append(segs, seg)
+	}
+	return segs, nil
+}
+
+// parseJSONBracket parses a bracketed path step at the start of s into seg
+// and returns its length.
+func parseJSONBracket(s string, seg *jsonSegment) (int, error) {
+	if len(s) > 1 && (s[1] == '\'' || s[1] == '"') {
+		quote := s[1]
+		var name strings.Builder
+		for i := 2; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				if i+1 == len(s) {
+					return 0, ErrInvalidPath
+				}
+				i++
+				name.WriteByte(s[i])
+			case quote:
+				if i+1 == len(s) || s[i+1] != ']' {
+					return 0, ErrInvalidPath
+				}
+				seg.name = name.String()
+				return i + 2, nil
+			default:
+				name.WriteByte(s[i])
+			}
+		}
+		return 0, ErrInvalidPath
+	}
+
+	end := strings.IndexByte(s, ']')
+	if end < 0 {
+		return 0, ErrInvalidPath
+	}
+	if s[1:end] == "*" {
+		seg.wildcard = true
+		return end + 1, nil
+	}
+	index, err := strconv.Atoi(s[1:end])
+	if err != nil {
+		return 0, ErrInvalidPath
+	}
+	seg.index, seg.isIndex = index, true
+	return end + 1, nil
+}
+
+// jsonRef refers to a value within a document so it can be replaced or
+// removed.
+type jsonRef struct {
+	parent interface{} // map[string]interface{}, *[]interface{}, or nil for the root
+	key    string
+	index  int
+	path   string // Normalized path of the value, such as $["a"][0]
+}
+
+func (d *jsonValue) get(r jsonRef) interface{} {
+	switch p := r.parent.(type) {
+	case map[string]interface{}:
+		return p[r.key]
+	case *[]interface{}:
+		return (*p)[r.index]
+	default:
+		return d.root
+	}
+}
+
+func (d *jsonValue) set(r jsonRef, v interface{}) {
+	switch p := r.parent.(type) {
+	case map[string]interface{}:
+		p[r.key] = v
+	case *[]interface{}:
+		(*p)[r.index] = v
+	default:
+		d.root = v
+	}
+}
+
+// eval returns references to the values matched by segs, in document order
+// with object members sorted by name. Each value is matched at most once.
+func (d *jsonValue) eval(segs []jsonSegment) []jsonRef {
+	refs := []jsonRef{{path: "$"}}
+	for _, seg := range segs {
+		var next []jsonRef
+		for _, r := range refs {
+			v := d.get(r)
+			if !seg.recursive {
+				next = append(next, seg.children(r.path, v)...)
+				continue
+			}
+			jsonWalk(r.path, v, func(path string, v interface{}) {
+				next = append(next, seg.children(path, v)...)
+			})
+		}
+		refs = dedupeRefs(next)
+	}
+	return refs
+}
+
+// children returns references to the children of v, found at path, matched
+// by seg.
+func (seg jsonSegment) children(path string, v interface{}) []jsonRef {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if seg.wildcard {
+			var refs []jsonRef
+			for _, k := range sortedKeys(v) {
+				refs = append(refs, memberRef(path, v, k))
+			}
+			return refs
+		}
+		if _, ok := v[seg.name]; ok && !seg.isIndex {
+			return []jsonRef{memberRef(path, v, seg.name)}
+		}
+	case *[]interface{}:
+		if seg.wildcard {
+			refs := make([]jsonRef, len(*v))
+			for i := range *v {
+				refs[i] = elementRef(path, v, i)
+			}
+			return refs
+		}
+		if seg.isIndex {
+			i := seg.index
+			if i < 0 {
+				i += len(*v)
+			}
+			if i >= 0 && i < len(*v) {
+				return []jsonRef{elementRef(path, v, i)}
+			}
+		}
+	}
+	return nil
+}
+
+// memberRef refers to member key of the object m found at path.
+func memberRef(path string, m map[string]interface{}, key string) jsonRef {
+	return jsonRef{parent: m, key: key, path: path + "[" + strconv.Quote(key) + "]"}
+}
+
+// elementRef refers to element i of the array a found at path.
+func elementRef(path string, a *[]interface{}, i int) jsonRef {
+	return jsonRef{parent: a, index: i, path: path + "[" + strconv.Itoa(i) + "]"}
+}
+
+// jsonWalk calls fn for v, found at path, and each of its descendants.
+func jsonWalk(path string, v interface{}, fn func(string, interface{})) {
+	fn(path, v)
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(v) {
+			jsonWalk(path+"["+strconv.Quote(k)+"]", v[k], fn)
+		}
+	case *[]interface{}:
+		for i, e := range *v {
+			jsonWalk(path+"["+strconv.Itoa(i)+"]", e, fn)
+		}
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// dedupeRefs removes repeated references, which recursive descent can
+// produce, keeping the first of each. References to the same value share
+// a path.
+func dedupeRefs(refs []jsonRef) []jsonRef {
+	seen := make(map[string]bool, len(refs))
+	out := refs[:0]
+	for _, r := range refs {
+		if !seen[r.path] {
+			seen[r.path] = true
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// JSONSet sets the value at path in the JSON document stored at key. A new
+// document can only be created at the root path $. A path ending in a
+// member name adds the member to the objects it selects. If nx is set only
+// missing values are set, and if xx is set only existing ones are replaced.
+// It reports whether any value was set.
+func (s *Store) JSONSet(key, path, value string, nx, xx bool) (bool, error) {
+	if _, err := parseJSONPath(path); err != nil {
+		return false, err
+	}
+	if _, err := parseJSON(value); err != nil {
+		return false, err
+	}
+	var flags []string
+	if nx {
+		flags = append(flags, "nx")
+	}
+	if xx {
+		flags = append(flags, "xx")
+	}
+	resp, err := s.apply(&command{
+		Op:    "jsonset",
+		Key:   key,
+		Path:  path,
+		Value: value,
+		Flags: flags,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.(bool), nil
+}
+
+// JSONGet returns the values at paths in the JSON document stored at key.
+// With a single path the result is a JSON array of the matched values;
+// with several it is an object mapping each path to such an array.
+func (s *Store) JSONGet(key string, paths ...string) (string, error) {
+	if len(paths) == 0 {
+		paths = []string{"$"}
+	}
+	segs := make([][]jsonSegment, len(paths))
+	for i, path := range paths {
+		var err error
+		if segs[i], err = parseJSONPath(path); err != nil {
+			return "", err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, err := s.json(key)
+	if err != nil {
+		return "", err
+	}
+	if d == nil {
+		return "", ErrKeyNotFound
+	}
+
+	results := make(map[string][]interface{}, len(paths))
+	for i, path := range paths {
+		values := []interface{}{}
+		for _, r := range d.eval(segs[i]) {
+			values = append(values, d.get(r))
+		}
+		results[path] = values
+	}
+	if len(paths) == 1 {
+		return jsonText(results[paths[0]])
+	}
+	return jsonText(results)
+}
+
+// JSONDel removes the values at path from the JSON document stored at key
+// and returns how many were removed. Deleting the root deletes the key.
+func (s *Store) JSONDel(key, path string) (int, error) {
+	if _, err := parseJSONPath(path); err != nil {
+		return 0, err
+	}
+	resp, err := s.apply(&command{
+		Op:   "jsondel",
+		Key:  key,
+		Path: path,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// JSONNumIncrBy atomically increments the numbers at path in the JSON
+// document stored at key by delta. It returns a JSON array of the new
+// values, with null for matched values that are not numbers. Integers stay
+// integers when delta is a whole number.
+func (s *Store) JSONNumIncrBy(key, path string, delta float64) (string, error) {
+	if _, err := parseJSONPath(path); err != nil {
+		return "", err
+	}
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return "", ErrNotFinite
+	}
+	resp, err := s.apply(&command{
+		Op:         "jsonnumincrby",
+		Key:        key,
+		Path:       path,
+		FloatDelta: delta,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.(string), nil
+}
+
+// JSONArrAppend appends values, each a JSON text, to the arrays at path in
+// the JSON document stored at key. It returns the new length of each
+// matched array, or -1 for matched values that are not arrays.
+func (s *Store) JSONArrAppend(key, path string, values ...string) ([]int64, error) {
+	if _, err := parseJSONPath(path); err != nil {
+		return nil, err
+	}
+	for _, v := range values {
+		if _, err := parseJSON(v); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := s.apply(&command{
+		Op:     "jsonarrappend",
+		Key:    key,
+		Path:   path,
+		Values: values,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]int64), nil
+}
+
+// json returns the JSON document stored at key, or nil if the key does not
+// exist. It must be called with the lock held.
+func (s *Store) json(key string) (*jsonValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	d, ok := item.value.(*jsonValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return d, nil
+}
+
+// jsonIncr adds delta to the number n.
+func jsonIncr(n json.Number, delta float64) (json.Number, error) {
+	if i, err := n.Int64(); err == nil && delta == math.Trunc(delta) && math.Abs(delta) < 1<<53 {
+		d := int64(delta)
+		if (d > 0 && i > math.MaxInt64-d) || (d < 0 && i < math.MinInt64-d) {
+			return "", ErrOverflow
+		}
+		return json.Number(strconv.FormatInt(i+d, 10)), nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return "", ErrNotFloat
+	}
+	f += delta
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", ErrNotFinite
+	}
+	return json.Number(strconv.FormatFloat(f, 'g', -1, 64)), nil
+}
+
+func (f *fsm) applyJSONSet(key, path, value string, nx, xx bool, now time.Time) interface{} {
+	segs, err := parseJSONPath(path)
+	if err != nil {
+		return err
+	}
+	v, err := parseJSON(value)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		if len(segs) > 0 {
+			return ErrJSONRoot
+		}
+		if xx {
+			return false
+		}
+		f.cache.Add(key, cacheItem{value: &jsonValue{root: v}})
+		return true
+	}
+	d, ok := item.value.(*jsonValue)
+	if !ok {
+		return ErrWrongType
+	}
+	if len(segs) == 0 {
+		if nx {
+			return false
+		}
+		d.root = v
+		f.cache.Add(key, item)
+		return true
+	}
+
+	// Resolve the parents first so a final member name can add members
+	// to objects that do not have them yet. Recursive descent only matches
+	// existing values.
+	last := segs[len(segs)-1]
+	type parent struct {
+		path  string
+		value interface{}
+	}
+	var parents []parent
+	for _, r := range d.eval(segs[:len(segs)-1]) {
+		if last.recursive {
+			jsonWalk(r.path, d.get(r), func(path string, v interface{}) {
+				parents = append(parents, parent{path, v})
+			})
+		} else {
+			parents = append(parents, parent{r.path, d.get(r)})
+		}
+	}
+	var refs []jsonRef
+	for _, p := range parents {
+		m, ok := p.value.(map[string]interface{})
+		if ok && !last.wildcard && !last.isIndex && !last.recursive {
+			_, exists := m[last.name]
+			if (nx && exists) || (xx && !exists) {
+				continue
+			}
+			refs = append(refs, memberRef(p.path, m, last.name))
+			continue
+		}
+		if !nx {
+			refs = append(refs, last.children(p.path, p.value)...)
+		}
+	}
+	refs = dedupeRefs(refs)
+	for _, r := range refs {
+		d.set(r, jsonCopy(v))
+	}
+	if len(refs) > 0 {
+		f.cache.Add(key, item)
+	}
+	return len(refs) > 0
+}
+
+func (f *fsm) applyJSONDel(key, path string, now time.Time) interface{} {
+	segs, err := parseJSONPath(path)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return 0
+	}
+	d, ok := item.value.(*jsonValue)
+	if !ok {
+		return ErrWrongType
+	}
+	if len(segs) == 0 {
+		f.cache.Remove(key)
+		return 1
+	}
+
+	refs := d.eval(segs)
+	var arrays []*[]interface{}
+	for _, r := range refs {
+		switch p := r.parent.(type) {
+		case map[string]interface{}:
+			delete(p, r.key)
+		case *[]interface{}:
+			// Mark array elements so the indexes of other matches stay valid.
+			(*p)[r.index] = jsonDeleted{}
+			arrays = append(arrays, p)
+		}
+	}
+	for _, a := range arrays {
+		items := (*a)[:0]
+		for _, e := range *a {
+			if _, ok := e.(jsonDeleted); !ok {
+				items = append(items, e)
+			}
+		}
+		*a = items
+	}
+	if len(refs) > 0 {
+		f.cache.Add(key, item)
+	}
+	return len(refs)
+}
+
+func (f *fsm) applyJSONNumIncrBy(key, path string, delta float64, now time.Time) interface{} {
+	segs, err := parseJSONPath(path)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return ErrKeyNotFound
+	}
+	d, ok := item.value.(*jsonValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	// Compute every result before changing anything, so an overflow leaves
+	// the document untouched.
+	refs := d.eval(segs)
+	results := make([]interface{}, len(refs))
+	for i, r := range refs {
+		n, ok := d.get(r).(json.Number)
+		if !ok {
+			continue
+		}
+		if results[i], err = jsonIncr(n, delta); err != nil {
+			return err
+		}
+	}
+	for i, r := range refs {
+		if results[i] != nil {
+			d.set(r, results[i])
+		}
+	}
+	if len(refs) > 0 {
+		f.cache.Add(key, item)
+	}
+	text, err := jsonText(results)
+	if err != nil {
+		return err
+	}
+	return text
+}
+
+func (f *fsm) applyJSONArrAppend(key, path string, values []string, now time.Time) interface{} {
+	segs, err := parseJSONPath(path)
+	if err != nil {
+		return err
+	}
+	elems := make([]interface{}, len(values))
+	for i, v := range values {
+		if elems[i], err = parseJSON(v); err != nil {
+			return err
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return ErrKeyNotFound
+	}
+	d, ok := item.value.(*jsonValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	refs := d.eval(segs)
+	lengths := make([]int64, len(refs))
+	for i, r := range refs {
+		a, ok := d.get(r).(*[]interface{})
+		if !ok {
+			lengths[i] = -1
+			continue
+		}
+		for _, e := range elems {
+			*a = append(*a, jsonCopy(e))
+		}
+		lengths[i] = int64(len(*a))
+	}
+	if len(refs) > 0 {
+		f.cache.Add(key, item)
+	}
+	return lengths
+}
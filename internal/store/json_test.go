@@ -0,0 +1,236 @@
+package store
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testJSONDoc = `{"a":1,"b":{"a":2,"c":[1,2,3]},"s":"x<y","big":12345678901234567890}`
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantErr bool
+	}{
+		{"$", false},
+		{"$.a", false},
+		{"$.a.b", false},
+		{"$['a b']", false},
+		{`$["a\"b"]`, false},
+		{"$.a[0]", false},
+		{"$.a[-1]", false},
+		{"$.*", false},
+		{"$[*]", false},
+		{"$..a", false},
+		{"$..*", false},
+		{"$..[0]", false},
+		{"", true},
+		{"a", true},
+		{"$.", true},
+		{"$a", true},
+		{"$.[0]", true},
+		{"$[0", true},
+		{"$[x]", true},
+		{"$['a]", true},
+		{"$['a'x", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, err := parseJSONPath(tt.path)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidPath) {
+				t.Fatalf("got error %v, want %v", err, ErrInvalidPath)
+			}
+		})
+	}
+}
+
+func TestJSONGet(t *testing.T) {
+	s := openStore(t)
+	if _, err := s.JSONSet("doc", "$", testJSONDoc, false, false); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  string
+	}{
+		{"root", []string{"$"}, `[{"a":1,"b":{"a":2,"c":[1,2,3]},"big":12345678901234567890,"s":"x<y"}]`},
+		{"member", []string{"$.a"}, `[1]`},
+		{"nested", []string{"$.b.c"}, `[[1,2,3]]`},
+		{"quoted", []string{"$['b']['a']"}, `[2]`},
+		{"index", []string{"$.b.c[1]"}, `[2]`},
+		{"negative index", []string{"$.b.c[-1]"}, `[3]`},
+		{"index out of range", []string{"$.b.c[5]"}, `[]`},
+		{"wildcard", []string{"$.b.c[*]"}, `[1,2,3]`},
+		{"recursive", []string{"$..a"}, `[1,2]`},
+		{"missing", []string{"$.x"}, `[]`},
+		{"several paths", []string{"$.a", "$.s"}, `{"$.a":[1],"$.s":["x<y"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.JSONGet("doc", tt.paths...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := s.JSONGet("missing"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("got error %v, want %v", err, ErrKeyNotFound)
+	}
+}
+
+func TestJSONSet(t *testing.T) {
+	s := openStore(t)
+
+	tests := []struct {
+		name    string
+		path    string
+		value   string
+		nx, xx  bool
+		want    bool
+		wantErr error
+		wantDoc string
+	}{
+		{"replace member", "$.a", `"one"`, false, false, true, nil,
+			`{"a":"one","b":{"a":2,"c":[1,2,3]},"big":12345678901234567890,"s":"x<y"}`},
+		{"add member", "$.b.d", `{"e":true}`, false, false, true, nil,
+			`{"a":1,"b":{"a":2,"c":[1,2,3],"d":{"e":true}},"big":12345678901234567890,"s":"x<y"}`},
+		{"replace element", "$.b.c[0]", `null`, false, false, true, nil,
+			`{"a":1,"b":{"a":2,"c":[null,2,3]},"big":12345678901234567890,"s":"x<y"}`},
+		{"replace recursive", "$..a", `0`, false, false, true, nil,
+			`{"a":0,"b":{"a":0,"c":[1,2,3]},"big":12345678901234567890,"s":"x<y"}`},
+		{"replace root", "$", `[1]`, false, false, true, nil, `[1]`},
+		{"nx existing", "$.a", `2`, true, false, false, nil, testJSONDoc},
+		{"nx missing", "$.x", `2`, true, false, true, nil,
+			`{"a":1,"b":{"a":2,"c":[1,2,3]},"big":12345678901234567890,"s":"x<y","x":2}`},
+		{"xx missing", "$.x", `2`, false, true, false, nil, testJSONDoc},
+		{"xx existing", "$.a", `2`, false, true, true, nil,
+			`{"a":2,"b":{"a":2,"c":[1,2,3]},"big":12345678901234567890,"s":"x<y"}`},
+		{"missing parent", "$.x.y", `2`, false, false, false, nil, testJSONDoc},
+		{"invalid path", "a", `2`, false, false, false, ErrInvalidPath, testJSONDoc},
+		{"invalid value", "$.a", `{`, false, false, false, ErrInvalidJSON, testJSONDoc},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.JSONSet("doc", "$", testJSONDoc, false, false); err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.JSONSet("doc", tt.path, tt.value, tt.nx, tt.xx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			checkJSONDoc(t, s, tt.wantDoc)
+		})
+	}
+
+	if _, err := s.JSONSet("new", "$.a", `1`, false, false); !errors.Is(err, ErrJSONRoot) {
+		t.Fatalf("got error %v, want %v", err, ErrJSONRoot)
+	}
+}
+
+func TestJSONDel(t *testing.T) {
+	s := openStore(t)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    int
+		wantDoc string
+	}{
+		{"member", "$.a", 1, `{"b":{"a":2,"c":[1,2,3]},"big":12345678901234567890,"s":"x<y"}`},
+		{"element", "$.b.c[1]", 1, `{"a":1,"b":{"a":2,"c":[1,3]},"big":12345678901234567890,"s":"x<y"}`},
+		{"every element", "$.b.c[*]", 3, `{"a":1,"b":{"a":2,"c":[]},"big":12345678901234567890,"s":"x<y"}`},
+		{"recursive", "$..a", 2, `{"b":{"c":[1,2,3]},"big":12345678901234567890,"s":"x<y"}`},
+		{"missing", "$.x", 0, testJSONDoc},
+		{"root", "$", 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.JSONSet("doc", "$", testJSONDoc, false, false); err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.JSONDel("doc", tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %d deleted, want %d", got, tt.want)
+			}
+			checkJSONDoc(t, s, tt.wantDoc)
+		})
+	}
+}
+
+// checkJSONDoc checks the document stored at "doc", or that the key is gone
+// if want is empty.
+func checkJSONDoc(t *testing.T, s *Store, want string) {
+	t.Helper()
+
+	got, err := s.JSONGet("doc")
+	if want == "" {
+		if !errors.Is(err, ErrKeyNotFound) {
+			t.Fatalf("got document %s, error %v, want none", got, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, err := jsonText([]interface{}{mustParseJSON(t, want)}); err != nil || got != want {
+		t.Fatalf("got document %s, want %s", got, want)
+	}
+}
+
+func mustParseJSON(t *testing.T, text string) interface{} {
+	t.Helper()
+
+	v, err := parseJSON(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// TestDedupeRefs checks that repeated references are dropped, including
+// those to the root, which has no parent.
+func TestDedupeRefs(t *testing.T) {
+	d := &jsonValue{}
+	if err := d.UnmarshalJSON([]byte(`{"a":{"a":[{"a":1}]}}`)); err != nil {
+		t.Fatal(err)
+	}
+	root := jsonRef{path: "$"}
+	if got := dedupeRefs([]jsonRef{root, root}); len(got) != 1 {
+		t.Fatalf("got %d root references, want 1", len(got))
+	}
+
+	// Recursive descent reaches the nested members through several
+	// ancestors, but each is matched once.
+	segs, err := parseJSONPath("$..a..a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, r := range d.eval(segs) {
+		got = append(got, r.path)
+	}
+	want := []string{`$["a"]["a"]`, `$["a"]["a"][0]["a"]`}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Fatalf("got paths %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,401 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// ErrIndexOutOfRange is returned when a list index is out of range.
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+// listValue is the value of a list key. It is a pointer type so the FSM can
+// modify it in place.
+type listValue struct {
+	items []string
+}
+
+func (l *listValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.items)
+}
+
+func (l *listValue) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, &l.items)
+}
+
+func (l *listValue) clone() *listValue {
+	return &listValue{items: slices.Clone(l.items)}
+}
+
+// popResult is returned by the FSM for list pops.
+type popResult struct {
+	key    string
+	values []string
+}
+
+// LPush inserts values at the head of the list stored at key, creating the
+// list if it does not exist. It returns the length of the list.
+func (s *Store) LPush(key string, values ...string) (int, error) {
+	return s.push("lpush", key, values)
+}
+
+// RPush inserts values at the tail of the list stored at key, creating the
+// list if it does not exist. It returns the length of the list.
+func (s *Store) RPush(key string, values ...string) (int, error) {
+	return s.push("rpush", key, values)
+}
+
+func (s *Store) push(op, key string, values []string) (int, error) {
+	resp, err := s.apply(&command{
+		Op:     op,
+		Key:    key,
+		Values: values,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// LPop removes and returns up to count elements from the head of the list
+// stored at key.
+func (s *Store) LPop(key string, count int) ([]string, error) {
+	res, err := s.pop("lpop", []string{key}, count)
+	if err != nil {
+		return nil, err
+	}
+	return res.values, nil
+}
+
+// RPop removes and returns up to count elements from the tail of the list
+// stored at key.
+func (s *Store) RPop(key string, count int) ([]string, error) {
+	res, err := s.pop("rpop", []string{key}, count)
+	if err != nil {
+		return nil, err
+	}
+	return res.values, nil
+}
+
+// BLPop pops an element from the head of the first non-empty list among
+// keys. If all lists are empty it waits until an element is pushed, the
+// timeout elapses or ctx is done; a zero timeout waits indefinitely. The
+// returned key is empty if the timeout elapsed.
+func (s *Store) BLPop(ctx context.Context, keys []string, timeout time.Duration) (key, value string, err error) {
+	return s.blockingPop(ctx, "lpop", keys, timeout)
+}
+
+// BRPop is like BLPop but pops from the tail of the list.
+func (s *Store) BRPop(ctx context.Context, keys []string, timeout time.Duration) (key, value string, err error) {
+	return s.blockingPop(ctx, "rpop", keys, timeout)
+}
+
+func (s *Store) blockingPop(ctx context.Context, op string, keys []string, timeout time.Duration) (string, string, error) {
+	// Followers cannot pop, so fail now rather than after waiting.
+	if s.raft.State() != raft.Leader {
+		return "", "", fmt.Errorf("not leader")
+	}
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	// Register before popping so a push in between is not missed.
+	wake := s.watch(keys)
+	defer s.unwatch(keys, wake)
+
+	for {
+		// Only propose a pop when there is something to pop, so an idle
+		// waiter does not write an empty entry to the Raft log.
+		ready, err := s.poppable(keys)
+		if err != nil {
+			return "", "", err
+		}
+		if ready {
+			res, err := s.pop(op, keys, 1)
+			if err != nil {
+				return "", "", err
+			}
+			if len(res.values) > 0 {
+				return res.key, res.values[0], nil
+			}
+		}
+
+		select {
+		case <-wake:
+		case <-expired:
+			return "", "", nil
+		case <-ctx.Done():
+			return "", "", ctx.Err()
+		}
+	}
+}
+
+// pop removes up to count elements from the first non-empty list among keys.
+// The pop goes through Raft, so an element is handed out only once.
+func (s *Store) pop(op string, keys []string, count int) (popResult, error) {
+	resp, err := s.apply(&command{
+		Op:    op,
+		Keys:  keys,
+		Count: count,
+	})
+	if err != nil {
+		return popResult{}, err
+	}
+	return resp.(popResult), nil
+}
+
+// poppable reports whether one of keys holds a non-empty list. Like a pop,
+// it fails if the first existing key holds another type.
+func (s *Store) poppable(keys []string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		l, err := s.list(key)
+		if err != nil {
+			return false, err
+		}
+		if l != nil && len(l.items) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// watch returns a channel that receives a signal when an element is pushed
+// to one of keys. It must be released with unwatch.
+func (s *Store) watch(keys []string) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	for _, key := range keys {
+		if s.waiters[key] == nil {
+			s.waiters[key] = make(map[chan struct{}]struct{})
+		}
+		s.waiters[key][ch] = struct{}{}
+	}
+	return ch
+}
+
+// unwatch removes a channel registered with watch.
+func (s *Store) unwatch(keys []string, ch chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		delete(s.waiters[key], ch)
+		if len(s.waiters[key]) == 0 {
+			delete(s.waiters, key)
+		}
+	}
+}
+
+// LRange returns the elements of the list stored at key between start and
+// stop inclusive. Negative indexes count from the end of the list.
+func (s *Store) LRange(key string, start, stop int64) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.list(key)
+	if err != nil || l == nil {
+		return nil, err
+	}
+	lo, hi := listRange(start, stop, len(l.items))
+	return append([]string(nil), l.items[lo:hi]...), nil
+}
+
+// LLen returns the length of the list stored at key.
+func (s *Store) LLen(key string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.list(key)
+	if err != nil || l == nil {
+		return 0, err
+	}
+	return len(l.items), nil
+}
+
+// LIndex returns the element at index in the list stored at key. Negative
+// indexes count from the end of the list.
+func (s *Store) LIndex(key string, index int64) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, err := s.list(key)
+	if err != nil {
+		return "", err
+	}
+	if l == nil {
+		return "", ErrKeyNotFound
+	}
+	if index < 0 {
+		index += int64(len(l.items))
+	}
+	if index < 0 || index >= int64(len(l.items)) {
+		return "", ErrIndexOutOfRange
+	}
+	return l.items[index], nil
+}
+
+// LTrim trims the list stored at key to the elements between start and stop
+// inclusive. The key is deleted if no elements remain.
+func (s *Store) LTrim(key string, start, stop int64) error {
+	_, err := s.apply(&command{
+		Op:    "ltrim",
+		Key:   key,
+		Start: start,
+		Stop:  stop,
+	})
+	return err
+}
+
+// list returns the list stored at key, or nil if the key does not exist. It
+// must be called with the lock held.
+func (s *Store) list(key string) (*listValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	l, ok := item.value.(*listValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return l, nil
+}
+
+// listRange converts inclusive start and stop indexes, which may be
+// negative, to the half-open range [lo, hi) over a list of n elements.
+func listRange(start, stop int64, n int) (int, int) {
+	if start < 0 {
+		start += int64(n)
+	}
+	if stop < 0 {
+		stop += int64(n)
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= int64(n) {
+		stop = int64(n) - 1
+	}
+	if start > stop {
+		return 0, 0
+	}
+	return int(start), int(stop) + 1
+}
+
+func (f *fsm) applyPush(key string, values []string, head bool, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: &listValue{}}
+	}
+	l, ok := item.value.(*listValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	if head {
+		items := make([]string, 0, len(values)+len(l.items))
+		for i := len(values) - 1; i >= 0; i-- {
+			items = append(items, values[i])
+		}
+		l.items = append(items, l.items...)
+	} else {
+		l.items = append(l.items, values...)
+	}
+	if len(l.items) > 0 {
+		f.cache.Add(key, item)
+		f.wake(key)
+	}
+	return len(l.items)
+}
+
+// wake notifies the blocking pops waiting on key. It must be called with the
+// lock held.
+func (f *fsm) wake(key string) {
+	for ch := range f.waiters[key] {
+		select {
+		case ch <- struct{}{}:
+		default: // Already signalled
+		}
+	}
+}
+
+// wakeAll notifies every blocking pop and stream read, for when the whole
+// dataset is replaced. It must be called with the lock held.
+func (f *fsm) wakeAll() {
+	for key := range f.waiters {
+		f.wake(key)
+	}
+}
+
+func (f *fsm) applyPop(keys []string, count int, head bool, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if count < 1 {
+		count = 1
+	}
+	for _, key := range keys {
+		item, ok := f.lookup(key, now)
+		if !ok {
+			continue
+		}
+		l, ok := item.value.(*listValue)
+		if !ok {
+			return ErrWrongType
+		}
+
+		n := min(count, len(l.items))
+		values := make([]string, n)
+		if head {
+			copy(values, l.items[:n])
+			l.items = l.items[n:]
+		} else {
+			for i := 0; i < n; i++ {
+				values[i] = l.items[len(l.items)-1-i]
+			}
+			l.items = l.items[:len(l.items)-n]
+		}
+		if len(l.items) == 0 {
+			f.cache.Remove(key)
+		}
+		return popResult{key: key, values: values}
+	}
+	return popResult{}
+}
+
+func (f *fsm) applyLTrim(key string, start, stop int64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return nil
+	}
+	l, ok := item.value.(*listValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	lo, hi := listRange(start, stop, len(l.items))
+	l.items = append([]string(nil), l.items[lo:hi]...)
+	if len(l.items) == 0 {
+		f.cache.Remove(key)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestList(t *testing.T) {
+	s := openStore(t)
+
+	if n, err := s.RPush("l", "b", "c"); err != nil || n != 2 {
+		t.Fatalf("RPush = %d, %v, want 2", n, err)
+	}
+	if n, err := s.LPush("l", "a", "z"); err != nil || n != 4 {
+		t.Fatalf("LPush = %d, %v, want 4", n, err)
+	}
+	want := []string{"z", "a", "b", "c"}
+	if got, err := s.LRange("l", 0, -1); err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("LRange = %v, %v, want %v", got, err, want)
+	}
+	if n, err := s.LLen("l"); err != nil || n != 4 {
+		t.Fatalf("LLen = %d, %v, want 4", n, err)
+	}
+	if v, err := s.LIndex("l", -1); err != nil || v != "c" {
+		t.Fatalf("LIndex(-1) = %q, %v, want c", v, err)
+	}
+	if _, err := s.LIndex("l", 4); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("LIndex(4) = %v, want %v", err, ErrIndexOutOfRange)
+	}
+
+	if got, err := s.LPop("l", 1); err != nil || !reflect.DeepEqual(got, []string{"z"}) {
+		t.Fatalf("LPop = %v, %v, want [z]", got, err)
+	}
+	if got, err := s.RPop("l", 5); err != nil || !reflect.DeepEqual(got, []string{"c", "b", "a"}) {
+		t.Fatalf("RPop = %v, %v, want [c b a]", got, err)
+	}
+	if got, err := s.LPop("l", 1); err != nil || len(got) != 0 {
+		t.Fatalf("LPop(empty) = %v, %v, want nothing", got, err)
+	}
+	if _, err := s.LIndex("l", 0); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("the emptied list was not deleted: %v", err)
+	}
+
+	if _, err := s.RPush("t", "a", "b", "c", "d"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.LTrim("t", 1, -2); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.LRange("t", 0, -1); err != nil || !reflect.DeepEqual(got, []string{"b", "c"}) {
+		t.Fatalf("LRange after LTrim = %v, %v, want [b c]", got, err)
+	}
+	if err := s.LTrim("t", 5, 10); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.LLen("t"); err != nil || n != 0 {
+		t.Fatalf("LLen after trimming everything = %d, %v, want 0", n, err)
+	}
+
+	if err := s.Set("str", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RPush("str", "a"); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("RPush on a string = %v, want %v", err, ErrWrongType)
+	}
+	if _, err := s.LRange("str", 0, -1); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("LRange on a string = %v, want %v", err, ErrWrongType)
+	}
+}
+
+func TestListRange(t *testing.T) {
+	tests := []struct {
+		start, stop int64
+		n           int
+		lo, hi      int
+	}{
+		{0, -1, 5, 0, 5},
+		{1, 2, 5, 1, 3},
+		{-2, -1, 5, 3, 5},
+		{-10, 10, 5, 0, 5},
+		{3, 1, 5, 0, 0},
+		{5, 10, 5, 0, 0},
+		{0, -1, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		if lo, hi := listRange(tt.start, tt.stop, tt.n); lo != tt.lo || hi != tt.hi {
+			t.Errorf("listRange(%d, %d, %d) = [%d, %d), want [%d, %d)", tt.start, tt.stop, tt.n, lo, hi, tt.lo, tt.hi)
+		}
+	}
+}
+
+func TestBLPop(t *testing.T) {
+	s := openStore(t)
+
+	if _, err := s.RPush("b", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if key, value, err := s.BLPop(context.Background(), []string{"a", "b"}, time.Second); err != nil || key != "b" || value != "x" {
+		t.Fatalf("BLPop = %q, %q, %v, want b x", key, value, err)
+	}
+
+	// A waiter is woken by a later push.
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		s.RPush("a", "y")
+	}()
+	if key, value, err := s.BRPop(context.Background(), []string{"a", "b"}, 5*time.Second); err != nil || key != "a" || value != "y" {
+		t.Fatalf("BRPop = %q, %q, %v, want a y", key, value, err)
+	}
+
+	if key, _, err := s.BLPop(context.Background(), []string{"a"}, 50*time.Millisecond); err != nil || key != "" {
+		t.Fatalf("BLPop after the timeout = %q, %v, want nothing", key, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, _, err := s.BLPop(ctx, []string{"a"}, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("BLPop with a cancelled context = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+// TestBLPopOnce checks that an element pushed while several clients wait is
+// handed to exactly one of them.
+func TestBLPopOnce(t *testing.T) {
+	s := openStore(t)
+
+	const waiters = 3
+	results := make(chan string, waiters)
+	for i := 0; i < waiters; i++ {
+		go func() {
+			_, value, err := s.BLPop(context.Background(), []string{"q"}, 500*time.Millisecond)
+			if err != nil {
+				t.Error(err)
+			}
+			results <- value
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	if _, err := s.RPush("q", "only"); err != nil {
+		t.Fatal(err)
+	}
+
+	got := 0
+	for i := 0; i < waiters; i++ {
+		if <-results == "only" {
+			got++
+		}
+	}
+	if got != 1 {
+		t.Fatalf("the element was popped %d times, want 1", got)
+	}
+}
+
+// TestBLPopIdle checks that a waiter on empty lists does not write to the
+// Raft log.
+func TestBLPopIdle(t *testing.T) {
+	s := openStore(t)
+	if err := s.Set("str", "v"); err != nil {
+		t.Fatal(err)
+	}
+
+	before := s.raft.LastIndex()
+	if key, _, err := s.BLPop(context.Background(), []string{"a", "b"}, 100*time.Millisecond); err != nil || key != "" {
+		t.Fatalf("BLPop = %q, %v, want nothing", key, err)
+	}
+	if after := s.raft.LastIndex(); after != before {
+		t.Fatalf("an idle BLPop advanced the log from %d to %d", before, after)
+	}
+	if _, _, err := s.BLPop(context.Background(), []string{"str"}, time.Second); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("BLPop on a string = %v, want %v", err, ErrWrongType)
+	}
+}
+
+// TestBLPopNotLeader checks that a blocking pop on a node that is not the
+// leader fails at once instead of waiting for a push it cannot pop.
+func TestBLPopNotLeader(t *testing.T) {
+	s := openStore(t)
+	if err := s.raft.Shutdown().Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if _, _, err := s.BLPop(ctx, []string{"a"}, 0); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("BLPop on a follower = %v, want an immediate error", err)
+	}
+}
+
+// TestBlockingRestore checks that restoring a snapshot wakes blocking pops
+// and stream reads whose keys it fills.
+func TestBlockingRestore(t *testing.T) {
+	src := openStore(t)
+	if _, err := src.RPush("list", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.XAdd("stream", "1-1", map[string]string{"f": "v"}, -1); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.XGroupCreate("stream", "g", "0", false); err != nil {
+		t.Fatal(err)
+	}
+	snapshot := persist(t, src)
+
+	s := openStore(t)
+	if err := s.XGroupCreate("stream", "g", "$", true); err != nil {
+		t.Fatal(err)
+	}
+	popped := make(chan string, 1)
+	go func() {
+		_, value, err := s.BLPop(context.Background(), []string{"list"}, 5*time.Second)
+		if err != nil {
+			t.Error(err)
+		}
+		popped <- value
+	}()
+	read := make(chan int, 1)
+	go func() {
+		entries, err := s.XReadGroupBlock(context.Background(), "g", "c", "stream", XReadGroupOptions{}, 5*time.Second)
+		if err != nil {
+			t.Error(err)
+		}
+		read <- len(entries)
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	if err := (*fsm)(s).Restore(io.NopCloser(bytes.NewReader(snapshot))); err != nil {
+		t.Fatal(err)
+	}
+	if got := <-popped; got != "x" {
+		t.Errorf("BLPop after restore = %q, want x", got)
+	}
+	if got := <-read; got != 1 {
+		t.Errorf("XReadGroupBlock after restore read %d entries, want 1", got)
+	}
+}
@@ -0,0 +1,396 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// ErrInvalidCount is returned when SRANDMEMBER is asked for more members
+// than maxRandMembers.
+var ErrInvalidCount = errors.New("count is out of range")
+
+// maxRandMembers bounds the count of SRANDMEMBER in either direction, since
+// a negative count allocates -count members regardless of the set size.
+const maxRandMembers = 1000
+
+// setValue is the value of a set key.
+type setValue map[string]struct{}
+
+func (s setValue) clone() setValue {
+	return maps.Clone(s)
+}
+
+// MarshalJSON encodes the set as a sorted array of members.
+func (s setValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.sorted())
+}
+
+func (s *setValue) UnmarshalJSON(b []byte) error {
+	var members []string
+	if err := json.Unmarshal(b, &members); err != nil {
+		return err
+	}
+	*s = make(setValue, len(members))
+	for _, m := range members {
+		(*s)[m] = struct{}{}
+	}
+	return nil
+}
+
+// sorted returns the members of the set in ascending order.
+func (s setValue) sorted() []string {
+	members := make([]string, 0, len(s))
+	for m := range s {
+		members = append(members, m)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// SAdd adds members to the set stored at key, creating the set if it does
+// not exist. It returns the number of members that were added.
+func (s *Store) SAdd(key string, members ...string) (int, error) {
+	resp, err := s.apply(&command{
+		Op:     "sadd",
+		Key:    key,
+		Values: members,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// SRem removes members from the set stored at key and returns the number of
+// members that were removed. The key is deleted once the set is empty.
+func (s *Store) SRem(key string, members ...string) (int, error) {
+	resp, err := s.apply(&command{
+		Op:     "srem",
+		Key:    key,
+		Values: members,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// SIsMember reports whether member belongs to the set stored at key.
+func (s *Store) SIsMember(key, member string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, err := s.set(key)
+	if err != nil {
+		return false, err
+	}
+	_, ok := set[member]
+	return ok, nil
+}
+
+// SMembers returns the members of the set stored at key in ascending order.
+func (s *Store) SMembers(key string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, err := s.set(key)
+	if err != nil {
+		return nil, err
+	}
+	return set.sorted(), nil
+}
+
+// SCard returns the number of members of the set stored at key.
+func (s *Store) SCard(key string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, err := s.set(key)
+	if err != nil {
+		return 0, err
+	}
+	return len(set), nil
+}
+
+// SPop removes and returns up to count random members from the set stored
+// at key. The members are chosen by the FSM with a seed derived from the log
+// index, so every replica removes the same ones.
+func (s *Store) SPop(key string, count int) ([]string, error) {
+	resp, err := s.apply(&command{
+		Op:    "spop",
+		Key:   key,
+		Count: count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]string), nil
+}
+
+// SRandMember returns random members of the set stored at key without
+// removing them. A positive count returns up to count distinct members, a
+// negative count returns exactly -count members that may repeat. The count
+// must be within maxRandMembers of zero.
+func (s *Store) SRandMember(key string, count int) ([]string, error) {
+	if count < -maxRandMembers || count > maxRandMembers {
+		return nil, ErrInvalidCount
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, err := s.set(key)
+	if err != nil || len(set) == 0 {
+		return nil, err
+	}
+	members := set.sorted()
+	if count < 0 {
+		picked := make([]string, -count)
+		for i := range picked {
+			picked[i] = members[rand.Intn(len(members))]
+		}
+		return picked, nil
+	}
+	rand.Shuffle(len(members), func(i, j int) {
+		members[i], members[j] = members[j], members[i]
+	})
+	return members[:min(count, len(members))], nil
+}
+
+// SInter returns the members of the intersection of the sets stored at keys.
+func (s *Store) SInter(keys ...string) ([]string, error) {
+	return s.setAlgebra(setInter, keys)
+}
+
+// SUnion returns the members of the union of the sets stored at keys.
+func (s *Store) SUnion(keys ...string) ([]string, error) {
+	return s.setAlgebra(setUnion, keys)
+}
+
+// SDiff returns the members of the first set that are in none of the others.
+func (s *Store) SDiff(keys ...string) ([]string, error) {
+	return s.setAlgebra(setDiff, keys)
+}
+
+// SInterStore stores the intersection of the sets stored at keys in
+// destination and returns its size.
+func (s *Store) SInterStore(destination string, keys ...string) (int, error) {
+	return s.setAlgebraStore("sinterstore", destination, keys)
+}
+
+// SUnionStore stores the union of the sets stored at keys in destination and
+// returns its size.
+func (s *Store) SUnionStore(destination string, keys ...string) (int, error) {
+	return s.setAlgebraStore("sunionstore", destination, keys)
+}
+
+// SDiffStore stores the difference of the sets stored at keys in destination
+// and returns its size.
+func (s *Store) SDiffStore(destination string, keys ...string) (int, error) {
+	return s.setAlgebraStore("sdiffstore", destination, keys)
+}
+
+func (s *Store) setAlgebra(op func([]setValue) setValue, keys []string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sets := make([]setValue, len(keys))
+	for i, key := range keys {
+		set, err := s.set(key)
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = set
+	}
+	return op(sets).sorted(), nil
+}
+
+// setAlgebraStore computes and stores a set operation in a single Raft entry,
+// so the result reflects a consistent view of the source sets.
+func (s *Store) setAlgebraStore(op, destination string, keys []string) (int, error) {
+	resp, err := s.apply(&command{
+		Op:   op,
+		Key:  destination,
+		Keys: keys,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// set returns the set stored at key, or nil if the key does not exist. It
+// must be called with the lock held.
+func (s *Store) set(key string) (setValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	set, ok := item.value.(setValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return set, nil
+}
+
+func setInter(sets []setValue) setValue {
+	out := setValue{}
+	if len(sets) == 0 {
+		return out
+	}
+	for m := range sets[0] {
+		in := true
+		for _, other := range sets[1:] {
+			if _, ok := other[m]; !ok {
+				in = false
+				break
+			}
+		}
+		if in {
+			out[m] = struct{}{}
+		}
+	}
+	return out
+}
+
+func setUnion(sets []setValue) setValue {
+	out := setValue{}
+	for _, set := range sets {
+		for m := range set {
+			out[m] = struct{}{}
+		}
+	}
+	return out
+}
+
+func setDiff(sets []setValue) setValue {
+	out := setValue{}
+	if len(sets) == 0 {
+		return out
+	}
+	for m := range sets[0] {
+		out[m] = struct{}{}
+	}
+	for _, other := range sets[1:] {
+		for m := range other {
+			delete(out, m)
+		}
+	}
+	return out
+}
+
+func (f *fsm) applySAdd(key string, members []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: setValue{}}
+	}
+	set, ok := item.value.(setValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	added := 0
+	for _, m := range members {
+		if _, ok := set[m]; !ok {
+			set[m] = struct{}{}
+			added++
+		}
+	}
+	if len(set) > 0 {
+		f.cache.Add(key, item)
+	}
+	return added
+}
+
+func (f *fsm) applySRem(key string, members []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return 0
+	}
+	set, ok := item.value.(setValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	removed := 0
+	for _, m := range members {
+		if _, ok := set[m]; ok {
+			delete(set, m)
+			removed++
+		}
+	}
+	if len(set) == 0 {
+		f.cache.Remove(key)
+	}
+	return removed
+}
+
+func (f *fsm) applySPop(key string, count int, seed uint64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return []string{}
+	}
+	set, ok := item.value.(setValue)
+	if !ok {
+		return ErrWrongType
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	// Shuffle the sorted members with a seed taken from the log so every
+	// replica pops the same members.
+	members := set.sorted()
+	r := rand.New(rand.NewSource(int64(seed)))
+	r.Shuffle(len(members), func(i, j int) {
+		members[i], members[j] = members[j], members[i]
+	})
+	popped := members[:min(count, len(members))]
+	for _, m := range popped {
+		delete(set, m)
+	}
+	if len(set) == 0 {
+		f.cache.Remove(key)
+	}
+	return popped
+}
+
+func (f *fsm) applySetStore(op func([]setValue) setValue, destination string, keys []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sets := make([]setValue, len(keys))
+	for i, key := range keys {
+		item, ok := f.lookup(key, now)
+		if !ok {
+			continue
+		}
+		set, ok := item.value.(setValue)
+		if !ok {
+			return ErrWrongType
+		}
+		sets[i] = set
+	}
+
+	// The destination is overwritten whatever its type, and loses its TTL.
+	result := op(sets)
+	if len(result) == 0 {
+		f.cache.Remove(destination)
+		return 0
+	}
+	f.cache.Add(destination, cacheItem{value: result})
+	return len(result)
+}
@@ -0,0 +1,187 @@
+package store
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSet(t *testing.T) {
+	s := openStore(t)
+
+	if n, err := s.SAdd("s", "a", "b", "a"); err != nil || n != 2 {
+		t.Fatalf("SAdd = %d, %v, want 2", n, err)
+	}
+	if n, err := s.SAdd("s", "b", "c"); err != nil || n != 1 {
+		t.Fatalf("SAdd = %d, %v, want 1", n, err)
+	}
+	if ok, err := s.SIsMember("s", "c"); err != nil || !ok {
+		t.Fatalf("SIsMember(c) = %v, %v, want true", ok, err)
+	}
+	if ok, err := s.SIsMember("s", "z"); err != nil || ok {
+		t.Fatalf("SIsMember(z) = %v, %v, want false", ok, err)
+	}
+	if got, err := s.SMembers("s"); err != nil || !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("SMembers = %v, %v, want [a b c]", got, err)
+	}
+	if n, err := s.SCard("s"); err != nil || n != 3 {
+		t.Fatalf("SCard = %d, %v, want 3", n, err)
+	}
+
+	if n, err := s.SRem("s", "a", "z"); err != nil || n != 1 {
+		t.Fatalf("SRem = %d, %v, want 1", n, err)
+	}
+	if n, err := s.SRem("s", "b", "c"); err != nil || n != 2 {
+		t.Fatalf("SRem = %d, %v, want 2", n, err)
+	}
+	if _, err := s.Get("s"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("the emptied set was not deleted: %v", err)
+	}
+
+	if err := s.Set("str", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SAdd("str", "a"); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("SAdd on a string = %v, want %v", err, ErrWrongType)
+	}
+	if _, err := s.SInter("str"); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("SInter on a string = %v, want %v", err, ErrWrongType)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	s := openStore(t)
+
+	if _, err := s.SAdd("x", "a", "b", "c"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SAdd("y", "b", "c", "d"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		call func() ([]string, error)
+		want []string
+	}{
+		{"inter", func() ([]string, error) { return s.SInter("x", "y") }, []string{"b", "c"}},
+		{"inter with missing", func() ([]string, error) { return s.SInter("x", "missing") }, []string{}},
+		{"union", func() ([]string, error) { return s.SUnion("x", "y", "missing") }, []string{"a", "b", "c", "d"}},
+		{"diff", func() ([]string, error) { return s.SDiff("x", "y") }, []string{"a"}},
+		{"diff of missing", func() ([]string, error) { return s.SDiff("missing", "x") }, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && !reflect.DeepEqual(got, tt.want)) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if n, err := s.SUnionStore("dst", "x"); err != nil || n != 3 {
+		t.Fatalf("SUnionStore = %d, %v, want 3", n, err)
+	}
+	// The destination is a copy, not the source set itself.
+	if _, err := s.SAdd("x", "e"); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.SCard("dst"); err != nil || n != 3 {
+		t.Fatalf("SCard(dst) = %d, %v, want 3", n, err)
+	}
+
+	if n, err := s.SInterStore("dst", "x", "y"); err != nil || n != 2 {
+		t.Fatalf("SInterStore = %d, %v, want 2", n, err)
+	}
+	if err := s.Set("str", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.SDiffStore("str", "x", "x"); err != nil || n != 0 {
+		t.Fatalf("SDiffStore = %d, %v, want 0", n, err)
+	}
+	if _, err := s.Get("str"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("an empty result did not delete the destination: %v", err)
+	}
+}
+
+func TestSPop(t *testing.T) {
+	s := openStore(t)
+
+	if _, err := s.SAdd("s", "a", "b", "c"); err != nil {
+		t.Fatal(err)
+	}
+	popped, err := s.SPop("s", 2)
+	if err != nil || len(popped) != 2 {
+		t.Fatalf("SPop = %v, %v, want 2 members", popped, err)
+	}
+	rest, err := s.SMembers("s")
+	if err != nil || len(rest) != 1 {
+		t.Fatalf("SMembers = %v, %v, want 1 member", rest, err)
+	}
+	all := append(popped, rest...)
+	sort.Strings(all)
+	if !reflect.DeepEqual(all, []string{"a", "b", "c"}) {
+		t.Fatalf("popped %v and kept %v, want a partition of [a b c]", popped, rest)
+	}
+
+	if got, err := s.SPop("s", 5); err != nil || len(got) != 1 {
+		t.Fatalf("SPop = %v, %v, want the last member", got, err)
+	}
+	if got, err := s.SPop("s", 1); err != nil || len(got) != 0 {
+		t.Fatalf("SPop(missing) = %v, %v, want nothing", got, err)
+	}
+}
+
+// TestApplySPop checks that the members popped depend only on the seed in
+// the log entry, so every replica pops the same ones.
+func TestApplySPop(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	var got [][]string
+	for i := 0; i < 2; i++ {
+		f := (*fsm)(New(true))
+		f.applySAdd("s", []string{"a", "b", "c", "d", "e"}, now)
+		got = append(got, f.applySPop("s", 2, 42, now).([]string))
+	}
+	if !reflect.DeepEqual(got[0], got[1]) {
+		t.Fatalf("replicas popped %v and %v", got[0], got[1])
+	}
+}
+
+func TestSRandMember(t *testing.T) {
+	s := openStore(t)
+	if _, err := s.SAdd("set", "a", "b", "c"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		count   int
+		want    int
+		wantErr error
+	}{
+		{"one", 1, 1, nil},
+		{"more than the set", 10, 3, nil},
+		{"repeating", -5, 5, nil},
+		{"largest repeating", -maxRandMembers, maxRandMembers, nil},
+		{"too many repeating", -maxRandMembers - 1, 0, ErrInvalidCount},
+		{"too many distinct", maxRandMembers + 1, 0, ErrInvalidCount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.SRandMember("set", tt.count)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != tt.want {
+				t.Fatalf("got %d members, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
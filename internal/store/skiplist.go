@@ -0,0 +1,225 @@
+package store
+
+import "math/rand"
+
+const (
+	skiplistMaxLevel = 32   // Enough for 2^64 elements
+	skiplistP        = 0.25 // Probability of promoting a node to the next level
+)
+
+// skiplist keeps the members of a sorted set ordered by score, then by
+// member. Each link records how many nodes it spans, so ranks can be
+// computed in logarithmic time. The shape of the list depends on random
+// levels, but its order does not, so replicas always agree on the results.
+type skiplist struct {
+	header *skiplistNode
+	tail   *skiplistNode
+	length int
+	level  int
+}
+
+type skiplistNode struct {
+	member   string
+	score    float64
+	backward *skiplistNode
+	level    []skiplistLevel
+}
+
+type skiplistLevel struct {
+	forward *skiplistNode
+	span    int
+}
+
+func newSkiplist() *skiplist {
+	return &skiplist{
+		header: &skiplistNode{level: make([]skiplistLevel, skiplistMaxLevel)},
+		level:  1,
+	}
+}
+
+func randomLevel() int {
+	level := 1
+	for level < skiplistMaxLevel && rand.Float64() < skiplistP {
+		level++
+	}
+	return level
+}
+
+// before reports whether the node sorts before the given score and member.
+func (n *skiplistNode) before(score float64, member string) bool {
+	return n.score < score || (n.score == score && n.member < member)
+}
+
+// clone copies the list node by node, keeping the level and spans of every
+// node, so it takes linear time.
+func (z *skiplist) clone() *skiplist {
+	c := newSkiplist()
+	c.length, c.level = z.length, z.level
+
+	var last [skiplistMaxLevel]*skiplistNode // Last node copied at each level
+	for i := range last {
+		c.header.level[i].span = z.header.level[i].span
+		last[i] = c.header
+	}
+	var prev *skiplistNode
+	for x := z.header.level[0].forward; x != nil; x = x.level[0].forward {
+		n := &skiplistNode{member: x.member, score: x.score, backward: prev, level: make([]skiplistLevel, len(x.level))}
+		for i := range x.level {
+			n.level[i].span = x.level[i].span
+			last[i].level[i].forward = n
+			last[i] = n
+		}
+		prev = n
+	}
+	c.tail = prev
+	return c
+}
+
+// insert adds a member, which must not already be in the list.
+func (z *skiplist) insert(score float64, member string) {
+	var update [skiplistMaxLevel]*skiplistNode
+	var rank [skiplistMaxLevel]int
+
+	x := z.header
+	for i := z.level - 1; i >= 0; i-- {
+		if i < z.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.level[i].forward != nil && x.level[i].forward.before(score, member) {
+			rank[i] += x.level[i].span
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	level := randomLevel()
+	if level > z.level {
+		for i := z.level; i < level; i++ {
+			update[i] = z.header
+			update[i].level[i].span = z.length
+		}
+		z.level = level
+	}
+
+	x = &skiplistNode{member: member, score: score, level: make([]skiplistLevel, level)}
+	for i := 0; i < level; i++ {
+		x.level[i].forward = update[i].level[i].forward
+		update[i].level[i].forward = x
+		x.level[i].span = update[i].level[i].span - (rank[0] - rank[i])
+		update[i].level[i].span = rank[0] - rank[i] + 1
+	}
+	for i := level; i < z.level; i++ {
+		update[i].level[i].span++
+	}
+
+	if update[0] != z.header {
+		x.backward = update[0]
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x
+	} else {
+		z.tail = x
+	}
+	z.length++
+}
+
+// delete removes a member and reports whether it was found.
+func (z *skiplist) delete(score float64, member string) bool {
+	var update [skiplistMaxLevel]*skiplistNode
+
+	x := z.header
+	for i := z.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && x.level[i].forward.before(score, member) {
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	x = x.level[0].forward
+	if x == nil || x.score != score || x.member != member {
+		return false
+	}
+
+	for i := 0; i < z.level; i++ {
+		if update[i].level[i].forward == x {
+			update[i].level[i].span += x.level[i].span - 1
+			update[i].level[i].forward = x.level[i].forward
+		} else {
+			update[i].level[i].span--
+		}
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x.backward
+	} else {
+		z.tail = x.backward
+	}
+	for z.level > 1 && z.header.level[z.level-1].forward == nil {
+		z.level--
+	}
+	z.length--
+	return true
+}
+
+// rank returns the 1-based rank of a member, or 0 if it is not in the list.
+func (z *skiplist) rank(score float64, member string) int {
+	rank := 0
+	x := z.header
+	for i := z.level - 1; i >= 0; i-- {
+		for next := x.level[i].forward; next != nil; next = x.level[i].forward {
+			if !next.before(score, member) && (next.score != score || next.member != member) {
+				break
+			}
+			rank += x.level[i].span
+			x = next
+		}
+		if x != z.header && x.member == member {
+			return rank
+		}
+	}
+	return 0
+}
+
+// byRank returns the node at the 1-based rank, or nil if it is out of range.
+func (z *skiplist) byRank(rank int) *skiplistNode {
+	traversed := 0
+	x := z.header
+	for i := z.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && traversed+x.level[i].span <= rank {
+			traversed += x.level[i].span
+			x = x.level[i].forward
+		}
+		if traversed == rank && x != z.header {
+			return x
+		}
+	}
+	return nil
+}
+
+// first returns the first node in the range, or nil if it is empty.
+func (z *skiplist) first(r zrange) *skiplistNode {
+	x := z.header
+	for i := z.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !r.aboveMin(x.level[i].forward) {
+			x = x.level[i].forward
+		}
+	}
+	x = x.level[0].forward
+	if x == nil || !r.belowMax(x) {
+		return nil
+	}
+	return x
+}
+
+// last returns the last node in the range, or nil if it is empty.
+func (z *skiplist) last(r zrange) *skiplistNode {
+	x := z.header
+	for i := z.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && r.belowMax(x.level[i].forward) {
+			x = x.level[i].forward
+		}
+	}
+	if x == z.header || !r.aboveMin(x) {
+		return nil
+	}
+	return x
+}
@@ -0,0 +1,148 @@
+package store
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSkiplistRank(t *testing.T) {
+	z := newSkiplist()
+	var want []ZMember
+
+	// Insert and delete in random order, with repeated scores so ties are
+	// broken by member.
+	r := rand.New(rand.NewSource(1))
+	for _, i := range r.Perm(500) {
+		m := ZMember{Member: fmt.Sprintf("m%03d", i), Score: float64(i % 50)}
+		z.insert(m.Score, m.Member)
+		want = append(want, m)
+	}
+	for i := 0; i < len(want); i += 3 {
+		if !z.delete(want[i].Score, want[i].Member) {
+			t.Fatalf("delete %s: not found", want[i].Member)
+		}
+	}
+	if z.delete(-1, "missing") {
+		t.Fatal("deleted a missing member")
+	}
+	kept := want[:0]
+	for i, m := range want {
+		if i%3 != 0 {
+			kept = append(kept, m)
+		}
+	}
+	want = kept
+	sort.Slice(want, func(i, j int) bool {
+		return want[i].Score < want[j].Score || want[i].Score == want[j].Score && want[i].Member < want[j].Member
+	})
+
+	// A clone has the same order, ranks and backward links.
+	for _, z := range []*skiplist{z, z.clone()} {
+		if z.length != len(want) {
+			t.Fatalf("got length %d, want %d", z.length, len(want))
+		}
+		for i, m := range want {
+			if got := z.rank(m.Score, m.Member); got != i+1 {
+				t.Fatalf("rank of %s: got %d, want %d", m.Member, got, i+1)
+			}
+			n := z.byRank(i + 1)
+			if n == nil || n.member != m.Member {
+				t.Fatalf("byRank(%d): got %v, want %s", i+1, n, m.Member)
+			}
+		}
+		if got := z.rank(0, "missing"); got != 0 {
+			t.Fatalf("rank of missing member: got %d, want 0", got)
+		}
+		if n := z.byRank(0); n != nil {
+			t.Fatalf("byRank(0): got %s, want nil", n.member)
+		}
+		if n := z.byRank(len(want) + 1); n != nil {
+			t.Fatalf("byRank past the end: got %s, want nil", n.member)
+		}
+
+		// The backward links walk the same order in reverse.
+		i := len(want) - 1
+		for n := z.tail; n != nil; n = n.backward {
+			if n.member != want[i].Member {
+				t.Fatalf("backward walk at %d: got %s, want %s", i, n.member, want[i].Member)
+			}
+			i--
+		}
+		if i != -1 {
+			t.Fatalf("backward walk stopped %d members early", i+1)
+		}
+	}
+
+	// Changing a clone leaves the original untouched.
+	c := z.clone()
+	c.insert(-1, "new")
+	c.delete(want[0].Score, want[0].Member)
+	if z.length != len(want) || z.rank(want[0].Score, want[0].Member) != 1 || z.rank(-1, "new") != 0 {
+		t.Fatal("changing a clone changed the original")
+	}
+}
+
+func TestZSetCollect(t *testing.T) {
+	z := newZSet()
+	for _, m := range []ZMember{{"a", 1}, {"b", 2}, {"c", 2}, {"d", 3}, {"e", 5}} {
+		z.add(m.Member, m.Score)
+	}
+	lex := newZSet()
+	for _, m := range []string{"a", "b", "c", "d", "e"} {
+		lex.add(m, 0)
+	}
+
+	score := func(min, max string) zrange {
+		r, err := ParseScoreRange(min, max)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	lexical := func(min, max string) zrange {
+		r, err := ParseLexRange(min, max)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	tests := []struct {
+		name          string
+		z             *zsetValue
+		r             zrange
+		rev           bool
+		offset, count int
+		want          string
+	}{
+		{"all", z, score("-inf", "+inf"), false, 0, -1, "a b c d e"},
+		{"inclusive", z, score("2", "3"), false, 0, -1, "b c d"},
+		{"exclusive", z, score("(1", "(3"), false, 0, -1, "b c"},
+		{"reverse", z, score("2", "5"), true, 0, -1, "e d c b"},
+		{"offset and count", z, score("-inf", "+inf"), false, 1, 2, "b c"},
+		{"reverse offset", z, score("-inf", "+inf"), true, 3, -1, "b a"},
+		{"offset past the end", z, score("-inf", "+inf"), false, 5, -1, ""},
+		{"zero count", z, score("-inf", "+inf"), false, 0, 0, ""},
+		{"empty", z, score("4", "4.5"), false, 0, -1, ""},
+		{"inverted", z, score("3", "1"), false, 0, -1, ""},
+		{"lex all", lex, lexical("-", "+"), false, 0, -1, "a b c d e"},
+		{"lex inclusive", lex, lexical("[b", "[d"), false, 0, -1, "b c d"},
+		{"lex exclusive", lex, lexical("(b", "(d"), false, 0, -1, "c"},
+		{"lex reverse", lex, lexical("[c", "+"), true, 0, -1, "e d c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, m := range tt.z.collect(tt.r, tt.rev, tt.offset, tt.count) {
+				got = append(got, m.Member)
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
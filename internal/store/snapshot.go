@@ -0,0 +1,335 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// snapshotVersion is the version of the snapshot format written by Persist.
+// Snapshots without a version are the legacy key -> value map.
+const snapshotVersion = 1
+
+const (
+	typeString     = "string"
+	typeHash       = "hash"
+	typeList       = "list"
+	typeSet        = "set"
+	typeZSet       = "zset"
+	typeStream     = "stream"
+	typeHLL        = "hyperloglog"
+	typeJSON       = "json"
+	typeBloom      = "bloom"
+	typeCuckoo     = "cuckoo"
+	typeCMS        = "cms"
+	typeTopK       = "topk"
+	typeTDigest    = "tdigest"
+	typeTimeSeries = "timeseries"
+)
+
+// snapshotData is the versioned snapshot format. Entries are ordered from
+// least to most recently used so Restore rebuilds the same LRU order.
+type snapshotData struct {
+	Version int             `json:"version"`
+	Entries []snapshotEntry `json:"entries"`
+}
+
+// snapshotEntry holds a single key together with its type and expiration.
+type snapshotEntry struct {
+	Key        string          `json:"key"`
+	Type       string          `json:"type"`
+	Value      json.RawMessage `json:"value"`
+	Expiration int64           `json:"expiration,omitempty"` // absolute deadline in Unix nanoseconds
+}
+
+// Snapshot returns a snapshot of the key-value store.
+func (f *fsm) Snapshot() (raft.FSMSnapshot, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Values are modified in place by the FSM, so copy them while writes are
+	// blocked and leave the encoding to Persist, which runs concurrently with
+	// them.
+	keys := f.cache.Keys()
+	items := make([]cacheItem, 0, len(keys))
+	for i, key := range keys {
+		item, ok := f.cache.Peek(key)
+		if !ok {
+			continue
+		}
+		keys[len(items)] = keys[i]
+		items = append(items, item.clone())
+	}
+	return &fsmSnapshot{keys: keys[:len(items)], items: items}, nil
+}
+
+// Restore stores the key-value store to a previous state.
+func (f *fsm) Restore(rc io.ReadCloser) error {
+	defer rc.Close()
+
+	entries, err := readSnapshot(rc)
+	if err != nil {
+		return err
+	}
+
+	items := make([]cacheItem, len(entries))
+	for i, entry := range entries {
+		if items[i], err = decodeEntry(entry); err != nil {
+			return err
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.cache.Purge() // Clear the existing cache
+	for i, entry := range entries {
+		f.cache.Add(entry.Key, items[i])
+		f.trackDeadline(entry.Key, items[i])
+	}
+	f.wakeAll() // Any waiting key may now hold data
+	return nil
+}
+
+// readSnapshot decodes a snapshot written by Persist. Legacy snapshots are
+// converted to entries without an expiration.
+func readSnapshot(r io.Reader) ([]snapshotEntry, error) {
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	// Legacy snapshots map keys to strings, so a numeric version field can
+	// only belong to the versioned format.
+	if v, ok := raw["version"]; !ok || len(v) == 0 || v[0] == '"' {
+		entries := make([]snapshotEntry, 0, len(raw))
+		for k, v := range raw {
+			var value string
+			if err := json.Unmarshal(v, &value); err != nil {
+				return nil, err
+			}
+			b, err := json.Marshal([]byte(value))
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, snapshotEntry{Key: k, Type: typeString, Value: b})
+		}
+		return entries, nil
+	}
+
+	var version int
+	if err := json.Unmarshal(raw["version"], &version); err != nil {
+		return nil, err
+	}
+	if version > snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", version)
+	}
+
+	var entries []snapshotEntry
+	if err := json.Unmarshal(raw["entries"], &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// clone returns a copy of the item that later writes to the original
+// cannot change.
+func (i cacheItem) clone() cacheItem {
+	switch v := i.value.(type) {
+	case hashValue:
+		i.value = v.clone()
+	case *listValue:
+		i.value = v.clone()
+	case setValue:
+		i.value = v.clone()
+	case *zsetValue:
+		i.value = v.clone()
+	case *streamValue:
+		i.value = v.clone()
+	case *hllValue:
+		i.value = v.clone()
+	case *jsonValue:
+		i.value = v.clone()
+	case *bloomValue:
+		i.value = v.clone()
+	case *cuckooValue:
+		i.value = v.clone()
+	case *cmsValue:
+		i.value = v.clone()
+	case *topKValue:
+		i.value = v.clone()
+	case *tdigestValue:
+		i.value = v.clone()
+	case *tsValue:
+		i.value = v.clone()
+	}
+	return i
+}
+
+// encodeEntry converts a cache item to its snapshot representation.
+func encodeEntry(key string, item cacheItem) (snapshotEntry, error) {
+	entry := snapshotEntry{Key: key}
+	if !item.expiration.IsZero() {
+		entry.Expiration = item.expiration.UnixNano()
+	}
+
+	var value interface{}
+	switch v := item.value.(type) {
+	case string:
+		// Strings are encoded as bytes so binary values survive the round trip.
+		entry.Type, value = typeString, []byte(v)
+	case hashValue:
+		entry.Type, value = typeHash, v
+	case *listValue:
+		entry.Type, value = typeList, v
+	case setValue:
+		entry.Type, value = typeSet, v
+	case *zsetValue:
+		entry.Type, value = typeZSet, v
+	case *streamValue:
+		entry.Type, value = typeStream, v
+	case *hllValue:
+		entry.Type, value = typeHLL, v
+	case *jsonValue:
+		entry.Type, value = typeJSON, v
+	case *bloomValue:
+		entry.Type, value = typeBloom, v
+	case *cuckooValue:
+		entry.Type, value = typeCuckoo, v
+	case *cmsValue:
+		entry.Type, value = typeCMS, v
+	case *topKValue:
+		entry.Type, value = typeTopK, v
+	case *tdigestValue:
+		entry.Type, value = typeTDigest, v
+	case *tsValue:
+		entry.Type, value = typeTimeSeries, v
+	default:
+		return snapshotEntry{}, fmt.Errorf("key %s: unsupported value %T", key, item.value)
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return snapshotEntry{}, err
+	}
+	entry.Value = b
+	return entry, nil
+}
+
+// decodeEntry converts a snapshot entry back to a cache item.
+func decodeEntry(entry snapshotEntry) (cacheItem, error) {
+	var item cacheItem
+	if entry.Expiration != 0 {
+		item.expiration = time.Unix(0, entry.Expiration)
+	}
+
+	var err error
+	switch entry.Type {
+	case typeString:
+		var b []byte
+		err = json.Unmarshal(entry.Value, &b)
+		item.value = string(b)
+	case typeHash:
+		var h hashValue
+		err = json.Unmarshal(entry.Value, &h)
+		item.value = h
+	case typeList:
+		l := &listValue{}
+		err = json.Unmarshal(entry.Value, l)
+		item.value = l
+	case typeSet:
+		var set setValue
+		err = json.Unmarshal(entry.Value, &set)
+		item.value = set
+	case typeZSet:
+		z := newZSet()
+		err = json.Unmarshal(entry.Value, z)
+		item.value = z
+	case typeStream:
+		st := newStream()
+		err = json.Unmarshal(entry.Value, st)
+		item.value = st
+	case typeHLL:
+		h := &hllValue{}
+		err = json.Unmarshal(entry.Value, h)
+		item.value = h
+	case typeJSON:
+		d := &jsonValue{}
+		err = json.Unmarshal(entry.Value, d)
+		item.value = d
+	case typeBloom:
+		bf := &bloomValue{}
+		err = json.Unmarshal(entry.Value, bf)
+		item.value = bf
+	case typeCuckoo:
+		cf := &cuckooValue{}
+		err = json.Unmarshal(entry.Value, cf)
+		item.value = cf
+	case typeCMS:
+		c := &cmsValue{}
+		err = json.Unmarshal(entry.Value, c)
+		item.value = c
+	case typeTopK:
+		t := &topKValue{}
+		err = json.Unmarshal(entry.Value, t)
+		item.value = t
+	case typeTDigest:
+		t := &tdigestValue{}
+		err = json.Unmarshal(entry.Value, t)
+		item.value = t
+	case typeTimeSeries:
+		ts := &tsValue{}
+		err = json.Unmarshal(entry.Value, ts)
+		item.value = ts
+	default:
+		err = fmt.Errorf("unknown type %q", entry.Type)
+	}
+	if err != nil {
+		return cacheItem{}, fmt.Errorf("key %s: %s", entry.Key, err)
+	}
+	return item, nil
+}
+
+// fsmSnapshot holds copies of the cache items, from least to most recently
+// used, taken when the snapshot was started.
+type fsmSnapshot struct {
+	keys  []string
+	items []cacheItem
+}
+
+func (f *fsmSnapshot) Persist(sink raft.SnapshotSink) error {
+	err := func() error {
+		// Encode data.
+		data := &snapshotData{Version: snapshotVersion}
+		for i, key := range f.keys {
+			entry, err := encodeEntry(key, f.items[i])
+			if err != nil {
+				return err
+			}
+			data.Entries = append(data.Entries, entry)
+		}
+		b, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		// Write data to sink.
+		if _, err := sink.Write(b); err != nil {
+			return err
+		}
+
+		// Close the sink.
+		return sink.Close()
+	}()
+
+	if err != nil {
+		sink.Cancel()
+	}
+
+	return err
+}
+
+func (f *fsmSnapshot) Release() {}
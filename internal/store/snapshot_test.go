@@ -0,0 +1,303 @@
+package store
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// bufferSink is an in-memory raft.SnapshotSink.
+type bufferSink struct {
+	bytes.Buffer
+}
+
+func (b *bufferSink) ID() string    { return "test" }
+func (b *bufferSink) Cancel() error { return nil }
+func (b *bufferSink) Close() error  { return nil }
+
+var _ raft.SnapshotSink = (*bufferSink)(nil)
+
+// persist writes a snapshot of s and returns its bytes.
+func persist(t *testing.T, s *Store) []byte {
+	t.Helper()
+
+	snap, err := (*fsm)(s).Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sink bufferSink
+	if err := snap.Persist(&sink); err != nil {
+		t.Fatal(err)
+	}
+	return sink.Bytes()
+}
+
+// restore restores a snapshot into a new store that is not part of a
+// cluster, so it can be read but not written.
+func restore(t *testing.T, b []byte) *Store {
+	t.Helper()
+
+	s := New(true)
+	if err := (*fsm)(s).Restore(io.NopCloser(bytes.NewReader(b))); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	s := openStore(t)
+	deadline := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		key    string
+		typ    string
+		create func() error
+	}{
+		{"string", typeString, func() error {
+			if err := s.Set("string", "binary\x00\xff"); err != nil {
+				return err
+			}
+			_, err := s.ExpireAt("string", deadline)
+			return err
+		}},
+		{"persistent", typeString, func() error {
+			return s.Set("persistent", "v")
+		}},
+		{"hash", typeHash, func() error {
+			_, err := s.HSet("hash", map[string]string{"a": "1", "b": "2"})
+			return err
+		}},
+		{"list", typeList, func() error {
+			_, err := s.RPush("list", "a", "b", "c")
+			return err
+		}},
+		{"set", typeSet, func() error {
+			_, err := s.SAdd("set", "a", "b")
+			return err
+		}},
+		{"zset", typeZSet, func() error {
+			_, err := s.ZAdd("zset", []ZMember{{"a", 1}, {"b", 2}, {"c", 2}}, ZAddOptions{})
+			return err
+		}},
+		{"stream", typeStream, func() error {
+			if _, err := s.XAdd("stream", "1-1", map[string]string{"f": "v"}, -1); err != nil {
+				return err
+			}
+			if err := s.XGroupCreate("stream", "g", "0", false); err != nil {
+				return err
+			}
+			_, err := s.XReadGroup("g", "c", "stream", ">", XReadGroupOptions{})
+			return err
+		}},
+		{"hyperloglog", typeHLL, func() error {
+			_, err := s.PFAdd("hyperloglog", "a", "b", "c")
+			return err
+		}},
+		{"json", typeJSON, func() error {
+			_, err := s.JSONSet("json", "$", `{"a":[1,2,{"b":null}]}`, false, false)
+			return err
+		}},
+		{"bloom", typeBloom, func() error {
+			_, err := s.BFAdd("bloom", "a", "b")
+			return err
+		}},
+		{"cuckoo", typeCuckoo, func() error {
+			return s.CFAdd("cuckoo", "a", "b")
+		}},
+		{"cms", typeCMS, func() error {
+			if err := s.CMSInitByDim("cms", 100, 4); err != nil {
+				return err
+			}
+			_, err := s.CMSIncrBy("cms", []CMSIncrement{{"a", 3}})
+			return err
+		}},
+		{"topk", typeTopK, func() error {
+			if err := s.TopKReserve("topk", 3, 8, 7, 0.9); err != nil {
+				return err
+			}
+			_, err := s.TopKAdd("topk", "a", "a", "b")
+			return err
+		}},
+		{"tdigest", typeTDigest, func() error {
+			if err := s.TDigestCreate("tdigest", 100); err != nil {
+				return err
+			}
+			return s.TDigestAdd("tdigest", 1, 2, 3)
+		}},
+		{"timeseries", typeTimeSeries, func() error {
+			_, err := s.TSAdd("timeseries", time.Now().UnixMilli(), 1.5, TSOptions{
+				Retention: time.Hour,
+				Labels:    map[string]string{"sensor": "a"},
+			})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		if err := tt.create(); err != nil {
+			t.Fatalf("create %s: %v", tt.key, err)
+		}
+	}
+
+	restored := restore(t, persist(t, s))
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			want := snapshotOf(t, s, tt.key)
+			got := snapshotOf(t, restored, tt.key)
+			if got.Type != tt.typ {
+				t.Fatalf("got type %q, want %q", got.Type, tt.typ)
+			}
+			if got.Expiration != want.Expiration {
+				t.Fatalf("got expiration %d, want %d", got.Expiration, want.Expiration)
+			}
+			if !bytes.Equal(got.Value, want.Value) {
+				t.Fatalf("got value %s, want %s", got.Value, want.Value)
+			}
+		})
+	}
+
+	if got, want := restored.cache.Keys(), s.cache.Keys(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got LRU order %v, want %v", got, want)
+	}
+	if rank, err := restored.ZRank("zset", "c", false); err != nil || rank != 2 {
+		t.Errorf("got rank %d, error %v, want 2", rank, err)
+	}
+	if exp, err := restored.ExpireTime("string"); err != nil || !exp.Equal(deadline) {
+		t.Errorf("got expiration %v, error %v, want %v", exp, err, deadline)
+	}
+}
+
+// snapshotOf returns the snapshot entry of key in s.
+func snapshotOf(t *testing.T, s *Store, key string) snapshotEntry {
+	t.Helper()
+
+	s.mu.Lock()
+	item, ok := s.cache.Peek(key)
+	s.mu.Unlock()
+	if !ok {
+		t.Fatalf("key %s not found", key)
+	}
+	entry, err := encodeEntry(key, item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entry
+}
+
+// TestSnapshotIsolation checks that writes made after a snapshot is taken
+// do not change what it persists, for every type modified in place.
+func TestSnapshotIsolation(t *testing.T) {
+	s := openStore(t)
+	now := time.Now().UnixMilli()
+
+	steps := func(calls ...func() error) {
+		t.Helper()
+		for i, call := range calls {
+			if err := call(); err != nil {
+				t.Fatalf("call %d: %v", i, err)
+			}
+		}
+	}
+	steps(
+		func() error { _, err := s.HSet("hash", map[string]string{"a": "1"}); return err },
+		func() error { _, err := s.RPush("list", "a", "b"); return err },
+		func() error { _, err := s.SAdd("set", "a"); return err },
+		func() error { _, err := s.ZAdd("zset", []ZMember{{"a", 1}}, ZAddOptions{}); return err },
+		func() error { _, err := s.XAdd("stream", "1-1", map[string]string{"f": "v"}, -1); return err },
+		func() error { return s.XGroupCreate("stream", "g", "0", false) },
+		func() error { _, err := s.XReadGroup("g", "c", "stream", ">", XReadGroupOptions{}); return err },
+		func() error { _, err := s.PFAdd("hyperloglog", "a"); return err },
+		func() error { _, err := s.JSONSet("json", "$", `{"a":[1]}`, false, false); return err },
+		func() error { _, err := s.BFAdd("bloom", "a"); return err },
+		func() error { return s.CFAdd("cuckoo", "a") },
+		func() error { return s.CMSInitByDim("cms", 100, 4) },
+		func() error { return s.TopKReserve("topk", 3, 8, 7, 0.9) },
+		func() error { return s.TDigestCreate("tdigest", 100) },
+		func() error { _, err := s.TSAdd("timeseries", now, 1, TSOptions{}); return err },
+	)
+
+	want := persist(t, s)
+	snap, err := (*fsm)(s).Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps(
+		func() error { _, err := s.HSet("hash", map[string]string{"a": "2", "b": "2"}); return err },
+		func() error { _, err := s.RPush("list", "c"); return err },
+		func() error { _, err := s.LPop("list", 1); return err },
+		func() error { _, err := s.SAdd("set", "b"); return err },
+		func() error { _, err := s.ZAdd("zset", []ZMember{{"a", 5}, {"b", 2}}, ZAddOptions{}); return err },
+		func() error { _, err := s.XAdd("stream", "2-1", map[string]string{"f": "v"}, -1); return err },
+		func() error { _, err := s.XClaim("stream", "g", "d", 0, "1-1"); return err },
+		func() error { _, err := s.PFAdd("hyperloglog", "b", "c"); return err },
+		func() error { _, err := s.JSONSet("json", "$.a[0]", "2", false, false); return err },
+		func() error { _, err := s.BFAdd("bloom", "b"); return err },
+		func() error { return s.CFAdd("cuckoo", "b") },
+		func() error { _, err := s.CMSIncrBy("cms", []CMSIncrement{{"a", 3}}); return err },
+		func() error { _, err := s.TopKAdd("topk", "a"); return err },
+		func() error { return s.TDigestAdd("tdigest", 1, 2) },
+		func() error { _, err := s.TSAdd("timeseries", now+1, 2, TSOptions{}); return err },
+	)
+
+	var sink bufferSink
+	if err := snap.Persist(&sink); err != nil {
+		t.Fatal(err)
+	}
+	if got := sink.Bytes(); !bytes.Equal(got, want) {
+		t.Fatalf("got snapshot %s, want %s", got, want)
+	}
+}
+
+func TestSnapshotRestoreLegacy(t *testing.T) {
+	s := restore(t, []byte(`{"a":"1","b":"two","version":"3"}`))
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"a", "1"},
+		{"b", "two"},
+		{"version", "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, err := s.Get(tt.key)
+			if err != nil || got != tt.want {
+				t.Fatalf("got %q, error %v, want %q", got, err, tt.want)
+			}
+			if exp, err := s.ExpireTime(tt.key); err != nil || !exp.IsZero() {
+				t.Fatalf("got expiration %v, error %v, want none", exp, err)
+			}
+		})
+	}
+}
+
+func TestSnapshotRestoreRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"not JSON", `{"a":`},
+		{"future version", `{"version":2,"entries":[]}`},
+		{"unknown type", `{"version":1,"entries":[{"key":"a","type":"widget","value":"1"}]}`},
+		{"mistyped value", `{"version":1,"entries":[{"key":"a","type":"string","value":1}]}`},
+		{"legacy non-string", `{"a":1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(true)
+			s.cache.Add("kept", cacheItem{value: "v"})
+			if err := (*fsm)(s).Restore(io.NopCloser(strings.NewReader(tt.data))); err == nil {
+				t.Fatal("restore succeeded")
+			}
+			if _, ok := s.cache.Peek("kept"); !ok {
+				t.Fatal("failed restore modified the store")
+			}
+		})
+	}
+}
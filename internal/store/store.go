@@ -2,12 +2,14 @@ package store
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"log"
+	"math"
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
@@ -17,9 +19,40 @@ import (
 	raftboltdb "github.com/hashicorp/raft-boltdb/v2"
 )
 
+var (
+	// ErrKeyNotFound is returned when a key does not exist or has expired.
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrNotInteger is returned when a value cannot be used as an integer.
+	ErrNotInteger = errors.New("value is not an integer or out of range")
+	// ErrOverflow is returned when an increment would overflow an int64.
+	ErrOverflow = errors.New("increment or decrement would overflow")
+	// ErrNotFloat is returned when a value cannot be used as a float.
+	ErrNotFloat = errors.New("value is not a valid float")
+	// ErrNotFinite is returned when an increment would produce NaN or Infinity.
+	ErrNotFinite = errors.New("increment would produce NaN or Infinity")
+	// ErrWrongType is returned when a command is used on a key holding another type.
+	ErrWrongType = errors.New("WRONGTYPE Operation against a key holding the wrong kind of value")
+	// ErrKeyExists is returned when creating a key that already exists.
+	ErrKeyExists = errors.New("key already exists")
+)
+
 type cacheItem struct {
-	value      string
-	expiration time.Time
+	value      interface{} // string, or a container such as hashValue or *listValue, depending on the type of the key
+	expiration time.Time   // zero means the key never expires
+}
+
+// expired reports whether the item's deadline has passed at now.
+func (i cacheItem) expired(now time.Time) bool {
+	return !i.expiration.IsZero() && !now.Before(i.expiration)
+}
+
+// str returns the value of a string item.
+func (i cacheItem) str() (string, error) {
+	v, ok := i.value.(string)
+	if !ok {
+		return "", ErrWrongType
+	}
+	return v, nil
 }
 
 const (
@@ -28,33 +61,79 @@ const (
 )
 
 type command struct {
-	Op    string `json:"op,omitempty"`
-	Key   string `json:"key,omitempty"`
-	Value string `json:"value,omitempty"`
+	Op          string            `json:"op,omitempty"`
+	Key         string            `json:"key,omitempty"`
+	Keys        []string          `json:"keys,omitempty"`
+	Value       string            `json:"value,omitempty"`
+	Data        []byte            `json:"data,omitempty"`  // binary-safe value
+	Blobs       [][]byte          `json:"blobs,omitempty"` // binary-safe values
+	Values      []string          `json:"values,omitempty"`
+	Field       string            `json:"field,omitempty"`
+	Path        string            `json:"path,omitempty"`
+	Fields      []string          `json:"fields,omitempty"`
+	FieldValues map[string]string `json:"field_values,omitempty"`
+	Delta       int64             `json:"delta,omitempty"`
+	FloatDelta  float64           `json:"float_delta,omitempty"`
+	Count       int               `json:"count,omitempty"`
+	Start       int64             `json:"start,omitempty"`
+	Stop        int64             `json:"stop,omitempty"`
+	Scores      []float64         `json:"scores,omitempty"`
+	Flags       []string          `json:"flags,omitempty"`
+	ID          string            `json:"id,omitempty"`
+	IDs         []string          `json:"ids,omitempty"`
+	Group       string            `json:"group,omitempty"`
+	Consumer    string            `json:"consumer,omitempty"`
+	MaxLen      int64             `json:"max_len,omitempty"`
+	MinIdle     int64             `json:"min_idle,omitempty"` // milliseconds
+	Offset      int64             `json:"offset,omitempty"`
+	Bit         int               `json:"bit,omitempty"`
+	ErrorRate   float64           `json:"error_rate,omitempty"`
+	Capacity    int64             `json:"capacity,omitempty"`
+	Expansion   int               `json:"expansion,omitempty"`
+	Width       int               `json:"width,omitempty"`
+	Depth       int               `json:"depth,omitempty"`
+	Increments  []uint64          `json:"increments,omitempty"`
+	Decay       int               `json:"decay,omitempty"`
+	Compression float64           `json:"compression,omitempty"`
+	Samples     []float64         `json:"samples,omitempty"`
+	Timestamp   int64             `json:"timestamp,omitempty"` // Unix milliseconds
+	FloatValue  float64           `json:"float_value,omitempty"`
+	Retention   int64             `json:"retention,omitempty"`  // milliseconds
+	Expiration  int64             `json:"expiration,omitempty"` // absolute deadline in Unix nanoseconds
 }
 
 // Store is a simple key-value store, where all changes are made via Raft consensus.
 type Store struct {
-	RaftDir    string
-	RaftBind   string
-	inmem      bool
-	defaultTTL time.Duration
-	mu         sync.Mutex
-	cache      *lru.Cache[string, cacheItem] // LRU cache with expiration
-	raft       *raft.Raft                    // The consensus mechanism
+	RaftDir   string
+	RaftBind  string
+	BackupDir string
+	inmem     bool
+	mu        sync.Mutex
+	cache     *lru.Cache[string, cacheItem]         // LRU cache with expiration
+	raft      *raft.Raft                            // The consensus mechanism
+	waiters   map[string]map[chan struct{}]struct{} // Blocking pops waiting on each key
+	volatile  map[string]struct{}                   // Keys that may have a deadline, sampled by the expiry cycle
+	stop      chan struct{}                         // Closed by Close to stop the expiry cycle
+	applied   time.Time                             // Log time of the last applied entry
 
 	logger *log.Logger
 }
 
 // New returns a new Store.
 func New(inmem bool) *Store {
-	cache, _ := lru.New[string, cacheItem](1000) // Create an LRU cache with a capacity of 1000 items
-	return &Store{
-		cache:      cache,
-		defaultTTL: 24 * time.Hour,
-		inmem:      inmem,
-		logger:     log.New(os.Stderr, "[store] ", log.LstdFlags),
+	s := &Store{
+		inmem:    inmem,
+		waiters:  make(map[string]map[chan struct{}]struct{}),
+		volatile: make(map[string]struct{}),
+		stop:     make(chan struct{}),
+		logger:   log.New(os.Stderr, "[store] ", log.LstdFlags),
 	}
+	// Create an LRU cache with a capacity of 1000 items. Keys it drops no
+	// longer need to be sampled by the expiry cycle.
+	s.cache, _ = lru.NewWithEvict[string, cacheItem](1000, func(key string, _ cacheItem) {
+		delete(s.volatile, key)
+	})
+	return s
 }
 
 // Open opens the store. If enableSingle is set, and there are no existing peers,
@@ -117,60 +196,234 @@ func (s *Store) Open(enableSingle bool, localID string) error {
 		ra.BootstrapCluster(configuration)
 	}
 
+	go s.runExpireCycles()
+
 	return nil
 }
 
-// Get returns the value for the given key.
+// Close stops the active expiry cycle and shuts down Raft. It must be
+// called at most once, after Open.
+func (s *Store) Close() error {
+	close(s.stop)
+	return s.raft.Shutdown().Error()
+}
+
+// Get returns the value for the given key. Reads never modify the cache:
+// expired keys are removed by the leader through Raft, and recency is only
+// updated by the FSM so every replica evicts the same keys.
 func (s *Store) Get(key string) (string, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if item, ok := s.cache.Get(key); ok {
-		if time.Now().Before(item.expiration) {
-			return item.value, nil
-		}
-		s.cache.Remove(key) // Remove expired item
+	item, ok := s.peek(key)
+	if !ok {
+		return "", ErrKeyNotFound
+	}
+	return item.str()
+}
+
+// peek returns the item stored at key if it exists and has not expired,
+// without updating its recency. It must be called with the lock held, which
+// must also be held while reading values the FSM modifies in place.
+func (s *Store) peek(key string) (cacheItem, bool) {
+	item, ok := s.cache.Peek(key)
+	if !ok || item.expired(time.Now()) {
+		return cacheItem{}, false
 	}
-	return "", fmt.Errorf("key not found")
+	return item, true
 }
 
-// Set sets the value for the given key.
+// Set sets the value for the given key. The key does not expire.
 func (s *Store) Set(key, value string) error {
-	if s.raft.State() != raft.Leader {
-		return fmt.Errorf("not leader")
+	_, err := s.SetWithOptions(key, value, SetOptions{})
+	return err
+}
+
+// SetOptions controls when SetWithOptions stores a value and how the key
+// expires.
+type SetOptions struct {
+	NX       bool      // Only set the key if it does not exist
+	XX       bool      // Only set the key if it exists
+	Get      bool      // Return the previous value, which must be a string
+	Deadline time.Time // When the key expires; zero means it does not
+	KeepTTL  bool      // Keep the timeout of an existing key
+}
+
+// flags returns the options as command flags.
+func (o SetOptions) flags() []string {
+	var flags []string
+	for _, f := range []struct {
+		set  bool
+		name string
+	}{{o.NX, "nx"}, {o.XX, "xx"}, {o.Get, "get"}, {o.KeepTTL, "keepttl"}} {
+		if f.set {
+			flags = append(flags, f.name)
+		}
 	}
+	return flags
+}
+
+// SetResult is the outcome of SetWithOptions.
+type SetResult struct {
+	Set      bool   // Whether the value was stored
+	Previous string // Previous value of the key, with Get
+	Existed  bool   // Whether the key existed, with Get
+}
 
+// SetWithOptions sets the value for the given key like SET with options.
+// The NX and XX conditions are checked by the FSM, so at most one of several
+// concurrent SET NX calls on a key succeeds. A deadline in the past deletes
+// the key once it is set.
+func (s *Store) SetWithOptions(key, value string, opts SetOptions) (SetResult, error) {
 	c := &command{
 		Op:    "set",
 		Key:   key,
-		Value: value,
+		Data:  []byte(value),
+		Flags: opts.flags(),
 	}
-	b, err := json.Marshal(c)
+	if !opts.Deadline.IsZero() {
+		c.Expiration = opts.Deadline.UnixNano()
+	}
+	resp, err := s.apply(c)
 	if err != nil {
-		return err
+		return SetResult{}, err
 	}
+	return resp.(SetResult), nil
+}
 
-	f := s.raft.Apply(b, raftTimeout)
-	return f.Error()
+// Delete atomically deletes the given keys and returns how many of them
+// existed.
+func (s *Store) Delete(keys ...string) (int, error) {
+	resp, err := s.apply(&command{
+		Op:   "delete",
+		Keys: keys,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
 }
 
-// Delete deletes the given key.
-func (s *Store) Delete(key string) error {
-	if s.raft.State() != raft.Leader {
-		return fmt.Errorf("not leader")
+// Incr atomically increments the integer value of the given key by one and
+// returns the new value. A missing key is treated as 0.
+func (s *Store) Incr(key string) (int64, error) {
+	resp, err := s.apply(&command{
+		Op:  "incr",
+		Key: key,
+	})
+	if err != nil {
+		return 0, err
 	}
+	return resp.(int64), nil
+}
 
-	c := &command{
-		Op:  "delete",
+// IncrBy atomically increments the integer value of the given key by delta
+// and returns the new value. A missing key is treated as 0.
+func (s *Store) IncrBy(key string, delta int64) (int64, error) {
+	resp, err := s.apply(&command{
+		Op:    "incrby",
+		Key:   key,
+		Delta: delta,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int64), nil
+}
+
+// DecrBy atomically decrements the integer value of the given key by delta
+// and returns the new value. A missing key is treated as 0.
+func (s *Store) DecrBy(key string, delta int64) (int64, error) {
+	if delta == math.MinInt64 {
+		return 0, ErrOverflow
+	}
+	return s.IncrBy(key, -delta)
+}
+
+// IncrByFloat atomically increments the floating point value of the given key
+// by delta and returns the new value. A missing key is treated as 0.
+func (s *Store) IncrByFloat(key string, delta float64) (float64, error) {
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return 0, ErrNotFinite
+	}
+	resp, err := s.apply(&command{
+		Op:         "incrbyfloat",
+		Key:        key,
+		FloatDelta: delta,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(float64), nil
+}
+
+// Expire sets a timeout on the given key and reports whether the key
+// exists. The deadline is computed once on the leader and replicated as an
+// absolute time, so every node agrees on it.
+func (s *Store) Expire(key string, ttl time.Duration) (bool, error) {
+	return s.ExpireAt(key, time.Now().Add(ttl))
+}
+
+// ExpireAt sets the absolute time at which the given key expires and reports
+// whether the key exists. A deadline in the past deletes the key.
+func (s *Store) ExpireAt(key string, deadline time.Time) (bool, error) {
+	resp, err := s.apply(&command{
+		Op:         "expire",
+		Key:        key,
+		Expiration: deadline.UnixNano(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.(bool), nil
+}
+
+// Persist removes the timeout from the given key. It reports whether a
+// timeout was removed, which is false for a missing key.
+func (s *Store) Persist(key string) (bool, error) {
+	resp, err := s.apply(&command{
+		Op:  "persist",
 		Key: key,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.(bool), nil
+}
+
+// ExpireTime returns the time at which the given key expires, or the zero
+// time if the key has no timeout.
+func (s *Store) ExpireTime(key string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.peek(key)
+	if !ok {
+		return time.Time{}, ErrKeyNotFound
 	}
+	return item.expiration, nil
+}
+
+// apply proposes c through Raft and returns the response of the FSM. An error
+// returned by the FSM is surfaced as the error result.
+func (s *Store) apply(c *command) (interface{}, error) {
+	if s.raft.State() != raft.Leader {
+		return nil, fmt.Errorf("not leader")
+	}
+
 	b, err := json.Marshal(c)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	f := s.raft.Apply(b, raftTimeout)
-	return f.Error()
+	if err := f.Error(); err != nil {
+		return nil, err
+	}
+	if err, ok := f.Response().(error); ok {
+		return nil, err
+	}
+	return f.Response(), nil
 }
 
 // Join joins a node, identified by nodeID and located at addr, to this store.
@@ -219,90 +472,298 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 		panic(fmt.Sprintf("failed to unmarshal command: %s", err.Error()))
 	}
 
+	f.mu.Lock()
+	f.applied = logTime(l)
+	f.mu.Unlock()
+
 	switch c.Op {
 	case "set":
-		return f.applySet(c.Key, c.Value)
+		value := c.Value
+		if c.Data != nil {
+			// Values are carried as bytes, which survive JSON encoding
+			// unlike strings that are not valid UTF-8. Older entries
+			// carry the value as a string.
+			value = string(c.Data)
+		}
+		var deadline time.Time
+		if c.Expiration != 0 {
+			deadline = time.Unix(0, c.Expiration)
+		}
+		return f.applySet(c.Key, value, c.Flags, deadline, logTime(l))
 	case "delete":
-		return f.applyDelete(c.Key)
+		keys := c.Keys
+		if c.Key != "" {
+			// Entries written before multi-key deletes carry a single key.
+			keys = append(keys, c.Key)
+		}
+		return f.applyDelete(keys, logTime(l))
+	case "incr":
+		return f.applyIncrBy(c.Key, 1, logTime(l))
+	case "incrby":
+		return f.applyIncrBy(c.Key, c.Delta, logTime(l))
+	case "incrbyfloat":
+		return f.applyIncrByFloat(c.Key, c.FloatDelta, logTime(l))
+	case "expire":
+		return f.applyExpire(c.Key, time.Unix(0, c.Expiration), logTime(l))
+	case "persist":
+		return f.applyPersist(c.Key, logTime(l))
+	case "reap":
+		return f.applyReap(c.Keys, logTime(l))
+	case "hset":
+		return f.applyHSet(c.Key, c.FieldValues, logTime(l))
+	case "hdel":
+		return f.applyHDel(c.Key, c.Fields, logTime(l))
+	case "hincrby":
+		return f.applyHIncrBy(c.Key, c.Field, c.Delta, logTime(l))
+	case "lpush":
+		return f.applyPush(c.Key, c.Values, true, logTime(l))
+	case "rpush":
+		return f.applyPush(c.Key, c.Values, false, logTime(l))
+	case "lpop":
+		return f.applyPop(c.Keys, c.Count, true, logTime(l))
+	case "rpop":
+		return f.applyPop(c.Keys, c.Count, false, logTime(l))
+	case "ltrim":
+		return f.applyLTrim(c.Key, c.Start, c.Stop, logTime(l))
+	case "sadd":
+		return f.applySAdd(c.Key, c.Values, logTime(l))
+	case "srem":
+		return f.applySRem(c.Key, c.Values, logTime(l))
+	case "spop":
+		return f.applySPop(c.Key, c.Count, l.Index, logTime(l))
+	case "sinterstore":
+		return f.applySetStore(setInter, c.Key, c.Keys, logTime(l))
+	case "sunionstore":
+		return f.applySetStore(setUnion, c.Key, c.Keys, logTime(l))
+	case "sdiffstore":
+		return f.applySetStore(setDiff, c.Key, c.Keys, logTime(l))
+	case "zadd":
+		return f.applyZAdd(c.Key, c.Values, c.Scores, c.Flags, logTime(l))
+	case "zrem":
+		return f.applyZRem(c.Key, c.Values, logTime(l))
+	case "zpopmin":
+		return f.applyZPop(c.Key, c.Count, false, logTime(l))
+	case "zpopmax":
+		return f.applyZPop(c.Key, c.Count, true, logTime(l))
+	case "xadd":
+		return f.applyXAdd(c.Key, c.ID, c.FieldValues, c.MaxLen, logTime(l))
+	case "xtrim":
+		return f.applyXTrim(c.Key, c.MaxLen, c.ID, logTime(l))
+	case "xgroupcreate":
+		return f.applyXGroupCreate(c.Key, c.Group, c.ID, hasFlag(c.Flags, "mkstream"), logTime(l))
+	case "xreadgroup":
+		return f.applyXReadGroup(c.Key, c.Group, c.Consumer, c.ID, c.Count, hasFlag(c.Flags, "noack"), logTime(l))
+	case "xack":
+		return f.applyXAck(c.Key, c.Group, c.IDs, logTime(l))
+	case "xclaim":
+		return f.applyXClaim(c.Key, c.Group, c.Consumer, c.MinIdle, c.IDs, logTime(l))
+	case "setbit":
+		return f.applySetBit(c.Key, c.Offset, c.Bit, logTime(l))
+	case "bitop":
+		return f.applyBitOp(c.Flags[0], c.Key, c.Keys, logTime(l))
+	case "pfadd":
+		return f.applyPFAdd(c.Key, c.Values, logTime(l))
+	case "pfmerge":
+		return f.applyPFMerge(c.Key, c.Keys, logTime(l))
+	case "jsonset":
+		return f.applyJSONSet(c.Key, c.Path, c.Value, hasFlag(c.Flags, "nx"), hasFlag(c.Flags, "xx"), logTime(l))
+	case "jsondel":
+		return f.applyJSONDel(c.Key, c.Path, logTime(l))
+	case "jsonnumincrby":
+		return f.applyJSONNumIncrBy(c.Key, c.Path, c.FloatDelta, logTime(l))
+	case "jsonarrappend":
+		return f.applyJSONArrAppend(c.Key, c.Path, c.Values, logTime(l))
+	case "bfreserve":
+		return f.applyBFReserve(c.Key, c.ErrorRate, c.Capacity, c.Expansion, logTime(l))
+	case "bfadd":
+		return f.applyBFAdd(c.Key, c.Values, logTime(l))
+	case "cfadd":
+		return f.applyCFAdd(c.Key, c.Values, logTime(l))
+	case "cfdel":
+		return f.applyCFDel(c.Key, c.Value, logTime(l))
+	case "cmsinitbydim":
+		return f.applyCMSInitByDim(c.Key, c.Width, c.Depth, logTime(l))
+	case "cmsincrby":
+		return f.applyCMSIncrBy(c.Key, c.Values, c.Increments, logTime(l))
+	case "topkreserve":
+		return f.applyTopKReserve(c.Key, c.Count, c.Width, c.Depth, c.Decay, logTime(l))
+	case "topkadd":
+		return f.applyTopKAdd(c.Key, c.Values, l.Index, logTime(l))
+	case "tdigestcreate":
+		return f.applyTDigestCreate(c.Key, c.Compression, logTime(l))
+	case "tdigestadd":
+		return f.applyTDigestAdd(c.Key, c.Samples, logTime(l))
+	case "tsadd":
+		return f.applyTSAdd(c.Key, c.Timestamp, c.FloatValue, c.Retention, c.FieldValues, logTime(l))
+	case "append":
+		return f.applyAppend(c.Key, string(c.Data), logTime(l))
+	case "setrange":
+		return f.applySetRange(c.Key, c.Offset, string(c.Data), logTime(l))
+	case "getdel":
+		return f.applyGetDel(c.Key, logTime(l))
+	case "getex":
+		return f.applyGetEx(c.Key, time.Unix(0, c.Expiration), hasFlag(c.Flags, "persist"), logTime(l))
+	case "mset":
+		return f.applyMSet(c.Keys, c.Blobs, hasFlag(c.Flags, "nx"), logTime(l))
 	default:
 		panic(fmt.Sprintf("unrecognized command op: %s", c.Op))
 	}
 }
 
-func (f *fsm) applySet(key, value string) interface{} {
-	f.mu.Lock()
-	defer f.mu.Unlock()
-	f.cache.Add(key, cacheItem{value: value, expiration: time.Now().Add(f.defaultTTL)})
-	return nil
+// lookup returns the item stored at key if it has not expired at now. It
+// must be called with the lock held.
+func (f *fsm) lookup(key string, now time.Time) (cacheItem, bool) {
+	item, ok := f.cache.Get(key)
+	if !ok || item.expired(now) {
+		return cacheItem{}, false
+	}
+	return item, true
 }
 
-func (f *fsm) applyDelete(key string) interface{} {
-	f.mu.Lock()
-	defer f.mu.Unlock()
-	f.cache.Remove(key)
-	return nil
+// logTime returns the time the leader appended l. Using it instead of the
+// local clock keeps expiry decisions identical on every replica.
+func logTime(l *raft.Log) time.Time {
+	if l.AppendedAt.IsZero() {
+		return time.Now()
+	}
+	return l.AppendedAt
+}
+
+// hasFlag reports whether flag is among the flags of a command.
+func hasFlag(flags []string, flag string) bool {
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
 }
 
-// Snapshot returns a snapshot of the key-value store.
-func (f *fsm) Snapshot() (raft.FSMSnapshot, error) {
+func (f *fsm) applySet(key, value string, flags []string, deadline, now time.Time) interface{} {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	// Clone the map.
-	o := make(map[string]string)
-	for _, v := range f.cache.Keys() {
-		if item, ok := f.cache.Get(v); ok {
-			o[v] = item.value
+	var res SetResult
+	item, ok := f.lookup(key, now)
+	if ok && hasFlag(flags, "get") {
+		old, err := item.str()
+		if err != nil {
+			return err
 		}
+		res.Previous, res.Existed = old, true
 	}
-	return &fsmSnapshot{store: o}, nil
-}
-
-// Restore stores the key-value store to a previous state.
-func (f *fsm) Restore(rc io.ReadCloser) error {
-	o := make(map[string]string)
-	if err := json.NewDecoder(rc).Decode(&o); err != nil {
-		return err
+	if ok && hasFlag(flags, "nx") || !ok && hasFlag(flags, "xx") {
+		return res
 	}
+	res.Set = true
 
-	// Set the state from the snapshot, no lock required according to
-	// Hashicorp docs.
-	f.cache.Purge() // Clear the existing cache
-	for k, v := range o {
-		f.cache.Add(k, cacheItem{
-			value:      v,
-			expiration: time.Now().Add(f.defaultTTL),
-		})
+	if !deadline.IsZero() && !now.Before(deadline) {
+		f.cache.Remove(key)
+		return res
 	}
-	return nil
+	next := cacheItem{value: value, expiration: deadline}
+	if ok && hasFlag(flags, "keepttl") {
+		next.expiration = item.expiration
+	}
+	f.cache.Add(key, next)
+	f.trackDeadline(key, next)
+	return res
 }
 
-type fsmSnapshot struct {
-	store map[string]string
+func (f *fsm) applyDelete(keys []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	deleted := 0
+	for _, key := range keys {
+		if item, ok := f.cache.Peek(key); ok {
+			if !item.expired(now) {
+				deleted++
+			}
+			f.cache.Remove(key)
+		}
+	}
+	return deleted
 }
 
-func (f *fsmSnapshot) Persist(sink raft.SnapshotSink) error {
-	err := func() error {
-		// Encode data.
-		b, err := json.Marshal(f.store)
+func (f *fsm) applyIncrBy(key string, delta int64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var n int64
+	item, ok := f.lookup(key, now)
+	if ok {
+		str, err := item.str()
 		if err != nil {
 			return err
 		}
+		if n, err = strconv.ParseInt(str, 10, 64); err != nil {
+			return ErrNotInteger
+		}
+	}
+	if (delta > 0 && n > math.MaxInt64-delta) || (delta < 0 && n < math.MinInt64-delta) {
+		return ErrOverflow
+	}
+	n += delta
+
+	item.value = strconv.FormatInt(n, 10)
+	f.cache.Add(key, item)
+	return n
+}
 
-		// Write data to sink.
-		if _, err := sink.Write(b); err != nil {
+func (f *fsm) applyIncrByFloat(key string, delta float64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var n float64
+	item, ok := f.lookup(key, now)
+	if ok {
+		str, err := item.str()
+		if err != nil {
 			return err
 		}
+		n, err = strconv.ParseFloat(str, 64)
+		if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+			return ErrNotFloat
+		}
+	}
+	n += delta
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return ErrNotFinite
+	}
 
-		// Close the sink.
-		return sink.Close()
-	}()
+	item.value = strconv.FormatFloat(n, 'f', -1, 64)
+	f.cache.Add(key, item)
+	return n
+}
 
-	if err != nil {
-		sink.Cancel()
-	}
+func (f *fsm) applyExpire(key string, deadline, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 
-	return err
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return false
+	}
+	if !now.Before(deadline) {
+		f.cache.Remove(key)
+		return true
+	}
+	item.expiration = deadline
+	f.cache.Add(key, item)
+	f.trackDeadline(key, item)
+	return true
 }
 
-func (f *fsmSnapshot) Release() {}
+func (f *fsm) applyPersist(key string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok || item.expiration.IsZero() {
+		return false
+	}
+	item.expiration = time.Time{}
+	f.cache.Add(key, item)
+	return true
+}
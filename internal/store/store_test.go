@@ -0,0 +1,398 @@
+package store
+
+import (
+	"errors"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+// openStore opens a single-node in-memory store and waits until it leads.
+func openStore(t *testing.T) *Store {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	s := New(true)
+	s.RaftDir = t.TempDir()
+	s.RaftBind = addr
+	if err := s.Open(true, "node0"); err != nil {
+		t.Fatalf("open store: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	deadline := time.Now().Add(10 * time.Second)
+	for s.raft.State() != raft.Leader {
+		if time.Now().After(deadline) {
+			t.Fatal("store did not become leader")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	return s
+}
+
+func TestSetWithOptions(t *testing.T) {
+	s := openStore(t)
+	deadline := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+
+	tests := []struct {
+		name      string
+		existing  bool
+		opts      SetOptions
+		want      SetResult
+		wantValue string
+		wantTTL   bool
+	}{
+		{"plain", true, SetOptions{}, SetResult{Set: true}, "new", false},
+		{"nx on a missing key", false, SetOptions{NX: true}, SetResult{Set: true}, "new", false},
+		{"nx on an existing key", true, SetOptions{NX: true}, SetResult{}, "old", true},
+		{"xx on a missing key", false, SetOptions{XX: true}, SetResult{}, "", false},
+		{"xx on an existing key", true, SetOptions{XX: true}, SetResult{Set: true}, "new", false},
+		{"get", true, SetOptions{Get: true}, SetResult{Set: true, Previous: "old", Existed: true}, "new", false},
+		{"get on a missing key", false, SetOptions{Get: true}, SetResult{Set: true}, "new", false},
+		{"get with a failed nx", true, SetOptions{NX: true, Get: true}, SetResult{Previous: "old", Existed: true}, "old", true},
+		{"deadline", true, SetOptions{Deadline: deadline}, SetResult{Set: true}, "new", true},
+		{"keepttl", true, SetOptions{KeepTTL: true}, SetResult{Set: true}, "new", true},
+		{"keepttl on a missing key", false, SetOptions{KeepTTL: true}, SetResult{Set: true}, "new", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := strings.ReplaceAll(tt.name, " ", "-")
+			if tt.existing {
+				if _, err := s.SetWithOptions(key, "old", SetOptions{Deadline: deadline}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			res, err := s.SetWithOptions(key, "new", tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res != tt.want {
+				t.Fatalf("got %+v, want %+v", res, tt.want)
+			}
+			v, err := s.Get(key)
+			if tt.wantValue == "" {
+				if !errors.Is(err, ErrKeyNotFound) {
+					t.Fatalf("Get = %q, %v, want %v", v, err, ErrKeyNotFound)
+				}
+				return
+			}
+			if err != nil || v != tt.wantValue {
+				t.Fatalf("Get = %q, %v, want %q", v, err, tt.wantValue)
+			}
+			got, err := s.ExpireTime(key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantTTL && !got.Equal(deadline) || !tt.wantTTL && !got.IsZero() {
+				t.Fatalf("ExpireTime = %v, want a timeout %v", got, tt.wantTTL)
+			}
+		})
+	}
+
+	if _, err := s.SetWithOptions("past", "v", SetOptions{Deadline: time.Now().Add(-time.Second)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get("past"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("Get after a set with a past deadline = %v, want %v", err, ErrKeyNotFound)
+	}
+	if _, err := s.RPush("list", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SetWithOptions("list", "v", SetOptions{Get: true}); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("set with get on a list = %v, want %v", err, ErrWrongType)
+	}
+}
+
+// TestSetNXConcurrent checks that only one of several concurrent SET NX
+// calls on a key succeeds.
+func TestSetNXConcurrent(t *testing.T) {
+	s := openStore(t)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	won := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := s.SetWithOptions("lock", "owner", SetOptions{NX: true, Deadline: time.Now().Add(time.Minute)})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if res.Set {
+				mu.Lock()
+				won++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if won != 1 {
+		t.Fatalf("%d SET NX calls succeeded, want 1", won)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := openStore(t)
+
+	for _, key := range []string{"a", "b", "c"} {
+		if err := s.Set(key, "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := s.Delete("a", "b", "missing", "a"); err != nil || n != 2 {
+		t.Fatalf("Delete = %d, %v, want 2", n, err)
+	}
+	for key, want := range map[string]error{"a": ErrKeyNotFound, "b": ErrKeyNotFound, "c": nil} {
+		if _, err := s.Get(key); !errors.Is(err, want) {
+			t.Errorf("Get(%q) = %v, want %v", key, err, want)
+		}
+	}
+}
+
+// TestApplyDelete checks that expired keys are removed but not counted, and
+// that single-key entries from before multi-key deletes are still applied.
+func TestApplyDelete(t *testing.T) {
+	f := (*fsm)(New(true))
+	now := time.Unix(1000, 0)
+
+	f.applySet("live", "v", nil, time.Time{}, now)
+	f.applySet("expired", "v", nil, time.Time{}, now)
+	f.applyExpire("expired", now.Add(time.Second), now)
+	if got := f.applyDelete([]string{"live", "expired"}, now.Add(2*time.Second)); got != 1 {
+		t.Fatalf("applyDelete = %v, want 1", got)
+	}
+	if f.cache.Len() != 0 {
+		t.Fatalf("cache holds %d keys, want 0", f.cache.Len())
+	}
+
+	f.applySet("legacy", "v", nil, time.Time{}, now)
+	l := &raft.Log{Data: []byte(`{"op":"delete","key":"legacy"}`), AppendedAt: now}
+	if got := f.Apply(l); got != 1 {
+		t.Fatalf("Apply(legacy delete) = %v, want 1", got)
+	}
+}
+
+func TestIncr(t *testing.T) {
+	s := openStore(t)
+
+	if n, err := s.Incr("counter"); err != nil || n != 1 {
+		t.Fatalf("Incr(missing) = %d, %v, want 1", n, err)
+	}
+
+	// Concurrent increments are not lost.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 25; j++ {
+				if _, err := s.Incr("counter"); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if v, err := s.Get("counter"); err != nil || v != "101" {
+		t.Fatalf("Get = %q, %v, want 101", v, err)
+	}
+
+	// The expiration survives an increment.
+	deadline := time.Now().Add(time.Hour)
+	if _, err := s.ExpireAt("counter", deadline); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Incr("counter"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.ExpireTime("counter"); err != nil || !got.Equal(deadline) {
+		t.Fatalf("ExpireTime = %v, %v, want %v", got, err, deadline)
+	}
+
+	if err := s.Set("text", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Incr("text"); !errors.Is(err, ErrNotInteger) {
+		t.Fatalf("Incr(text) = %v, want %v", err, ErrNotInteger)
+	}
+	if err := s.Set("max", strconv.FormatInt(math.MaxInt64, 10)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Incr("max"); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("Incr(max) = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestIncrBy(t *testing.T) {
+	s := openStore(t)
+
+	tests := []struct {
+		name    string
+		call    func() (int64, error)
+		want    int64
+		wantErr error
+	}{
+		{"incrby missing", func() (int64, error) { return s.IncrBy("n", 10) }, 10, nil},
+		{"incrby negative", func() (int64, error) { return s.IncrBy("n", -15) }, -5, nil},
+		{"decrby", func() (int64, error) { return s.DecrBy("n", 5) }, -10, nil},
+		{"decrby min int64", func() (int64, error) { return s.DecrBy("n", math.MinInt64) }, 0, ErrOverflow},
+		{"decrby underflow", func() (int64, error) { return s.DecrBy("n", math.MaxInt64) }, 0, ErrOverflow},
+		{"incrby near max", func() (int64, error) { return s.IncrBy("n", math.MaxInt64) }, math.MaxInt64 - 10, nil},
+		{"incrby overflow", func() (int64, error) { return s.IncrBy("n", 11) }, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Fatalf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncrByFloat(t *testing.T) {
+	s := openStore(t)
+
+	if n, err := s.IncrByFloat("f", 10.5); err != nil || n != 10.5 {
+		t.Fatalf("IncrByFloat(missing) = %v, %v, want 10.5", n, err)
+	}
+	if n, err := s.IncrByFloat("f", -0.25); err != nil || n != 10.25 {
+		t.Fatalf("IncrByFloat = %v, %v, want 10.25", n, err)
+	}
+	if v, err := s.Get("f"); err != nil || v != "10.25" {
+		t.Fatalf("Get = %q, %v, want 10.25", v, err)
+	}
+
+	if _, err := s.IncrByFloat("f", math.Inf(1)); !errors.Is(err, ErrNotFinite) {
+		t.Fatalf("IncrByFloat(+Inf) = %v, want %v", err, ErrNotFinite)
+	}
+	if _, err := s.IncrByFloat("f", math.MaxFloat64); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.IncrByFloat("f", math.MaxFloat64); !errors.Is(err, ErrNotFinite) {
+		t.Fatalf("IncrByFloat past MaxFloat64 = %v, want %v", err, ErrNotFinite)
+	}
+
+	for _, value := range []string{"abc", "NaN", "inf"} {
+		if err := s.Set("text", value); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.IncrByFloat("text", 1); !errors.Is(err, ErrNotFloat) {
+			t.Fatalf("IncrByFloat on %q = %v, want %v", value, err, ErrNotFloat)
+		}
+	}
+}
+
+func TestExpire(t *testing.T) {
+	s := openStore(t)
+
+	if set, err := s.Expire("missing", time.Minute); err != nil || set {
+		t.Fatalf("Expire(missing) = %v, %v, want false", set, err)
+	}
+
+	if err := s.Set("k", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if set, err := s.Expire("k", time.Hour); err != nil || !set {
+		t.Fatalf("Expire = %v, %v, want true", set, err)
+	}
+	if v, err := s.Get("k"); err != nil || v != "v" {
+		t.Fatalf("Get = %q, %v, want %q", v, err, "v")
+	}
+
+	if _, err := s.Expire("k", 50*time.Millisecond); err != nil {
+		t.Fatalf("Expire: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if _, err := s.Get("k"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("Get after deadline = %v, want %v", err, ErrKeyNotFound)
+	}
+}
+
+func TestExpireAtAndPersist(t *testing.T) {
+	s := openStore(t)
+
+	if _, err := s.ExpireTime("k"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("ExpireTime(missing) = %v, want %v", err, ErrKeyNotFound)
+	}
+	if removed, err := s.Persist("k"); err != nil || removed {
+		t.Fatalf("Persist(missing) = %v, %v, want false", removed, err)
+	}
+
+	if err := s.Set("k", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if deadline, err := s.ExpireTime("k"); err != nil || !deadline.IsZero() {
+		t.Fatalf("ExpireTime = %v, %v, want the zero time", deadline, err)
+	}
+	if removed, err := s.Persist("k"); err != nil || removed {
+		t.Fatalf("Persist without a timeout = %v, %v, want false", removed, err)
+	}
+
+	want := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	if _, err := s.ExpireAt("k", want); err != nil {
+		t.Fatalf("ExpireAt: %v", err)
+	}
+	if deadline, err := s.ExpireTime("k"); err != nil || !deadline.Equal(want) {
+		t.Fatalf("ExpireTime = %v, %v, want %v", deadline, err, want)
+	}
+	if removed, err := s.Persist("k"); err != nil || !removed {
+		t.Fatalf("Persist = %v, %v, want true", removed, err)
+	}
+	if deadline, err := s.ExpireTime("k"); err != nil || !deadline.IsZero() {
+		t.Fatalf("ExpireTime after Persist = %v, %v, want the zero time", deadline, err)
+	}
+
+	if _, err := s.ExpireAt("k", time.Now().Add(-time.Second)); err != nil {
+		t.Fatalf("ExpireAt: %v", err)
+	}
+	if _, err := s.Get("k"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("Get after a past deadline = %v, want %v", err, ErrKeyNotFound)
+	}
+}
+
+// TestApplyExpire checks that the FSM decides expiry from the log time it is
+// given rather than the local clock.
+func TestApplyExpire(t *testing.T) {
+	f := (*fsm)(New(true))
+	now := time.Unix(1000, 0)
+
+	f.applySet("k", "v", nil, time.Time{}, now)
+	if got := f.applyExpire("k", now.Add(time.Second), now); got != true {
+		t.Fatalf("applyExpire = %v, want true", got)
+	}
+	item, _ := f.cache.Peek("k")
+	if !item.expiration.Equal(now.Add(time.Second)) {
+		t.Fatalf("expiration = %v, want %v", item.expiration, now.Add(time.Second))
+	}
+	if got := f.applyExpire("k", now.Add(time.Minute), now.Add(2*time.Second)); got != false {
+		t.Fatalf("applyExpire on an expired key = %v, want false", got)
+	}
+
+	f.applySet("k", "v", nil, time.Time{}, now)
+	if got := f.applyExpire("k", now, now); got != true {
+		t.Fatalf("applyExpire = %v, want true", got)
+	}
+	if f.cache.Contains("k") {
+		t.Fatal("a deadline at or before the log time should delete the key")
+	}
+}
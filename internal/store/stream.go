@@ -0,0 +1,926 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+var (
+	// ErrInvalidStreamID is returned when a stream ID cannot be parsed.
+	ErrInvalidStreamID = errors.New("invalid stream ID")
+
+	// ErrStreamIDTooSmall is returned when XADD is given an ID that is not
+	// greater than the last ID of the stream.
+	ErrStreamIDTooSmall = errors.New("ID is equal or smaller than the stream top item")
+
+	// ErrGroupNotFound is returned when a consumer group does not exist.
+	ErrGroupNotFound = errors.New("consumer group not found")
+
+	// ErrGroupExists is returned when creating a consumer group that exists.
+	ErrGroupExists = errors.New("consumer group already exists")
+)
+
+// StreamID identifies a stream entry: the milliseconds part is the time the
+// entry was added and the sequence orders entries added in the same
+// millisecond.
+type StreamID struct {
+	Ms, Seq uint64
+}
+
+// String returns the ID in the "ms-seq" form.
+func (id StreamID) String() string {
+	return strconv.FormatUint(id.Ms, 10) + "-" + strconv.FormatUint(id.Seq, 10)
+}
+
+// ParseStreamID parses an ID in the "ms-seq" or "ms" form. A missing
+// sequence is zero.
+func ParseStreamID(s string) (StreamID, error) {
+	id, seqSet, err := parseStreamID(s)
+	if err != nil {
+		return StreamID{}, err
+	}
+	if !seqSet {
+		id.Seq = 0
+	}
+	return id, nil
+}
+
+func parseStreamID(s string) (StreamID, bool, error) {
+	msPart, seqPart, seqSet := strings.Cut(s, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return StreamID{}, false, ErrInvalidStreamID
+	}
+	var seq uint64
+	if seqSet {
+		if seq, err = strconv.ParseUint(seqPart, 10, 64); err != nil {
+			return StreamID{}, false, ErrInvalidStreamID
+		}
+	}
+	return StreamID{Ms: ms, Seq: seq}, seqSet, nil
+}
+
+func (id StreamID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+func (id *StreamID) UnmarshalText(b []byte) error {
+	var err error
+	*id, err = ParseStreamID(string(b))
+	return err
+}
+
+// less reports whether id sorts before other.
+func (id StreamID) less(other StreamID) bool {
+	return id.Ms < other.Ms || (id.Ms == other.Ms && id.Seq < other.Seq)
+}
+
+// next returns the smallest ID greater than id.
+func (id StreamID) next() StreamID {
+	if id.Seq == math.MaxUint64 {
+		return StreamID{Ms: id.Ms + 1}
+	}
+	return StreamID{Ms: id.Ms, Seq: id.Seq + 1}
+}
+
+// prev returns the largest ID smaller than id.
+func (id StreamID) prev() StreamID {
+	if id.Seq == 0 {
+		return StreamID{Ms: id.Ms - 1, Seq: math.MaxUint64}
+	}
+	return StreamID{Ms: id.Ms, Seq: id.Seq - 1}
+}
+
+// parseRangeStart parses the start of an ID range: "-" is the smallest ID,
+// "(" makes the bound exclusive and a missing sequence is zero.
+func parseRangeStart(s string) (StreamID, error) {
+	if s == "-" {
+		return StreamID{}, nil
+	}
+	exclusive := strings.HasPrefix(s, "(")
+	id, err := ParseStreamID(strings.TrimPrefix(s, "("))
+	if err != nil {
+		return StreamID{}, err
+	}
+	if exclusive {
+		if id == (StreamID{Ms: math.MaxUint64, Seq: math.MaxUint64}) {
+			return StreamID{}, ErrInvalidStreamID
+		}
+		id = id.next()
+	}
+	return id, nil
+}
+
+// parseRangeEnd parses the end of an ID range: "+" is the largest ID, "("
+// makes the bound exclusive and a missing sequence is the largest one.
+func parseRangeEnd(s string) (StreamID, error) {
+	if s == "+" {
+		return StreamID{Ms: math.MaxUint64, Seq: math.MaxUint64}, nil
+	}
+	exclusive := strings.HasPrefix(s, "(")
+	id, seqSet, err := parseStreamID(strings.TrimPrefix(s, "("))
+	if err != nil {
+		return StreamID{}, err
+	}
+	if !seqSet {
+		id.Seq = math.MaxUint64
+	}
+	if exclusive {
+		if id == (StreamID{}) {
+			return StreamID{}, ErrInvalidStreamID
+		}
+		id = id.prev()
+	}
+	return id, nil
+}
+
+// StreamEntry is an entry of a stream.
+type StreamEntry struct {
+	ID     StreamID          `json:"id"`
+	Fields map[string]string `json:"fields"`
+}
+
+// PendingEntry is an entry delivered to a consumer of a group but not yet
+// acknowledged.
+type PendingEntry struct {
+	ID         StreamID
+	Consumer   string
+	Idle       time.Duration // Time since the entry was last delivered
+	Deliveries int
+}
+
+// PendingSummary summarizes the pending entries of a consumer group.
+type PendingSummary struct {
+	Count     int
+	Lowest    StreamID
+	Highest   StreamID
+	Consumers map[string]int // Pending entries per consumer
+}
+
+// XReadGroupOptions controls XReadGroup.
+type XReadGroupOptions struct {
+	Count int  // Maximum number of entries to return, zero for no limit
+	NoAck bool // Do not add the delivered entries to the pending entries list
+}
+
+// streamValue is the value of a stream key. Entries are ordered by ID.
+type streamValue struct {
+	entries []StreamEntry
+	lastID  StreamID
+	groups  map[string]*consumerGroup
+}
+
+// consumerGroup tracks which entries were delivered to a group and which of
+// them are still waiting for an acknowledgement.
+type consumerGroup struct {
+	LastDelivered StreamID                  `json:"last_delivered"`
+	Pending       map[StreamID]*pendingItem `json:"pending"`
+	Consumers     map[string]int64          `json:"consumers"` // Consumer -> last seen, in Unix milliseconds
+}
+
+type pendingItem struct {
+	Consumer    string `json:"consumer"`
+	DeliveredAt int64  `json:"delivered_at"` // Unix milliseconds of the log entry that delivered it
+	Deliveries  int    `json:"deliveries"`
+}
+
+type streamJSON struct {
+	Entries []StreamEntry             `json:"entries"`
+	LastID  StreamID                  `json:"last_id"`
+	Groups  map[string]*consumerGroup `json:"groups,omitempty"`
+}
+
+func newStream() *streamValue {
+	return &streamValue{groups: make(map[string]*consumerGroup)}
+}
+
+// clone copies the stream. Entries are never modified once added, so only
+// the slice holding them is copied.
+func (st *streamValue) clone() *streamValue {
+	c := &streamValue{entries: slices.Clone(st.entries), lastID: st.lastID, groups: make(map[string]*consumerGroup, len(st.groups))}
+	for name, g := range st.groups {
+		pending := make(map[StreamID]*pendingItem, len(g.Pending))
+		for id, p := range g.Pending {
+			cp := *p
+			pending[id] = &cp
+		}
+		c.groups[name] = &consumerGroup{LastDelivered: g.LastDelivered, Pending: pending, Consumers: maps.Clone(g.Consumers)}
+	}
+	return c
+}
+
+func (st *streamValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(streamJSON{Entries: st.entries, LastID: st.lastID, Groups: st.groups})
+}
+
+func (st *streamValue) UnmarshalJSON(b []byte) error {
+	var v streamJSON
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	st.entries, st.lastID, st.groups = v.Entries, v.LastID, v.Groups
+	if st.groups == nil {
+		st.groups = make(map[string]*consumerGroup)
+	}
+	return nil
+}
+
+// search returns the index of the first entry with an ID not less than id.
+func (st *streamValue) search(id StreamID) int {
+	return sort.Search(len(st.entries), func(i int) bool {
+		return !st.entries[i].ID.less(id)
+	})
+}
+
+// entry returns the entry with the given ID, or false if it was trimmed.
+func (st *streamValue) entry(id StreamID) (StreamEntry, bool) {
+	i := st.search(id)
+	if i < len(st.entries) && st.entries[i].ID == id {
+		return st.entries[i], true
+	}
+	return StreamEntry{}, false
+}
+
+// rangeOf returns up to count entries with IDs between start and end
+// inclusive, from the newest with rev. A count of zero means no limit.
+func (st *streamValue) rangeOf(start, end StreamID, rev bool, count int) []StreamEntry {
+	lo := st.search(start)
+	hi := sort.Search(len(st.entries), func(i int) bool {
+		return end.less(st.entries[i].ID)
+	})
+	if hi <= lo {
+		return nil
+	}
+	if count <= 0 || count > hi-lo {
+		count = hi - lo
+	}
+
+	entries := make([]StreamEntry, 0, count)
+	for i := 0; i < count; i++ {
+		if rev {
+			entries = append(entries, st.entries[hi-1-i])
+		} else {
+			entries = append(entries, st.entries[lo+i])
+		}
+	}
+	return entries
+}
+
+// after returns up to count entries with IDs greater than id. A count of
+// zero means no limit.
+func (st *streamValue) after(id StreamID, count int) []StreamEntry {
+	i := sort.Search(len(st.entries), func(i int) bool {
+		return id.less(st.entries[i].ID)
+	})
+	n := len(st.entries) - i
+	if count > 0 && count < n {
+		n = count
+	}
+	return append([]StreamEntry{}, st.entries[i:i+n]...)
+}
+
+// pendingIDs returns the IDs in the pending entries list in ascending order.
+func (g *consumerGroup) pendingIDs() []StreamID {
+	ids := make([]StreamID, 0, len(g.Pending))
+	for id := range g.Pending {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].less(ids[j]) })
+	return ids
+}
+
+// XAdd appends an entry to the stream stored at key, creating the stream if
+// it does not exist, and returns its ID. The ID is "*" to generate one from
+// the time of the Raft log entry, "ms-*" to generate only the sequence, or
+// an explicit "ms-seq" greater than the last ID of the stream. A
+// non-negative maxLen trims the stream to that many entries.
+func (s *Store) XAdd(key, id string, fields map[string]string, maxLen int64) (StreamID, error) {
+	if id != "*" {
+		msPart, seqPart, _ := strings.Cut(id, "-")
+		if seqPart == "*" {
+			id = msPart + "-*"
+			if _, err := strconv.ParseUint(msPart, 10, 64); err != nil {
+				return StreamID{}, ErrInvalidStreamID
+			}
+		} else {
+			parsed, err := ParseStreamID(id)
+			if err != nil {
+				return StreamID{}, err
+			}
+			if parsed == (StreamID{}) {
+				// 0-0 is never a valid entry ID.
+				return StreamID{}, ErrInvalidStreamID
+			}
+			id = parsed.String()
+		}
+	}
+
+	c := &command{
+		Op:          "xadd",
+		Key:         key,
+		ID:          id,
+		FieldValues: fields,
+		MaxLen:      -1,
+	}
+	if maxLen >= 0 {
+		c.MaxLen = maxLen
+	}
+	resp, err := s.apply(c)
+	if err != nil {
+		return StreamID{}, err
+	}
+	return resp.(StreamID), nil
+}
+
+// XRange returns up to count entries of the stream stored at key with IDs
+// between start and end inclusive. "-" and "+" are the smallest and largest
+// IDs, and a "(" prefix makes a bound exclusive. A count of zero means no
+// limit.
+func (s *Store) XRange(key, start, end string, count int) ([]StreamEntry, error) {
+	return s.xrange(key, start, end, false, count)
+}
+
+// XRevRange is like XRange but returns the entries from the newest.
+func (s *Store) XRevRange(key, end, start string, count int) ([]StreamEntry, error) {
+	return s.xrange(key, start, end, true, count)
+}
+
+func (s *Store) xrange(key, start, end string, rev bool, count int) ([]StreamEntry, error) {
+	from, err := parseRangeStart(start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseRangeEnd(end)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, err := s.stream(key)
+	if err != nil || st == nil {
+		return nil, err
+	}
+	return st.rangeOf(from, to, rev, count), nil
+}
+
+// XLen returns the number of entries in the stream stored at key.
+func (s *Store) XLen(key string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, err := s.stream(key)
+	if err != nil || st == nil {
+		return 0, err
+	}
+	return len(st.entries), nil
+}
+
+// XTrimMaxLen removes the oldest entries of the stream stored at key until
+// at most maxLen remain, and returns the number of entries removed.
+func (s *Store) XTrimMaxLen(key string, maxLen int64) (int, error) {
+	resp, err := s.apply(&command{
+		Op:     "xtrim",
+		Key:    key,
+		MaxLen: maxLen,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// XTrimMinID removes the entries of the stream stored at key with IDs less
+// than minID, and returns the number of entries removed.
+func (s *Store) XTrimMinID(key, minID string) (int, error) {
+	id, err := ParseStreamID(minID)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := s.apply(&command{
+		Op:     "xtrim",
+		Key:    key,
+		ID:     id.String(),
+		MaxLen: -1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// XGroupCreate creates a consumer group on the stream stored at key. The
+// group starts delivering entries after id, or after the last entry if id
+// is "$". With mkStream an empty stream is created if the key does not
+// exist.
+func (s *Store) XGroupCreate(key, group, id string, mkStream bool) error {
+	if id != "$" {
+		parsed, err := ParseStreamID(id)
+		if err != nil {
+			return err
+		}
+		id = parsed.String()
+	}
+	c := &command{
+		Op:    "xgroupcreate",
+		Key:   key,
+		Group: group,
+		ID:    id,
+	}
+	if mkStream {
+		c.Flags = []string{"mkstream"}
+	}
+	_, err := s.apply(c)
+	return err
+}
+
+// XReadGroup reads entries from the stream stored at key on behalf of a
+// consumer of group. With id ">" it returns entries never delivered to the
+// group and adds them to the pending entries list; otherwise it returns the
+// consumer's pending entries with IDs greater than id. Deliveries go
+// through Raft, so an entry is handed to only one consumer.
+func (s *Store) XReadGroup(group, consumer, key, id string, opts XReadGroupOptions) ([]StreamEntry, error) {
+	if id != ">" {
+		parsed, err := ParseStreamID(id)
+		if err != nil {
+			return nil, err
+		}
+		id = parsed.String()
+	}
+	c := &command{
+		Op:       "xreadgroup",
+		Key:      key,
+		Group:    group,
+		Consumer: consumer,
+		ID:       id,
+		Count:    opts.Count,
+	}
+	if opts.NoAck {
+		c.Flags = []string{"noack"}
+	}
+	resp, err := s.apply(c)
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]StreamEntry), nil
+}
+
+// XReadGroupBlock is like XReadGroup with id ">", but if there are no new
+// entries it waits until one is added, the timeout elapses or ctx is done;
+// a zero timeout waits indefinitely. It returns no entries if the timeout
+// elapsed.
+func (s *Store) XReadGroupBlock(ctx context.Context, group, consumer, key string, opts XReadGroupOptions, timeout time.Duration) ([]StreamEntry, error) {
+	// Followers cannot read for a group, so fail now rather than after
+	// waiting.
+	if s.raft.State() != raft.Leader {
+		return nil, fmt.Errorf("not leader")
+	}
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	// Register before reading so an entry added in between is not missed.
+	keys := []string{key}
+	wake := s.watch(keys)
+	defer s.unwatch(keys, wake)
+
+	for {
+		// Only propose a read when it has an effect, so an idle waiter does
+		// not write an empty entry to the Raft log.
+		ready, err := s.readable(key, group, consumer)
+		if err != nil {
+			return nil, err
+		}
+		if ready {
+			entries, err := s.XReadGroup(group, consumer, key, ">", opts)
+			if err != nil || len(entries) > 0 {
+				return entries, err
+			}
+		}
+
+		select {
+		case <-wake:
+		case <-expired:
+			return nil, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// readable reports whether a read of new entries by consumer in group would
+// return entries or register the consumer. Like a read, it fails if the
+// stream or the group does not exist.
+func (s *Store) readable(key, group, consumer string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, err := s.group(key, group)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := g.Consumers[consumer]; !ok {
+		return true, nil
+	}
+	st, _ := s.stream(key)
+	return len(st.after(g.LastDelivered, 1)) > 0, nil
+}
+
+// XAck removes entries from the pending entries list of group and returns
+// the number of entries that were acknowledged.
+func (s *Store) XAck(key, group string, ids ...string) (int, error) {
+	c := &command{
+		Op:    "xack",
+		Key:   key,
+		Group: group,
+	}
+	for _, id := range ids {
+		parsed, err := ParseStreamID(id)
+		if err != nil {
+			return 0, err
+		}
+		c.IDs = append(c.IDs, parsed.String())
+	}
+	resp, err := s.apply(c)
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// XPending summarizes the pending entries list of group.
+func (s *Store) XPending(key, group string) (PendingSummary, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, err := s.group(key, group)
+	if err != nil {
+		return PendingSummary{}, err
+	}
+	summary := PendingSummary{Count: len(g.Pending), Consumers: make(map[string]int)}
+	for i, id := range g.pendingIDs() {
+		if i == 0 {
+			summary.Lowest = id
+		}
+		summary.Highest = id
+		summary.Consumers[g.Pending[id].Consumer]++
+	}
+	return summary, nil
+}
+
+// XPendingRange returns up to count pending entries of group with IDs
+// between start and end inclusive that have been idle for at least minIdle.
+// If consumer is not empty only its entries are returned.
+func (s *Store) XPendingRange(key, group, start, end string, count int, consumer string, minIdle time.Duration) ([]PendingEntry, error) {
+	from, err := parseRangeStart(start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseRangeEnd(end)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, err := s.group(key, group)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	var entries []PendingEntry
+	for _, id := range g.pendingIDs() {
+		if len(entries) == count {
+			break
+		}
+		if id.less(from) || to.less(id) {
+			continue
+		}
+		p := g.Pending[id]
+		idle := time.Duration(max(now-p.DeliveredAt, 0)) * time.Millisecond
+		if (consumer != "" && p.Consumer != consumer) || idle < minIdle {
+			continue
+		}
+		entries = append(entries, PendingEntry{
+			ID:         id,
+			Consumer:   p.Consumer,
+			Idle:       idle,
+			Deliveries: p.Deliveries,
+		})
+	}
+	return entries, nil
+}
+
+// XClaim transfers pending entries of group that have been idle for at
+// least minIdle to consumer, and returns them. Entries that were trimmed
+// from the stream are removed from the pending entries list instead.
+func (s *Store) XClaim(key, group, consumer string, minIdle time.Duration, ids ...string) ([]StreamEntry, error) {
+	c := &command{
+		Op:       "xclaim",
+		Key:      key,
+		Group:    group,
+		Consumer: consumer,
+		MinIdle:  minIdle.Milliseconds(),
+	}
+	for _, id := range ids {
+		parsed, err := ParseStreamID(id)
+		if err != nil {
+			return nil, err
+		}
+		c.IDs = append(c.IDs, parsed.String())
+	}
+	resp, err := s.apply(c)
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]StreamEntry), nil
+}
+
+// stream returns the stream stored at key, or nil if the key does not
+// exist. It must be called with the lock held.
+func (s *Store) stream(key string) (*streamValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	st, ok := item.value.(*streamValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return st, nil
+}
+
+// group returns a consumer group of the stream stored at key. It must be
+// called with the lock held.
+func (s *Store) group(key, group string) (*consumerGroup, error) {
+	st, err := s.stream(key)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, ErrKeyNotFound
+	}
+	g, ok := st.groups[group]
+	if !ok {
+		return nil, ErrGroupNotFound
+	}
+	return g, nil
+}
+
+// trim removes entries from the head of the stream so that at most maxLen
+// remain, or if maxLen is negative, those with IDs less than minID.
+func (st *streamValue) trim(maxLen int64, minID StreamID) int {
+	n := 0
+	if maxLen >= 0 {
+		n = max(len(st.entries)-int(min(maxLen, math.MaxInt32)), 0)
+	} else {
+		n = st.search(minID)
+	}
+	st.entries = st.entries[n:]
+	return n
+}
+
+// streamItem returns the stream stored at key. It must be called with the
+// lock held.
+func (f *fsm) streamItem(key string, now time.Time) (*streamValue, error) {
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	st, ok := item.value.(*streamValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return st, nil
+}
+
+// groupItem returns a consumer group of the stream stored at key. It must
+// be called with the lock held.
+func (f *fsm) groupItem(key, group string, now time.Time) (*streamValue, *consumerGroup, error) {
+	st, err := f.streamItem(key, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	g, ok := st.groups[group]
+	if !ok {
+		return nil, nil, ErrGroupNotFound
+	}
+	return st, g, nil
+}
+
+func (f *fsm) applyXAdd(key, id string, fields map[string]string, maxLen int64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: newStream()}
+	}
+	st, ok := item.value.(*streamValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	// Generated IDs come from the time of the log entry, so every replica
+	// assigns the same one.
+	var newID StreamID
+	switch msPart, seqPart, _ := strings.Cut(id, "-"); {
+	case id == "*":
+		ms := uint64(now.UnixMilli())
+		if ms > st.lastID.Ms {
+			newID = StreamID{Ms: ms}
+		} else {
+			newID = st.lastID.next()
+		}
+	case seqPart == "*":
+		ms, _ := strconv.ParseUint(msPart, 10, 64)
+		switch {
+		case ms < st.lastID.Ms:
+			return ErrStreamIDTooSmall
+		case ms == st.lastID.Ms:
+			if st.lastID.Seq == math.MaxUint64 {
+				return ErrStreamIDTooSmall
+			}
+			newID = st.lastID.next()
+		default:
+			newID = StreamID{Ms: ms}
+		}
+	default:
+		newID, _ = ParseStreamID(id)
+	}
+	if !st.lastID.less(newID) {
+		return ErrStreamIDTooSmall
+	}
+
+	st.entries = append(st.entries, StreamEntry{ID: newID, Fields: fields})
+	st.lastID = newID
+	if maxLen >= 0 {
+		st.trim(maxLen, StreamID{})
+	}
+	f.cache.Add(key, item)
+	f.wake(key)
+	return newID
+}
+
+func (f *fsm) applyXTrim(key string, maxLen int64, minID string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	st, err := f.streamItem(key, now)
+	if errors.Is(err, ErrKeyNotFound) {
+		return 0
+	}
+	if err != nil {
+		return err
+	}
+
+	var id StreamID
+	if maxLen < 0 {
+		id, _ = ParseStreamID(minID)
+	}
+	return st.trim(maxLen, id)
+}
+
+func (f *fsm) applyXGroupCreate(key, group, id string, mkStream bool, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		if !mkStream {
+			return ErrKeyNotFound
+		}
+		item = cacheItem{value: newStream()}
+		f.cache.Add(key, item)
+	}
+	st, ok := item.value.(*streamValue)
+	if !ok {
+		return ErrWrongType
+	}
+	if _, ok := st.groups[group]; ok {
+		return ErrGroupExists
+	}
+
+	start := st.lastID
+	if id != "$" {
+		start, _ = ParseStreamID(id)
+	}
+	st.groups[group] = &consumerGroup{
+		LastDelivered: start,
+		Pending:       make(map[StreamID]*pendingItem),
+		Consumers:     make(map[string]int64),
+	}
+	return nil
+}
+
+func (f *fsm) applyXReadGroup(key, group, consumer, id string, count int, noAck bool, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	st, g, err := f.groupItem(key, group, now)
+	if err != nil {
+		return err
+	}
+	ms := now.UnixMilli()
+	g.Consumers[consumer] = ms
+
+	entries := []StreamEntry{}
+	if id == ">" {
+		entries = st.after(g.LastDelivered, count)
+		for _, e := range entries {
+			g.LastDelivered = e.ID
+			if !noAck {
+				g.Pending[e.ID] = &pendingItem{Consumer: consumer, DeliveredAt: ms, Deliveries: 1}
+			}
+		}
+		return entries
+	}
+
+	// Re-read the consumer's own pending entries. Entries trimmed from the
+	// stream are returned without fields.
+	after, _ := ParseStreamID(id)
+	for _, pid := range g.pendingIDs() {
+		if count > 0 && len(entries) == count {
+			break
+		}
+		if !after.less(pid) || g.Pending[pid].Consumer != consumer {
+			continue
+		}
+		e, ok := st.entry(pid)
+		if !ok {
+			e = StreamEntry{ID: pid}
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func (f *fsm) applyXAck(key, group string, ids []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, g, err := f.groupItem(key, group, now)
+	if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrGroupNotFound) {
+		return 0
+	}
+	if err != nil {
+		return err
+	}
+
+	acked := 0
+	for _, s := range ids {
+		id, _ := ParseStreamID(s)
+		if _, ok := g.Pending[id]; ok {
+			delete(g.Pending, id)
+			acked++
+		}
+	}
+	return acked
+}
+
+func (f *fsm) applyXClaim(key, group, consumer string, minIdle int64, ids []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	st, g, err := f.groupItem(key, group, now)
+	if err != nil {
+		return err
+	}
+	ms := now.UnixMilli()
+	g.Consumers[consumer] = ms
+
+	claimed := []StreamEntry{}
+	for _, s := range ids {
+		id, _ := ParseStreamID(s)
+		p, ok := g.Pending[id]
+		if !ok || ms-p.DeliveredAt < minIdle {
+			continue
+		}
+		e, ok := st.entry(id)
+		if !ok {
+			delete(g.Pending, id)
+			continue
+		}
+		p.Consumer, p.DeliveredAt = consumer, ms
+		p.Deliveries++
+		claimed = append(claimed, e)
+	}
+	return claimed
+}
@@ -0,0 +1,260 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStreamIDRange(t *testing.T) {
+	max := StreamID{Ms: math.MaxUint64, Seq: math.MaxUint64}
+	tests := []struct {
+		s          string
+		start, end StreamID
+		wantErr    bool
+	}{
+		{"-", StreamID{}, StreamID{}, false},
+		{"+", StreamID{}, max, false},
+		{"5", StreamID{Ms: 5}, StreamID{Ms: 5, Seq: math.MaxUint64}, false},
+		{"5-3", StreamID{Ms: 5, Seq: 3}, StreamID{Ms: 5, Seq: 3}, false},
+		{"(5-3", StreamID{Ms: 5, Seq: 4}, StreamID{Ms: 5, Seq: 2}, false},
+		{"(5-0", StreamID{Ms: 5, Seq: 1}, StreamID{Ms: 4, Seq: math.MaxUint64}, false},
+		{"x", StreamID{}, StreamID{}, true},
+		{"5-x", StreamID{}, StreamID{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			start, err := parseRangeStart(tt.s)
+			if tt.s != "+" {
+				if tt.wantErr != (err != nil) || start != tt.start {
+					t.Fatalf("parseRangeStart = %v, %v, want %v", start, err, tt.start)
+				}
+			}
+			end, err := parseRangeEnd(tt.s)
+			if tt.s != "-" {
+				if tt.wantErr != (err != nil) || end != tt.end {
+					t.Fatalf("parseRangeEnd = %v, %v, want %v", end, err, tt.end)
+				}
+			}
+		})
+	}
+
+	if _, err := parseRangeStart("(18446744073709551615-18446744073709551615"); !errors.Is(err, ErrInvalidStreamID) {
+		t.Fatalf("exclusive start past the largest ID = %v, want %v", err, ErrInvalidStreamID)
+	}
+	if _, err := parseRangeEnd("(0-0"); !errors.Is(err, ErrInvalidStreamID) {
+		t.Fatalf("exclusive end before the smallest ID = %v, want %v", err, ErrInvalidStreamID)
+	}
+}
+
+func TestXAdd(t *testing.T) {
+	s := openStore(t)
+	fields := map[string]string{"f": "v"}
+
+	tests := []struct {
+		id      string
+		want    StreamID
+		wantErr error
+	}{
+		{"0-0", StreamID{}, ErrInvalidStreamID},
+		{"5-1", StreamID{Ms: 5, Seq: 1}, nil},
+		{"5-1", StreamID{}, ErrStreamIDTooSmall},
+		{"4-9", StreamID{}, ErrStreamIDTooSmall},
+		{"5-*", StreamID{Ms: 5, Seq: 2}, nil},
+		{"6-*", StreamID{Ms: 6}, nil},
+		{"7", StreamID{Ms: 7}, nil},
+		{"x-*", StreamID{}, ErrInvalidStreamID},
+	}
+	for _, tt := range tests {
+		got, err := s.XAdd("st", tt.id, fields, -1)
+		if !errors.Is(err, tt.wantErr) {
+			t.Fatalf("XAdd(%s) = %v, want %v", tt.id, err, tt.wantErr)
+		}
+		if err == nil && got != tt.want {
+			t.Fatalf("XAdd(%s) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+
+	id, err := s.XAdd("st", "*", fields, -1)
+	if err != nil || !(StreamID{Ms: 7}).less(id) {
+		t.Fatalf("XAdd(*) = %v, %v, want an ID after 7-0", id, err)
+	}
+	if n, err := s.XLen("st"); err != nil || n != 5 {
+		t.Fatalf("XLen = %d, %v, want 5", n, err)
+	}
+	if _, err := s.XAdd("st", "*", fields, 2); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.XLen("st"); err != nil || n != 2 {
+		t.Fatalf("XLen after MAXLEN 2 = %d, %v, want 2", n, err)
+	}
+}
+
+func TestXRange(t *testing.T) {
+	s := openStore(t)
+	for _, id := range []string{"1-1", "1-2", "2-1", "3-1"} {
+		if _, err := s.XAdd("st", id, map[string]string{"id": id}, -1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ids := func(entries []StreamEntry) []string {
+		var out []string
+		for _, e := range entries {
+			out = append(out, e.ID.String())
+		}
+		return out
+	}
+	tests := []struct {
+		name string
+		call func() ([]StreamEntry, error)
+		want []string
+	}{
+		{"all", func() ([]StreamEntry, error) { return s.XRange("st", "-", "+", 0) }, []string{"1-1", "1-2", "2-1", "3-1"}},
+		{"by millisecond", func() ([]StreamEntry, error) { return s.XRange("st", "1", "1", 0) }, []string{"1-1", "1-2"}},
+		{"exclusive", func() ([]StreamEntry, error) { return s.XRange("st", "(1-1", "(3-1", 0) }, []string{"1-2", "2-1"}},
+		{"count", func() ([]StreamEntry, error) { return s.XRange("st", "-", "+", 2) }, []string{"1-1", "1-2"}},
+		{"reverse", func() ([]StreamEntry, error) { return s.XRevRange("st", "+", "2", 0) }, []string{"3-1", "2-1"}},
+		{"missing key", func() ([]StreamEntry, error) { return s.XRange("missing", "-", "+", 0) }, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g := ids(got); !reflect.DeepEqual(g, tt.want) {
+				t.Fatalf("got %v, want %v", g, tt.want)
+			}
+		})
+	}
+
+	if n, err := s.XTrimMinID("st", "2"); err != nil || n != 2 {
+		t.Fatalf("XTrimMinID = %d, %v, want 2", n, err)
+	}
+	if n, err := s.XTrimMaxLen("st", 1); err != nil || n != 1 {
+		t.Fatalf("XTrimMaxLen = %d, %v, want 1", n, err)
+	}
+	if got, err := s.XRange("st", "-", "+", 0); err != nil || len(got) != 1 || got[0].ID.String() != "3-1" {
+		t.Fatalf("XRange after trimming = %v, %v, want [3-1]", got, err)
+	}
+}
+
+func TestConsumerGroup(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.XGroupCreate("st", "g", "$", false); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("XGroupCreate without MKSTREAM = %v, want %v", err, ErrKeyNotFound)
+	}
+	if err := s.XGroupCreate("st", "g", "0", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.XGroupCreate("st", "g", "0", true); !errors.Is(err, ErrGroupExists) {
+		t.Fatalf("XGroupCreate twice = %v, want %v", err, ErrGroupExists)
+	}
+	if _, err := s.XReadGroup("missing", "c", "st", ">", XReadGroupOptions{}); !errors.Is(err, ErrGroupNotFound) {
+		t.Fatalf("XReadGroup on a missing group = %v, want %v", err, ErrGroupNotFound)
+	}
+
+	for _, id := range []string{"1-1", "2-1", "3-1"} {
+		if _, err := s.XAdd("st", id, map[string]string{"f": "v"}, -1); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Each new entry goes to one consumer only.
+	a, err := s.XReadGroup("g", "alice", "st", ">", XReadGroupOptions{Count: 2})
+	if err != nil || len(a) != 2 || a[0].ID.String() != "1-1" {
+		t.Fatalf("XReadGroup(alice) = %v, %v, want 1-1 and 2-1", a, err)
+	}
+	b, err := s.XReadGroup("g", "bob", "st", ">", XReadGroupOptions{})
+	if err != nil || len(b) != 1 || b[0].ID.String() != "3-1" {
+		t.Fatalf("XReadGroup(bob) = %v, %v, want 3-1", b, err)
+	}
+	if got, err := s.XReadGroup("g", "bob", "st", ">", XReadGroupOptions{}); err != nil || len(got) != 0 {
+		t.Fatalf("XReadGroup with nothing new = %v, %v, want nothing", got, err)
+	}
+
+	// Reading from an ID returns the consumer's own pending entries.
+	if got, err := s.XReadGroup("g", "alice", "st", "1-1", XReadGroupOptions{}); err != nil || len(got) != 1 || got[0].ID.String() != "2-1" {
+		t.Fatalf("XReadGroup(alice, 1-1) = %v, %v, want 2-1", got, err)
+	}
+
+	summary, err := s.XPending("st", "g")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Count != 3 || summary.Lowest.String() != "1-1" || summary.Highest.String() != "3-1" ||
+		summary.Consumers["alice"] != 2 || summary.Consumers["bob"] != 1 {
+		t.Fatalf("XPending = %+v", summary)
+	}
+	pending, err := s.XPendingRange("st", "g", "-", "+", 10, "alice", 0)
+	if err != nil || len(pending) != 2 || pending[0].ID.String() != "1-1" || pending[0].Deliveries != 1 {
+		t.Fatalf("XPendingRange(alice) = %+v, %v, want 1-1 and 2-1", pending, err)
+	}
+
+	if n, err := s.XAck("st", "g", "1-1", "9-9"); err != nil || n != 1 {
+		t.Fatalf("XAck = %d, %v, want 1", n, err)
+	}
+	if claimed, err := s.XClaim("st", "g", "bob", time.Hour, "2-1"); err != nil || len(claimed) != 0 {
+		t.Fatalf("XClaim of a recently delivered entry = %v, %v, want nothing", claimed, err)
+	}
+	if claimed, err := s.XClaim("st", "g", "bob", 0, "2-1"); err != nil || len(claimed) != 1 {
+		t.Fatalf("XClaim = %v, %v, want 2-1", claimed, err)
+	}
+	if summary, err := s.XPending("st", "g"); err != nil || summary.Consumers["bob"] != 2 || summary.Consumers["alice"] != 0 {
+		t.Fatalf("XPending after XClaim = %+v, %v", summary, err)
+	}
+}
+
+func TestXReadGroupBlock(t *testing.T) {
+	s := openStore(t)
+	if err := s.XGroupCreate("st", "g", "$", true); err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		s.XAdd("st", "*", map[string]string{"f": "v"}, -1)
+	}()
+	got, err := s.XReadGroupBlock(context.Background(), "g", "c", "st", XReadGroupOptions{}, 5*time.Second)
+	if err != nil || len(got) != 1 {
+		t.Fatalf("XReadGroupBlock = %v, %v, want the new entry", got, err)
+	}
+
+	if got, err := s.XReadGroupBlock(context.Background(), "g", "c", "st", XReadGroupOptions{}, 50*time.Millisecond); err != nil || got != nil {
+		t.Fatalf("XReadGroupBlock after the timeout = %v, %v, want nothing", got, err)
+	}
+}
+
+// TestXReadGroupBlockIdle checks that a known consumer waiting for new
+// entries does not write to the Raft log.
+func TestXReadGroupBlockIdle(t *testing.T) {
+	s := openStore(t)
+	if err := s.XGroupCreate("st", "g", "$", true); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first read registers the consumer, so it goes through the log.
+	before := s.raft.LastIndex()
+	if got, err := s.XReadGroupBlock(context.Background(), "g", "c", "st", XReadGroupOptions{}, 50*time.Millisecond); err != nil || got != nil {
+		t.Fatalf("XReadGroupBlock = %v, %v, want nothing", got, err)
+	}
+	if after := s.raft.LastIndex(); after != before+1 {
+		t.Fatalf("registering a consumer advanced the log from %d to %d, want %d", before, after, before+1)
+	}
+
+	before = s.raft.LastIndex()
+	if got, err := s.XReadGroupBlock(context.Background(), "g", "c", "st", XReadGroupOptions{}, 100*time.Millisecond); err != nil || got != nil {
+		t.Fatalf("XReadGroupBlock = %v, %v, want nothing", got, err)
+	}
+	if after := s.raft.LastIndex(); after != before {
+		t.Fatalf("an idle XReadGroupBlock advanced the log from %d to %d", before, after)
+	}
+	if _, err := s.XReadGroupBlock(context.Background(), "missing", "c", "st", XReadGroupOptions{}, time.Second); !errors.Is(err, ErrGroupNotFound) {
+		t.Fatalf("XReadGroupBlock on a missing group = %v, want %v", err, ErrGroupNotFound)
+	}
+}
@@ -0,0 +1,287 @@
+package store
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrStringTooLong is returned when a command would grow a string past
+// maxStringLength.
+var ErrStringTooLong = errors.New("string exceeds maximum allowed size")
+
+// maxStringLength is the largest string APPEND and SETRANGE create, 512 MB
+// as in Redis.
+const maxStringLength = 512 << 20
+
+// Append appends value to the string stored at key, creating the key if it
+// does not exist, and returns the new length of the string. The key keeps
+// its timeout.
+func (s *Store) Append(key, value string) (int64, error) {
+	resp, err := s.apply(&command{
+		Op:   "append",
+		Key:  key,
+		Data: []byte(value),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int64), nil
+}
+
+// GetRange returns the bytes between start and end inclusive of the string
+// stored at key. Negative offsets count from the end of the string. A
+// missing key holds the empty string.
+func (s *Store) GetRange(key string, start, end int64) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, err := s.str(key)
+	if err != nil {
+		return "", err
+	}
+	start, end, ok := byteRange(start, end, int64(len(v)))
+	if !ok {
+		return "", nil
+	}
+	return v[start : end+1], nil
+}
+
+// SetRange overwrites the string stored at key with value starting at
+// offset, padding it with zero bytes as needed, and returns the new length
+// of the string. The key keeps its timeout. An empty value leaves the key
+// unchanged and does not create it.
+func (s *Store) SetRange(key string, offset int64, value string) (int64, error) {
+	if offset < 0 {
+		return 0, ErrIndexOutOfRange
+	}
+	if offset > maxStringLength-int64(len(value)) {
+		return 0, ErrStringTooLong
+	}
+	resp, err := s.apply(&command{
+		Op:     "setrange",
+		Key:    key,
+		Offset: offset,
+		Data:   []byte(value),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int64), nil
+}
+
+// StrLen returns the length of the string stored at key, or 0 if the key
+// does not exist.
+func (s *Store) StrLen(key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, err := s.str(key)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(v)), nil
+}
+
+// GetDel atomically returns the string stored at key and deletes the key.
+// A key holding another type is not deleted.
+func (s *Store) GetDel(key string) (string, error) {
+	resp, err := s.apply(&command{
+		Op:  "getdel",
+		Key: key,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.(string), nil
+}
+
+// GetEx returns the string stored at key and atomically updates its
+// timeout: a non-zero deadline sets the time at which the key expires, and
+// persist removes the timeout. A deadline in the past deletes the key after
+// reading it. With neither, GetEx is a plain Get and does not go through
+// Raft.
+func (s *Store) GetEx(key string, deadline time.Time, persist bool) (string, error) {
+	if deadline.IsZero() && !persist {
+		return s.Get(key)
+	}
+	c := &command{
+		Op:  "getex",
+		Key: key,
+	}
+	if persist {
+		c.Flags = []string{"persist"}
+	} else {
+		c.Expiration = deadline.UnixNano()
+	}
+	resp, err := s.apply(c)
+	if err != nil {
+		return "", err
+	}
+	return resp.(string), nil
+}
+
+// KeyValue is a key and the string to store at it.
+type KeyValue struct {
+	Key   string
+	Value string
+}
+
+// MGet returns the strings stored at keys in one read. For each key it also
+// reports whether it holds a string; missing keys and keys holding another
+// type are reported as misses rather than errors, as in Redis.
+func (s *Store) MGet(keys ...string) ([]string, []bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values := make([]string, len(keys))
+	found := make([]bool, len(keys))
+	for i, key := range keys {
+		item, ok := s.peek(key)
+		if !ok {
+			continue
+		}
+		values[i], found[i] = item.value.(string)
+	}
+	return values, found
+}
+
+// MSet sets the given keys in a single Raft entry, so either every key is
+// set or none is. Like Set, it removes any timeouts. If a key is given more
+// than once, the last value wins.
+func (s *Store) MSet(pairs []KeyValue) error {
+	_, err := s.apply(msetCommand(pairs, false))
+	return err
+}
+
+// MSetNX sets the given keys like MSet, but only if none of them exists. It
+// reports whether the keys were set.
+func (s *Store) MSetNX(pairs []KeyValue) (bool, error) {
+	resp, err := s.apply(msetCommand(pairs, true))
+	if err != nil {
+		return false, err
+	}
+	return resp.(bool), nil
+}
+
+func msetCommand(pairs []KeyValue, nx bool) *command {
+	c := &command{
+		Op:    "mset",
+		Keys:  make([]string, len(pairs)),
+		Blobs: make([][]byte, len(pairs)),
+	}
+	for i, p := range pairs {
+		c.Keys[i], c.Blobs[i] = p.Key, []byte(p.Value)
+	}
+	if nx {
+		c.Flags = []string{"nx"}
+	}
+	return c
+}
+
+func (f *fsm) applyAppend(key, value string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: ""}
+	}
+	v, err := item.str()
+	if err != nil {
+		return err
+	}
+	if len(v) > maxStringLength-len(value) {
+		return ErrStringTooLong
+	}
+
+	item.value = v + value
+	f.cache.Add(key, item)
+	return int64(len(v) + len(value))
+}
+
+func (f *fsm) applySetRange(key string, offset int64, value string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: ""}
+	}
+	v, err := item.str()
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		return int64(len(v))
+	}
+
+	b := []byte(v)
+	if end := int(offset) + len(value); end > len(b) {
+		b = append(b, make([]byte, end-len(b))...)
+	}
+	copy(b[offset:], value)
+
+	item.value = string(b)
+	f.cache.Add(key, item)
+	return int64(len(b))
+}
+
+func (f *fsm) applyGetDel(key string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return ErrKeyNotFound
+	}
+	v, err := item.str()
+	if err != nil {
+		return err
+	}
+	f.cache.Remove(key)
+	return v
+}
+
+func (f *fsm) applyGetEx(key string, deadline time.Time, persist bool, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return ErrKeyNotFound
+	}
+	v, err := item.str()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case persist:
+		item.expiration = time.Time{}
+	case !now.Before(deadline):
+		f.cache.Remove(key)
+		return v
+	default:
+		item.expiration = deadline
+	}
+	f.cache.Add(key, item)
+	f.trackDeadline(key, item)
+	return v
+}
+
+func (f *fsm) applyMSet(keys []string, values [][]byte, nx bool, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if nx {
+		for _, key := range keys {
+			if _, ok := f.lookup(key, now); ok {
+				return false
+			}
+		}
+	}
+	for i, key := range keys {
+		f.cache.Add(key, cacheItem{value: string(values[i])})
+	}
+	return true
+}
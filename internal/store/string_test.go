@@ -0,0 +1,195 @@
+package store
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAppendAndSetRange(t *testing.T) {
+	s := openStore(t)
+
+	if n, err := s.Append("k", "Hello"); err != nil || n != 5 {
+		t.Fatalf("Append to a missing key = %d, %v, want 5", n, err)
+	}
+	if n, err := s.Append("k", " World"); err != nil || n != 11 {
+		t.Fatalf("Append = %d, %v, want 11", n, err)
+	}
+	if n, err := s.SetRange("k", 6, "Redis"); err != nil || n != 11 {
+		t.Fatalf("SetRange = %d, %v, want 11", n, err)
+	}
+	if v, err := s.Get("k"); err != nil || v != "Hello Redis" {
+		t.Fatalf("Get = %q, %v, want %q", v, err, "Hello Redis")
+	}
+
+	if n, err := s.SetRange("padded", 3, "x"); err != nil || n != 4 {
+		t.Fatalf("SetRange past the end = %d, %v, want 4", n, err)
+	}
+	if v, err := s.Get("padded"); err != nil || v != "\x00\x00\x00x" {
+		t.Fatalf("Get = %q, %v, want zero padding", v, err)
+	}
+	if n, err := s.SetRange("empty", 10, ""); err != nil || n != 0 {
+		t.Fatalf("SetRange with an empty value = %d, %v, want 0", n, err)
+	}
+	if _, err := s.Get("empty"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("SetRange with an empty value created the key: %v", err)
+	}
+
+	// Both keep the timeout of the key.
+	deadline := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	if _, err := s.ExpireAt("k", deadline); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Append("k", "!"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SetRange("k", 0, "J"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.ExpireTime("k"); err != nil || !got.Equal(deadline) {
+		t.Fatalf("ExpireTime = %v, %v, want %v", got, err, deadline)
+	}
+
+	if _, err := s.SetRange("k", -1, "x"); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("SetRange at a negative offset = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if _, err := s.SetRange("k", maxStringLength, "x"); !errors.Is(err, ErrStringTooLong) {
+		t.Fatalf("SetRange past the maximum length = %v, want %v", err, ErrStringTooLong)
+	}
+	if _, err := s.HSet("h", map[string]string{"f": "v"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Append("h", "x"); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("Append to a hash = %v, want %v", err, ErrWrongType)
+	}
+}
+
+func TestGetRangeAndStrLen(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.Set("k", "This is a string"); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		start, end int64
+		want       string
+	}{
+		{0, 3, "This"},
+		{-3, -1, "ing"},
+		{0, -1, "This is a string"},
+		{10, 100, "string"},
+		{5, 3, ""},
+		{100, 200, ""},
+	}
+	for _, tt := range tests {
+		if got, err := s.GetRange("k", tt.start, tt.end); err != nil || got != tt.want {
+			t.Errorf("GetRange(%d, %d) = %q, %v, want %q", tt.start, tt.end, got, err, tt.want)
+		}
+	}
+	if got, err := s.GetRange("missing", 0, -1); err != nil || got != "" {
+		t.Fatalf("GetRange of a missing key = %q, %v, want empty", got, err)
+	}
+
+	if n, err := s.StrLen("k"); err != nil || n != 16 {
+		t.Fatalf("StrLen = %d, %v, want 16", n, err)
+	}
+	if n, err := s.StrLen("missing"); err != nil || n != 0 {
+		t.Fatalf("StrLen of a missing key = %d, %v, want 0", n, err)
+	}
+}
+
+func TestGetDelAndGetEx(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.Set("k", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := s.GetDel("k"); err != nil || v != "v" {
+		t.Fatalf("GetDel = %q, %v, want %q", v, err, "v")
+	}
+	if _, err := s.GetDel("k"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("GetDel of a deleted key = %v, want %v", err, ErrKeyNotFound)
+	}
+	if _, err := s.RPush("list", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.GetDel("list"); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("GetDel of a list = %v, want %v", err, ErrWrongType)
+	}
+	if got, err := s.LRange("list", 0, -1); err != nil || len(got) != 1 {
+		t.Fatal("GetDel deleted a key of another type")
+	}
+
+	if err := s.Set("k", "v"); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	if v, err := s.GetEx("k", deadline, false); err != nil || v != "v" {
+		t.Fatalf("GetEx = %q, %v, want %q", v, err, "v")
+	}
+	if got, err := s.ExpireTime("k"); err != nil || !got.Equal(deadline) {
+		t.Fatalf("ExpireTime after GetEx = %v, %v, want %v", got, err, deadline)
+	}
+	if _, err := s.GetEx("k", time.Time{}, true); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.ExpireTime("k"); err != nil || !got.IsZero() {
+		t.Fatalf("ExpireTime after GetEx persist = %v, %v, want the zero time", got, err)
+	}
+	if v, err := s.GetEx("k", time.Now().Add(-time.Second), false); err != nil || v != "v" {
+		t.Fatalf("GetEx with a past deadline = %q, %v, want %q", v, err, "v")
+	}
+	if _, err := s.Get("k"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("Get after GetEx with a past deadline = %v, want %v", err, ErrKeyNotFound)
+	}
+	if _, err := s.GetEx("k", deadline, false); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("GetEx of a missing key = %v, want %v", err, ErrKeyNotFound)
+	}
+}
+
+func TestMGetMSet(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.MSet([]KeyValue{{"a", "1"}, {"b", "2"}, {"a", "3"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RPush("list", "x"); err != nil {
+		t.Fatal(err)
+	}
+	values, found := s.MGet("a", "b", "missing", "list")
+	if want := []string{"3", "2", "", ""}; !reflect.DeepEqual(values, want) {
+		t.Fatalf("MGet values = %q, want %q", values, want)
+	}
+	if want := []bool{true, true, false, false}; !reflect.DeepEqual(found, want) {
+		t.Fatalf("MGet found = %v, want %v", found, want)
+	}
+
+	// MSet overwrites other types and removes timeouts, like Set.
+	if _, err := s.ExpireAt("b", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.MSet([]KeyValue{{"b", "4"}, {"list", "5"}}); err != nil {
+		t.Fatal(err)
+	}
+	if deadline, err := s.ExpireTime("b"); err != nil || !deadline.IsZero() {
+		t.Fatalf("ExpireTime after MSet = %v, %v, want the zero time", deadline, err)
+	}
+	if v, err := s.Get("list"); err != nil || v != "5" {
+		t.Fatalf("Get = %q, %v, want %q", v, err, "5")
+	}
+
+	// MSetNX sets nothing if any key exists.
+	if set, err := s.MSetNX([]KeyValue{{"c", "1"}, {"a", "1"}}); err != nil || set {
+		t.Fatalf("MSetNX with an existing key = %v, %v, want false", set, err)
+	}
+	if _, err := s.Get("c"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("a failed MSetNX set a key: %v", err)
+	}
+	if set, err := s.MSetNX([]KeyValue{{"c", "1"}, {"d", "2"}}); err != nil || !set {
+		t.Fatalf("MSetNX = %v, %v, want true", set, err)
+	}
+	if values, _ := s.MGet("c", "d"); !reflect.DeepEqual(values, []string{"1", "2"}) {
+		t.Fatalf("MGet after MSetNX = %q", values)
+	}
+}
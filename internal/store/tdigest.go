@@ -0,0 +1,295 @@
+package store
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"sort"
+	"time"
+)
+
+// ErrInvalidQuantile is returned for a quantile outside [0, 1].
+var ErrInvalidQuantile = errors.New("quantile must be between 0 and 1")
+
+// tdigestMaxCompression bounds the compression of a t-digest, and with it
+// the number of centroids.
+const tdigestMaxCompression = 10000
+
+// tdigestValue is the value of a t-digest key. It is a merging t-digest:
+// samples are buffered and merged into the centroids, ordered by mean, once
+// the buffer fills. Products are rounded explicitly before being added so
+// the compiler cannot fuse them, keeping the arithmetic identical on every
+// replica.
+type tdigestValue struct {
+	Compression float64    `json:"compression"`
+	Centroids   []centroid `json:"centroids"`
+	Buffer      []float64  `json:"buffer,omitempty"`
+	Min         float64    `json:"min"`
+	Max         float64    `json:"max"`
+}
+
+type centroid struct {
+	Mean   float64 `json:"mean"`
+	Weight float64 `json:"weight"`
+}
+
+func (t *tdigestValue) clone() *tdigestValue {
+	c := *t
+	c.Centroids, c.Buffer = slices.Clone(t.Centroids), slices.Clone(t.Buffer)
+	return &c
+}
+
+// add buffers a sample, merging the buffer once it is full.
+func (t *tdigestValue) add(v float64) {
+	if len(t.Centroids) == 0 && len(t.Buffer) == 0 {
+		t.Min, t.Max = v, v
+	}
+	t.Min, t.Max = min(t.Min, v), max(t.Max, v)
+	t.Buffer = append(t.Buffer, v)
+	if len(t.Buffer) >= int(5*t.Compression) {
+		t.Centroids = t.merged()
+		t.Buffer = nil
+	}
+}
+
+// merged returns the centroids with the buffered samples merged in,
+// without modifying the digest. Neighbouring centroids are combined while
+// their weight stays within 4*n*q*(1-q)/compression, so centroids near the
+// tails stay small and keep extreme quantiles accurate.
+func (t *tdigestValue) merged() []centroid {
+	all := make([]centroid, 0, len(t.Centroids)+len(t.Buffer))
+	all = append(all, t.Centroids...)
+	for _, v := range t.Buffer {
+		all = append(all, centroid{Mean: v, Weight: 1})
+	}
+	if len(all) == 0 {
+		return nil
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].Mean < all[j].Mean })
+
+	var total float64
+	for _, c := range all {
+		total += c.Weight
+	}
+	out := []centroid{all[0]}
+	var before float64 // Weight of the centroids before the last one in out
+	for _, c := range all[1:] {
+		last := &out[len(out)-1]
+		w := last.Weight + c.Weight
+		q := (before + w/2) / total
+		if w <= max(1, 4*total*q*(1-q)/t.Compression) {
+			last.Mean += float64(c.Weight*(c.Mean-last.Mean)) / w
+			last.Weight = w
+			continue
+		}
+		before += last.Weight
+		out = append(out, c)
+	}
+	return out
+}
+
+// quantile returns the estimated value at quantile q of the samples summed
+// up by cs, interpolating between the centers of neighbouring centroids.
+func (t *tdigestValue) quantile(cs []centroid, q float64) float64 {
+	switch {
+	case len(cs) == 0:
+		return math.NaN()
+	case q <= 0:
+		return t.Min
+	case q >= 1:
+		return t.Max
+	case len(cs) == 1:
+		return cs[0].Mean
+	}
+
+	var total float64
+	for _, c := range cs {
+		total += c.Weight
+	}
+	target := q * total
+
+	// Below the center of the first centroid, interpolate from the minimum.
+	first := cs[0]
+	if target < first.Weight/2 {
+		return t.Min + float64(target/(first.Weight/2)*(first.Mean-t.Min))
+	}
+	center := first.Weight / 2
+	for i := 0; i < len(cs)-1; i++ {
+		next := center + (cs[i].Weight+cs[i+1].Weight)/2
+		if target < next {
+			return cs[i].Mean + float64((target-center)/(next-center)*(cs[i+1].Mean-cs[i].Mean))
+		}
+		center = next
+	}
+	// Above the center of the last centroid, interpolate to the maximum.
+	last := cs[len(cs)-1]
+	return min(t.Max, last.Mean+float64((target-center)/(last.Weight/2)*(t.Max-last.Mean)))
+}
+
+// cdf returns the estimated fraction of the samples summed up by cs that
+// are at most x.
+func (t *tdigestValue) cdf(cs []centroid, x float64) float64 {
+	switch {
+	case len(cs) == 0:
+		return math.NaN()
+	case x < t.Min:
+		return 0
+	case x >= t.Max:
+		return 1
+	case len(cs) == 1:
+		return (x - t.Min) / (t.Max - t.Min)
+	}
+
+	var total float64
+	for _, c := range cs {
+		total += c.Weight
+	}
+
+	first := cs[0]
+	if x < first.Mean {
+		return float64(first.Weight/2*(x-t.Min)/(first.Mean-t.Min)) / total
+	}
+	center := first.Weight / 2
+	for i := 0; i < len(cs)-1; i++ {
+		next := center + (cs[i].Weight+cs[i+1].Weight)/2
+		if x < cs[i+1].Mean {
+			return (center + float64((x-cs[i].Mean)/(cs[i+1].Mean-cs[i].Mean)*(next-center))) / total
+		}
+		center = next
+	}
+	last := cs[len(cs)-1]
+	return (center + float64((x-last.Mean)/(t.Max-last.Mean)*(last.Weight/2))) / total
+}
+
+// TDigestCreate creates an empty t-digest at key. Higher compression keeps
+// more centroids and gives more accurate quantiles. It fails if the key
+// exists.
+func (s *Store) TDigestCreate(key string, compression float64) error {
+	if !(compression >= 1 && compression <= tdigestMaxCompression) {
+		return ErrInvalidFilter
+	}
+	_, err := s.apply(&command{
+		Op:          "tdigestcreate",
+		Key:         key,
+		Compression: compression,
+	})
+	return err
+}
+
+// TDigestAdd adds samples to the t-digest stored at key.
+func (s *Store) TDigestAdd(key string, values ...float64) error {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return ErrNotFinite
+		}
+	}
+	_, err := s.apply(&command{
+		Op:      "tdigestadd",
+		Key:     key,
+		Samples: values,
+	})
+	return err
+}
+
+// TDigestQuantile returns the estimated value at each of quantiles in the
+// t-digest stored at key. An empty digest yields NaN.
+func (s *Store) TDigestQuantile(key string, quantiles ...float64) ([]float64, error) {
+	for _, q := range quantiles {
+		if !(q >= 0 && q <= 1) {
+			return nil, ErrInvalidQuantile
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.tdigest(key)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, ErrKeyNotFound
+	}
+	cs := t.merged()
+	values := make([]float64, len(quantiles))
+	for i, q := range quantiles {
+		values[i] = t.quantile(cs, q)
+	}
+	return values, nil
+}
+
+// TDigestCDF returns the estimated fraction of samples at most each of
+// values in the t-digest stored at key. An empty digest yields NaN.
+func (s *Store) TDigestCDF(key string, values ...float64) ([]float64, error) {
+	for _, v := range values {
+		if math.IsNaN(v) {
+			return nil, ErrNotFloat
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.tdigest(key)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, ErrKeyNotFound
+	}
+	cs := t.merged()
+	fractions := make([]float64, len(values))
+	for i, v := range values {
+		fractions[i] = t.cdf(cs, v)
+	}
+	return fractions, nil
+}
+
+// tdigest returns the t-digest stored at key, or nil if the key does not
+// exist. It must be called with the lock held.
+func (s *Store) tdigest(key string) (*tdigestValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	t, ok := item.value.(*tdigestValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return t, nil
+}
+
+func (f *fsm) applyTDigestCreate(key string, compression float64, now time.Time) interface{} {
+	if !(compression >= 1 && compression <= tdigestMaxCompression) {
+		return ErrInvalidFilter
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.lookup(key, now); ok {
+		return ErrKeyExists
+	}
+	f.cache.Add(key, cacheItem{value: &tdigestValue{Compression: compression}})
+	return nil
+}
+
+func (f *fsm) applyTDigestAdd(key string, values []float64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return ErrKeyNotFound
+	}
+	t, ok := item.value.(*tdigestValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	for _, v := range values {
+		t.add(v)
+	}
+	f.cache.Add(key, item)
+	return nil
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestTDigest(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.TDigestCreate("td", 100); err != nil {
+		t.Fatal(err)
+	}
+	if q, err := s.TDigestQuantile("td", 0.5); err != nil || !math.IsNaN(q[0]) {
+		t.Fatalf("TDigestQuantile of an empty digest = %v, %v, want NaN", q, err)
+	}
+
+	// Enough samples to merge the buffer several times.
+	r := rand.New(rand.NewSource(1))
+	values := make([]float64, 5000)
+	for i := range values {
+		values[i] = float64(r.Intn(1000))
+	}
+	for i := 0; i < len(values); i += 1000 {
+		if err := s.TDigestAdd("td", values[i:i+1000]...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	qs := []float64{0, 0.25, 0.5, 0.99, 1}
+	quantiles, err := s.TDigestQuantile("td", qs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, q := range qs {
+		if want := sorted[int(q*float64(len(sorted)-1))]; math.Abs(quantiles[i]-want) > 10 {
+			t.Errorf("quantile %v = %v, want %v within 10", q, quantiles[i], want)
+		}
+	}
+	fractions, err := s.TDigestCDF("td", -1, sorted[len(sorted)/4], 2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []float64{0, 0.25, 1} {
+		if math.Abs(fractions[i]-want) > 0.01 {
+			t.Errorf("cdf %d = %v, want %v within 0.01", i, fractions[i], want)
+		}
+	}
+
+	if err := s.TDigestAdd("td", math.Inf(1)); !errors.Is(err, ErrNotFinite) {
+		t.Fatalf("TDigestAdd(+Inf) = %v, want %v", err, ErrNotFinite)
+	}
+	if _, err := s.TDigestQuantile("td", 1.5); !errors.Is(err, ErrInvalidQuantile) {
+		t.Fatalf("TDigestQuantile(1.5) = %v, want %v", err, ErrInvalidQuantile)
+	}
+	if _, err := s.TDigestCDF("td", math.NaN()); !errors.Is(err, ErrNotFloat) {
+		t.Fatalf("TDigestCDF(NaN) = %v, want %v", err, ErrNotFloat)
+	}
+	if err := s.TDigestAdd("missing", 1); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("TDigestAdd on a missing key = %v, want %v", err, ErrKeyNotFound)
+	}
+	if err := s.TDigestCreate("td", 100); !errors.Is(err, ErrKeyExists) {
+		t.Fatalf("TDigestCreate on an existing key = %v, want %v", err, ErrKeyExists)
+	}
+	if err := s.TDigestCreate("bad", 0.5); !errors.Is(err, ErrInvalidFilter) {
+		t.Fatalf("TDigestCreate(0.5) = %v, want %v", err, ErrInvalidFilter)
+	}
+}
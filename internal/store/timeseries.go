@@ -0,0 +1,353 @@
+package store
+
+import (
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrDuplicateSample is returned when adding a sample at a timestamp the
+	// series already holds.
+	ErrDuplicateSample = errors.New("series already has a sample at this timestamp")
+	// ErrSampleTooOld is returned when adding a sample older than the
+	// retention period of its series.
+	ErrSampleTooOld = errors.New("timestamp is older than the retention period")
+	// ErrInvalidAggregation is returned for an unknown aggregation type or a
+	// bucket that is not positive.
+	ErrInvalidAggregation = errors.New("invalid aggregation")
+	// ErrInvalidLabelFilter is returned when a label filter cannot be parsed.
+	ErrInvalidLabelFilter = errors.New("invalid label filter")
+)
+
+// tsValue is the value of a time series key.
+type tsValue struct {
+	Retention int64             `json:"retention,omitempty"` // milliseconds, zero keeps samples forever
+	Labels    map[string]string `json:"labels,omitempty"`
+	Samples   []TSSample        `json:"samples"` // Ordered by timestamp
+}
+
+// TSSample is a value of a time series at a timestamp in Unix milliseconds.
+type TSSample struct {
+	Timestamp int64   `json:"t"`
+	Value     float64 `json:"v"`
+}
+
+// TSSeries is a time series returned by TSMRange.
+type TSSeries struct {
+	Key     string
+	Labels  map[string]string
+	Samples []TSSample
+}
+
+// TSAggregation summarizes the samples of each bucket of a range.
+type TSAggregation struct {
+	Type   string // avg, min, max, sum or count
+	Bucket int64  // milliseconds
+}
+
+// TSOptions configures a time series created by TSAdd. It is ignored when
+// the series already exists, as in Redis.
+type TSOptions struct {
+	Retention time.Duration // zero keeps samples forever
+	Labels    map[string]string
+}
+
+func (ts *tsValue) clone() *tsValue {
+	return &tsValue{Retention: ts.Retention, Labels: maps.Clone(ts.Labels), Samples: slices.Clone(ts.Samples)}
+}
+
+// cutoff returns the timestamp before which samples are past retention at
+// now, or math.MinInt64 if the series keeps samples forever.
+func (ts *tsValue) cutoff(now time.Time) int64 {
+	if ts.Retention == 0 {
+		return math.MinInt64
+	}
+	return now.UnixMilli() - ts.Retention
+}
+
+// search returns the index of the first sample at or after timestamp.
+func (ts *tsValue) search(timestamp int64) int {
+	return sort.Search(len(ts.Samples), func(i int) bool {
+		return ts.Samples[i].Timestamp >= timestamp
+	})
+}
+
+// rangeOf returns the samples between from and to inclusive that are not
+// past retention at now.
+func (ts *tsValue) rangeOf(from, to int64, now time.Time) []TSSample {
+	from = max(from, ts.cutoff(now))
+	if from > to {
+		return nil
+	}
+	lo, hi := ts.search(from), len(ts.Samples)
+	if to < math.MaxInt64 {
+		hi = ts.search(to + 1)
+	}
+	return ts.Samples[lo:hi]
+}
+
+// aggregate groups samples into buckets aligned to multiples of the bucket
+// duration and summarizes each one. A nil aggregation copies the samples.
+func aggregate(samples []TSSample, agg *TSAggregation) []TSSample {
+	if agg == nil {
+		return append([]TSSample{}, samples...)
+	}
+
+	out := []TSSample{}
+	for i := 0; i < len(samples); {
+		start := bucketStart(samples[i].Timestamp, agg.Bucket)
+		j := i
+		for j < len(samples) && samples[j].Timestamp-start < agg.Bucket {
+			j++
+		}
+		bucket := samples[i:j]
+
+		v := bucket[0].Value
+		switch agg.Type {
+		case "avg", "sum":
+			v = 0
+			for _, s := range bucket {
+				v += s.Value
+			}
+			if agg.Type == "avg" {
+				v /= float64(len(bucket))
+			}
+		case "min":
+			for _, s := range bucket {
+				v = min(v, s.Value)
+			}
+		case "max":
+			for _, s := range bucket {
+				v = max(v, s.Value)
+			}
+		case "count":
+			v = float64(len(bucket))
+		}
+		out = append(out, TSSample{Timestamp: start, Value: v})
+		i = j
+	}
+	return out
+}
+
+// bucketStart returns the start of the bucket holding timestamp, rounding
+// down for timestamps before the epoch too.
+func bucketStart(timestamp, bucket int64) int64 {
+	m := timestamp % bucket
+	if m < 0 {
+		m += bucket
+	}
+	return timestamp - m
+}
+
+func checkAggregation(agg *TSAggregation) error {
+	if agg == nil {
+		return nil
+	}
+	switch agg.Type {
+	case "avg", "min", "max", "sum", "count":
+	default:
+		return ErrInvalidAggregation
+	}
+	if agg.Bucket <= 0 {
+		return ErrInvalidAggregation
+	}
+	return nil
+}
+
+// labelFilter matches the value of a label against a set of values. An
+// empty value matches a missing label.
+type labelFilter struct {
+	label  string
+	values []string
+	negate bool
+}
+
+// parseLabelFilter parses a filter of the form label=value, label!=value,
+// label=(v1,v2) or label!=(v1,v2). label= matches series without the label
+// and label!= series with it.
+func parseLabelFilter(filter string) (labelFilter, error) {
+	i := strings.IndexByte(filter, '=')
+	if i < 1 {
+		return labelFilter{}, ErrInvalidLabelFilter
+	}
+	f := labelFilter{label: filter[:i]}
+	if strings.HasSuffix(f.label, "!") {
+		f.label, f.negate = f.label[:len(f.label)-1], true
+		if f.label == "" {
+			return labelFilter{}, ErrInvalidLabelFilter
+		}
+	}
+	value := filter[i+1:]
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		f.values = strings.Split(value[1:len(value)-1], ",")
+	} else {
+		f.values = []string{value}
+	}
+	return f, nil
+}
+
+func (f labelFilter) match(labels map[string]string) bool {
+	v := labels[f.label]
+	for _, want := range f.values {
+		if v == want {
+			return !f.negate
+		}
+	}
+	return f.negate
+}
+
+// TSAdd adds a sample to the time series stored at key, creating the series
+// with opts if it does not exist; the retention and labels of an existing
+// series are left as they are. The timestamp is in Unix milliseconds; a
+// negative timestamp uses the time the leader appended the entry to the
+// Raft log. It returns the timestamp of the sample. Adding a sample drops
+// those past retention, as of the log time.
+func (s *Store) TSAdd(key string, timestamp int64, value float64, opts TSOptions) (int64, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, ErrNotFinite
+	}
+	resp, err := s.apply(&command{
+		Op:          "tsadd",
+		Key:         key,
+		Timestamp:   timestamp,
+		FloatValue:  value,
+		Retention:   opts.Retention.Milliseconds(),
+		FieldValues: opts.Labels,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int64), nil
+}
+
+// TSRange returns the samples of the time series stored at key between
+// from and to inclusive, summarized by agg if it is not nil. Retention is
+// applied as of the log time of the last applied entry rather than the
+// clock, so every replica returns the same samples.
+func (s *Store) TSRange(key string, from, to int64, agg *TSAggregation) ([]TSSample, error) {
+	if err := checkAggregation(agg); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, err := s.timeSeries(key)
+	if err != nil {
+		return nil, err
+	}
+	if ts == nil {
+		return nil, ErrKeyNotFound
+	}
+	return aggregate(ts.rangeOf(from, to, s.applied), agg), nil
+}
+
+// TSMRange returns the samples between from and to inclusive of every time
+// series whose labels match all of filters, ordered by key. Samples are
+// summarized by agg if it is not nil. Like TSRange, it applies retention as
+// of the last applied log time.
+func (s *Store) TSMRange(from, to int64, filters []string, agg *TSAggregation) ([]TSSeries, error) {
+	if err := checkAggregation(agg); err != nil {
+		return nil, err
+	}
+	matchers := make([]labelFilter, len(filters))
+	for i, filter := range filters {
+		var err error
+		if matchers[i], err = parseLabelFilter(filter); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	series := []TSSeries{}
+	for _, key := range s.cache.Keys() {
+		item, ok := s.peek(key)
+		if !ok {
+			continue
+		}
+		ts, ok := item.value.(*tsValue)
+		if !ok || !matchLabels(ts.Labels, matchers) {
+			continue
+		}
+		labels := make(map[string]string, len(ts.Labels))
+		for k, v := range ts.Labels {
+			labels[k] = v
+		}
+		series = append(series, TSSeries{
+			Key:     key,
+			Labels:  labels,
+			Samples: aggregate(ts.rangeOf(from, to, s.applied), agg),
+		})
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].Key < series[j].Key })
+	return series, nil
+}
+
+func matchLabels(labels map[string]string, matchers []labelFilter) bool {
+	for _, m := range matchers {
+		if !m.match(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// timeSeries returns the time series stored at key, or nil if the key does
+// not exist. It must be called with the lock held.
+func (s *Store) timeSeries(key string) (*tsValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	ts, ok := item.value.(*tsValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return ts, nil
+}
+
+// applyTSAdd adds a sample. The retention and labels are only used when the
+// series is created.
+func (f *fsm) applyTSAdd(key string, timestamp int64, value float64, retention int64, labels map[string]string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		item = cacheItem{value: &tsValue{Retention: retention, Labels: labels}}
+	}
+	ts, ok := item.value.(*tsValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	if timestamp < 0 {
+		timestamp = now.UnixMilli()
+	}
+	cutoff := ts.cutoff(now)
+	if timestamp < cutoff {
+		return ErrSampleTooOld
+	}
+	i := ts.search(timestamp)
+	if i < len(ts.Samples) && ts.Samples[i].Timestamp == timestamp {
+		return ErrDuplicateSample
+	}
+	ts.Samples = append(ts.Samples, TSSample{})
+	copy(ts.Samples[i+1:], ts.Samples[i:])
+	ts.Samples[i] = TSSample{Timestamp: timestamp, Value: value}
+
+	// Drop the samples past retention as of the log time, so every replica
+	// trims the same samples.
+	if n := ts.search(cutoff); n > 0 {
+		ts.Samples = append([]TSSample(nil), ts.Samples[n:]...)
+	}
+	f.cache.Add(key, item)
+	return timestamp
+}
@@ -0,0 +1,196 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+)
+
+func TestTimeSeries(t *testing.T) {
+	s := openStore(t)
+
+	for _, sample := range []TSSample{{1000, 1}, {3000, 3}, {2000, 2}, {61000, 10}} {
+		if ts, err := s.TSAdd("ts", sample.Timestamp, sample.Value, TSOptions{}); err != nil || ts != sample.Timestamp {
+			t.Fatalf("TSAdd(%d) = %d, %v", sample.Timestamp, ts, err)
+		}
+	}
+	if _, err := s.TSAdd("ts", 2000, 5, TSOptions{}); !errors.Is(err, ErrDuplicateSample) {
+		t.Fatalf("TSAdd of a duplicate timestamp = %v, want %v", err, ErrDuplicateSample)
+	}
+
+	tests := []struct {
+		name     string
+		from, to int64
+		agg      *TSAggregation
+		want     []TSSample
+	}{
+		{"all", 0, 1 << 62, nil, []TSSample{{1000, 1}, {2000, 2}, {3000, 3}, {61000, 10}}},
+		{"inclusive bounds", 2000, 3000, nil, []TSSample{{2000, 2}, {3000, 3}}},
+		{"empty", 4000, 5000, nil, []TSSample{}},
+		{"avg", 0, 1 << 62, &TSAggregation{"avg", 60000}, []TSSample{{0, 2}, {60000, 10}}},
+		{"sum", 0, 1 << 62, &TSAggregation{"sum", 60000}, []TSSample{{0, 6}, {60000, 10}}},
+		{"count", 1500, 1 << 62, &TSAggregation{"count", 60000}, []TSSample{{0, 2}, {60000, 1}}},
+		{"max", 0, 1 << 62, &TSAggregation{"max", 2000}, []TSSample{{0, 1}, {2000, 3}, {60000, 10}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.TSRange("ts", tt.from, tt.to, tt.agg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := s.TSRange("ts", 0, 1, &TSAggregation{"median", 1000}); !errors.Is(err, ErrInvalidAggregation) {
+		t.Fatalf("TSRange with an unknown aggregation = %v, want %v", err, ErrInvalidAggregation)
+	}
+	if _, err := s.TSRange("ts", 0, 1, &TSAggregation{"avg", 0}); !errors.Is(err, ErrInvalidAggregation) {
+		t.Fatalf("TSRange with a zero bucket = %v, want %v", err, ErrInvalidAggregation)
+	}
+	if _, err := s.TSRange("missing", 0, 1, nil); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("TSRange on a missing key = %v, want %v", err, ErrKeyNotFound)
+	}
+	if err := s.Set("str", "v"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.TSAdd("str", 1, 1, TSOptions{}); !errors.Is(err, ErrWrongType) {
+		t.Fatalf("TSAdd on a string = %v, want %v", err, ErrWrongType)
+	}
+}
+
+func TestTSMRange(t *testing.T) {
+	s := openStore(t)
+
+	series := map[string]map[string]string{
+		"cpu-a": {"metric": "cpu", "host": "a"},
+		"cpu-b": {"metric": "cpu", "host": "b"},
+		"mem-a": {"metric": "mem", "host": "a"},
+		"plain": nil,
+	}
+	for key, labels := range series {
+		if _, err := s.TSAdd(key, 1000, 1, TSOptions{Labels: labels}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		filters []string
+		want    []string
+	}{
+		{[]string{"metric=cpu"}, []string{"cpu-a", "cpu-b"}},
+		{[]string{"metric=cpu", "host!=a"}, []string{"cpu-b"}},
+		{[]string{"metric=(cpu,mem)", "host=a"}, []string{"cpu-a", "mem-a"}},
+		{[]string{"metric!=(cpu,mem)"}, []string{"plain"}},
+		{[]string{"metric="}, []string{"plain"}},
+		{[]string{"host!="}, []string{"cpu-a", "cpu-b", "mem-a"}},
+		{[]string{"metric=disk"}, nil},
+	}
+	for _, tt := range tests {
+		got, err := s.TSMRange(0, 2000, tt.filters, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, ts := range got {
+			keys = append(keys, ts.Key)
+		}
+		if !reflect.DeepEqual(keys, tt.want) {
+			t.Errorf("TSMRange(%v) = %v, want %v", tt.filters, keys, tt.want)
+		}
+	}
+
+	for _, filter := range []string{"metric", "=cpu", "!=cpu"} {
+		if _, err := s.TSMRange(0, 2000, []string{filter}, nil); !errors.Is(err, ErrInvalidLabelFilter) {
+			t.Errorf("TSMRange(%q) = %v, want %v", filter, err, ErrInvalidLabelFilter)
+		}
+	}
+}
+
+func TestBucketStart(t *testing.T) {
+	tests := []struct {
+		timestamp, bucket, want int64
+	}{
+		{0, 1000, 0},
+		{999, 1000, 0},
+		{1000, 1000, 1000},
+		{-1, 1000, -1000},
+		{-1000, 1000, -1000},
+	}
+	for _, tt := range tests {
+		if got := bucketStart(tt.timestamp, tt.bucket); got != tt.want {
+			t.Errorf("bucketStart(%d, %d) = %d, want %d", tt.timestamp, tt.bucket, got, tt.want)
+		}
+	}
+}
+
+func TestApplyTSAddRetention(t *testing.T) {
+	f := (*fsm)(New(true))
+	now := time.UnixMilli(100000)
+
+	f.applyTSAdd("ts", 95000, 1, 10000, nil, now)
+	f.applyTSAdd("ts", 99000, 2, 10000, nil, now)
+	if err := f.applyTSAdd("ts", 89999, 3, 10000, nil, now); err != ErrSampleTooOld {
+		t.Fatalf("adding a sample past retention = %v, want %v", err, ErrSampleTooOld)
+	}
+
+	// Samples are trimmed as of the log time of the entry, not the wall clock.
+	if got := f.applyTSAdd("ts", -1, 3, 10000, nil, now.Add(6*time.Second)); got != int64(106000) {
+		t.Fatalf("adding a sample at the log time = %v, want 106000", got)
+	}
+	item, _ := f.lookup("ts", now)
+	if want := []TSSample{{99000, 2}, {106000, 3}}; !reflect.DeepEqual(item.value.(*tsValue).Samples, want) {
+		t.Fatalf("got samples %v, want %v", item.value.(*tsValue).Samples, want)
+	}
+}
+
+// TestTSRangeLogTime checks that reads apply retention as of the log time of
+// the last applied entry, so replicas agree whatever their clocks say.
+func TestTSRangeLogTime(t *testing.T) {
+	s := New(true)
+	f := (*fsm)(s)
+	apply := func(c *command, at int64) {
+		t.Helper()
+		b, err := json.Marshal(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err, ok := f.Apply(&raft.Log{Data: b, AppendedAt: time.UnixMilli(at)}).(error); ok {
+			t.Fatal(err)
+		}
+	}
+
+	apply(&command{Op: "tsadd", Key: "ts", Timestamp: 95000, FloatValue: 1, Retention: 10000}, 100000)
+	if got, err := s.TSRange("ts", 0, 1<<62, nil); err != nil || len(got) != 1 {
+		t.Fatalf("TSRange = %v, %v, want the sample kept as of the log time", got, err)
+	}
+
+	apply(&command{Op: "set", Key: "other", Value: "v"}, 106000)
+	if got, err := s.TSRange("ts", 0, 1<<62, nil); err != nil || len(got) != 0 {
+		t.Fatalf("TSRange = %v, %v, want no samples past retention", got, err)
+	}
+	if got, err := s.TSMRange(0, 1<<62, nil, nil); err != nil || len(got) != 1 || len(got[0].Samples) != 0 {
+		t.Fatalf("TSMRange = %v, %v, want one series without samples", got, err)
+	}
+}
+
+// TestApplyTSAddExisting checks that the options of a TS.ADD to an existing
+// series are ignored.
+func TestApplyTSAddExisting(t *testing.T) {
+	f := (*fsm)(New(true))
+	now := time.UnixMilli(100000)
+
+	f.applyTSAdd("ts", 95000, 1, 10000, map[string]string{"a": "1"}, now)
+	f.applyTSAdd("ts", 96000, 2, 0, map[string]string{"b": "2"}, now)
+	item, _ := f.lookup("ts", now)
+	ts := item.value.(*tsValue)
+	if ts.Retention != 10000 || !reflect.DeepEqual(ts.Labels, map[string]string{"a": "1"}) {
+		t.Fatalf("got retention %d and labels %v, want those of the first add", ts.Retention, ts.Labels)
+	}
+}
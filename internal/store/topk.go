@@ -0,0 +1,259 @@
+package store
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"time"
+)
+
+const (
+	topKMaxBuckets = 1 << 24 // 256 MB
+	topKSeed       = 0x9747b28c
+
+	// topKDecayScale is the denominator of the decay probability, which is
+	// kept as an integer so every replica makes the same decisions.
+	topKDecayScale = 1000000
+)
+
+// topKValue is the value of a Top-K key. It is a HeavyKeeper sketch: depth
+// rows of width buckets, each holding a fingerprint and a count, along with
+// the k items with the highest counts seen so far.
+type topKValue struct {
+	K       int          `json:"k"`
+	Width   int          `json:"width"`
+	Depth   int          `json:"depth"`
+	Decay   int          `json:"decay"` // Probability out of topKDecayScale
+	Buckets []topKBucket `json:"buckets"`
+	Top     []TopKItem   `json:"top"` // Highest count first
+}
+
+type topKBucket struct {
+	Fingerprint uint64 `json:"fp"`
+	Count       uint64 `json:"count"`
+}
+
+// TopKItem is an item of a Top-K sketch and its estimated count.
+type TopKItem struct {
+	Item  string `json:"item"`
+	Count uint64 `json:"count"`
+}
+
+func (t *topKValue) clone() *topKValue {
+	c := *t
+	c.Buckets, c.Top = slices.Clone(t.Buckets), slices.Clone(t.Top)
+	return &c
+}
+
+// add counts item and returns the item expelled from the top k to make
+// room for it, or the empty string.
+func (t *topKValue) add(item string, r *rand.Rand) string {
+	fp := murmurHash64A([]byte(item), topKSeed)
+	var count uint64
+	for row := 0; row < t.Depth; row++ {
+		h := murmurHash64A([]byte(item), uint64(row))
+		b := &t.Buckets[row*t.Width+int(h%uint64(t.Width))]
+		switch {
+		case b.Count == 0:
+			b.Fingerprint, b.Count = fp, 1
+		case b.Fingerprint == fp:
+			b.Count++
+		case t.decays(b.Count, r):
+			// Another item holds the bucket. Its count decays with
+			// probability decay^count, and the bucket is taken over once it
+			// reaches zero.
+			b.Count--
+			if b.Count == 0 {
+				b.Fingerprint, b.Count = fp, 1
+			}
+		default:
+			continue
+		}
+		if b.Fingerprint == fp {
+			count = max(count, b.Count)
+		}
+	}
+
+	if i := t.find(item); i >= 0 {
+		t.Top[i].Count = count
+		t.sort()
+		return ""
+	}
+	if count == 0 {
+		return ""
+	}
+	if len(t.Top) < t.K {
+		t.Top = append(t.Top, TopKItem{item, count})
+		t.sort()
+		return ""
+	}
+	if last := len(t.Top) - 1; count > t.Top[last].Count {
+		expelled := t.Top[last].Item
+		t.Top[last] = TopKItem{item, count}
+		t.sort()
+		return expelled
+	}
+	return ""
+}
+
+// decays reports whether a bucket with count decays, which happens with
+// probability decay^count.
+func (t *topKValue) decays(count uint64, r *rand.Rand) bool {
+	if t.Decay >= topKDecayScale {
+		return true
+	}
+	for ; count > 0; count-- {
+		if r.Intn(topKDecayScale) >= t.Decay {
+			return false
+		}
+	}
+	return true
+}
+
+// find returns the position of item in the top k, or -1.
+func (t *topKValue) find(item string) int {
+	for i, it := range t.Top {
+		if it.Item == item {
+			return i
+		}
+	}
+	return -1
+}
+
+// sort orders the top k by count, breaking ties by item.
+func (t *topKValue) sort() {
+	sort.Slice(t.Top, func(i, j int) bool {
+		if t.Top[i].Count != t.Top[j].Count {
+			return t.Top[i].Count > t.Top[j].Count
+		}
+		return t.Top[i].Item < t.Top[j].Item
+	})
+}
+
+// TopKReserve creates an empty Top-K sketch at key that tracks the k most
+// frequent items, using depth rows of width buckets. Counts held by other
+// items decay with probability decay^count. It fails if the key exists.
+func (s *Store) TopKReserve(key string, k, width, depth int, decay float64) error {
+	if k < 1 || width < 1 || depth < 1 || width > topKMaxBuckets/depth || !(decay > 0 && decay <= 1) {
+		return ErrInvalidFilter
+	}
+	_, err := s.apply(&command{
+		Op:    "topkreserve",
+		Key:   key,
+		Count: k,
+		Width: width,
+		Depth: depth,
+		Decay: int(decay * topKDecayScale),
+	})
+	return err
+}
+
+// TopKAdd adds items to the Top-K sketch stored at key. For each item it
+// returns the item that was expelled from the top k to make room for it,
+// or the empty string.
+func (s *Store) TopKAdd(key string, items ...string) ([]string, error) {
+	resp, err := s.apply(&command{
+		Op:     "topkadd",
+		Key:    key,
+		Values: items,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]string), nil
+}
+
+// TopKQuery reports for each item whether it is among the top k of the
+// sketch stored at key.
+func (s *Store) TopKQuery(key string, items ...string) ([]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.topK(key)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, ErrKeyNotFound
+	}
+	found := make([]bool, len(items))
+	for i, item := range items {
+		found[i] = t.find(item) >= 0
+	}
+	return found, nil
+}
+
+// TopKList returns the top k items of the sketch stored at key with their
+// estimated counts, highest first.
+func (s *Store) TopKList(key string) ([]TopKItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.topK(key)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, ErrKeyNotFound
+	}
+	return append([]TopKItem(nil), t.Top...), nil
+}
+
+// topK returns the Top-K sketch stored at key, or nil if the key does not
+// exist. It must be called with the lock held.
+func (s *Store) topK(key string) (*topKValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	t, ok := item.value.(*topKValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return t, nil
+}
+
+func (f *fsm) applyTopKReserve(key string, k, width, depth, decay int, now time.Time) interface{} {
+	if k < 1 || width < 1 || depth < 1 || width > topKMaxBuckets/depth || decay < 1 {
+		return ErrInvalidFilter
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.lookup(key, now); ok {
+		return ErrKeyExists
+	}
+	f.cache.Add(key, cacheItem{value: &topKValue{
+		K:       k,
+		Width:   width,
+		Depth:   depth,
+		Decay:   decay,
+		Buckets: make([]topKBucket, width*depth),
+	}})
+	return nil
+}
+
+func (f *fsm) applyTopKAdd(key string, items []string, seed uint64, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return ErrKeyNotFound
+	}
+	t, ok := item.value.(*topKValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	// Decay with a seed taken from the log so every replica makes the same
+	// decisions.
+	r := rand.New(rand.NewSource(int64(seed)))
+	expelled := make([]string, len(items))
+	for i, it := range items {
+		expelled[i] = t.add(it, r)
+	}
+	f.cache.Add(key, item)
+	return expelled
+}
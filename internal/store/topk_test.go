@@ -0,0 +1,92 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTopK(t *testing.T) {
+	s := openStore(t)
+
+	if err := s.TopKReserve("topk", 2, 50, 4, 0.9); err != nil {
+		t.Fatal(err)
+	}
+	var items []string
+	for i, n := range []int{50, 30, 5, 1} {
+		for j := 0; j < n; j++ {
+			items = append(items, fmt.Sprintf("item-%d", i))
+		}
+	}
+	if _, err := s.TopKAdd("topk", items...); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := s.TopKList("topk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []TopKItem{{"item-0", 50}, {"item-1", 30}}; !reflect.DeepEqual(list, want) {
+		t.Fatalf("TopKList = %v, want %v", list, want)
+	}
+	found, err := s.TopKQuery("topk", "item-0", "item-2", "missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []bool{true, false, false}; !reflect.DeepEqual(found, want) {
+		t.Fatalf("TopKQuery = %v, want %v", found, want)
+	}
+
+	// An item that overtakes the last of the top k expels it.
+	var expelled []string
+	for i := 0; i < 40; i++ {
+		e, err := s.TopKAdd("topk", "item-3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e[0] != "" {
+			expelled = append(expelled, e[0])
+		}
+	}
+	if want := []string{"item-1"}; !reflect.DeepEqual(expelled, want) {
+		t.Fatalf("expelled %v, want %v", expelled, want)
+	}
+
+	if err := s.TopKReserve("topk", 2, 8, 7, 0.9); !errors.Is(err, ErrKeyExists) {
+		t.Fatalf("TopKReserve on an existing key = %v, want %v", err, ErrKeyExists)
+	}
+	if _, err := s.TopKAdd("missing", "a"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("TopKAdd on a missing key = %v, want %v", err, ErrKeyNotFound)
+	}
+	if _, err := s.TopKList("missing"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("TopKList on a missing key = %v, want %v", err, ErrKeyNotFound)
+	}
+	for _, decay := range []float64{0, 1.5} {
+		if err := s.TopKReserve("bad", 2, 8, 7, decay); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("TopKReserve with decay %v = %v, want %v", decay, err, ErrInvalidFilter)
+		}
+	}
+}
+
+func TestApplyTopKAdd(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	// A narrow sketch makes items contend for buckets, so counts decay.
+	var items []string
+	for i := 0; i < 500; i++ {
+		items = append(items, fmt.Sprintf("item-%d", i%20))
+	}
+	var got [][]TopKItem
+	for i := 0; i < 2; i++ {
+		f := (*fsm)(New(true))
+		f.applyTopKReserve("topk", 5, 4, 2, topKDecayScale/2, now)
+		f.applyTopKAdd("topk", items, 42, now)
+		item, _ := f.lookup("topk", now)
+		got = append(got, item.value.(*topKValue).Top)
+	}
+	if !reflect.DeepEqual(got[0], got[1]) {
+		t.Fatalf("replicas listed %v and %v", got[0], got[1])
+	}
+}
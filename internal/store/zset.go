@@ -0,0 +1,602 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrMemberNotFound is returned when a sorted set member does not exist.
+	ErrMemberNotFound = errors.New("member not found")
+
+	// ErrInvalidRange is returned when a score or lex range bound cannot be parsed.
+	ErrInvalidRange = errors.New("min or max is not a valid range bound")
+)
+
+// ZMember is a member of a sorted set together with its score.
+type ZMember struct {
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+}
+
+// ZAddOptions controls how ZAdd treats new and existing members.
+type ZAddOptions struct {
+	NX bool // Only add new members
+	XX bool // Only update existing members
+	GT bool // Only update a score if the new score is greater
+	LT bool // Only update a score if the new score is less
+	CH bool // Count changed members as well as added ones
+}
+
+// flags returns the options as command flags.
+func (o ZAddOptions) flags() []string {
+	var flags []string
+	for _, f := range []struct {
+		set  bool
+		name string
+	}{{o.NX, "nx"}, {o.XX, "xx"}, {o.GT, "gt"}, {o.LT, "lt"}, {o.CH, "ch"}} {
+		if f.set {
+			flags = append(flags, f.name)
+		}
+	}
+	return flags
+}
+
+// zaddOptions converts command flags back to options. It also reports
+// whether the incr flag is set.
+func zaddOptions(flags []string) (ZAddOptions, bool) {
+	var o ZAddOptions
+	incr := false
+	for _, f := range flags {
+		switch f {
+		case "nx":
+			o.NX = true
+		case "xx":
+			o.XX = true
+		case "gt":
+			o.GT = true
+		case "lt":
+			o.LT = true
+		case "ch":
+			o.CH = true
+		case "incr":
+			incr = true
+		}
+	}
+	return o, incr
+}
+
+// zaddResult is returned by the FSM for ZADD.
+type zaddResult struct {
+	count   int     // Members added, or added and changed with CH
+	score   float64 // New score of the member with INCR
+	updated bool    // Whether the INCR was applied
+}
+
+// zsetValue is the value of a sorted set key. Scores are kept in a map for
+// lookups by member and in a skiplist for range queries.
+type zsetValue struct {
+	scores map[string]float64
+	zsl    *skiplist
+}
+
+func newZSet() *zsetValue {
+	return &zsetValue{scores: make(map[string]float64), zsl: newSkiplist()}
+}
+
+// MarshalJSON encodes the sorted set as an array of members in rank order.
+func (z *zsetValue) MarshalJSON() ([]byte, error) {
+	members := make([]ZMember, 0, z.zsl.length)
+	for x := z.zsl.header.level[0].forward; x != nil; x = x.level[0].forward {
+		members = append(members, ZMember{Member: x.member, Score: x.score})
+	}
+	return json.Marshal(members)
+}
+
+// clone copies the sorted set, keeping the shape of its skiplist.
+func (z *zsetValue) clone() *zsetValue {
+	return &zsetValue{scores: maps.Clone(z.scores), zsl: z.zsl.clone()}
+}
+
+func (z *zsetValue) UnmarshalJSON(b []byte) error {
+	var members []ZMember
+	if err := json.Unmarshal(b, &members); err != nil {
+		return err
+	}
+	*z = *newZSet()
+	for _, m := range members {
+		z.add(m.Member, m.Score)
+	}
+	return nil
+}
+
+// add sets the score of member, inserting it if needed.
+func (z *zsetValue) add(member string, score float64) {
+	if cur, ok := z.scores[member]; ok {
+		if cur == score {
+			return
+		}
+		z.zsl.delete(cur, member)
+	}
+	z.scores[member] = score
+	z.zsl.insert(score, member)
+}
+
+// remove deletes member and reports whether it was present.
+func (z *zsetValue) remove(member string) bool {
+	score, ok := z.scores[member]
+	if !ok {
+		return false
+	}
+	delete(z.scores, member)
+	z.zsl.delete(score, member)
+	return true
+}
+
+// collect walks the range from its first (or, with rev, last) node, skips
+// offset members and returns up to count of them. A negative count returns
+// every remaining member in the range.
+func (z *zsetValue) collect(r zrange, rev bool, offset, count int) []ZMember {
+	var x *skiplistNode
+	if rev {
+		x = z.zsl.last(r)
+	} else {
+		x = z.zsl.first(r)
+	}
+
+	var members []ZMember
+	for ; x != nil && count != 0; offset-- {
+		if rev && !r.aboveMin(x) || !rev && !r.belowMax(x) {
+			break
+		}
+		if offset <= 0 {
+			members = append(members, ZMember{Member: x.member, Score: x.score})
+			count--
+		}
+		if rev {
+			x = x.backward
+		} else {
+			x = x.level[0].forward
+		}
+	}
+	return members
+}
+
+// zrange is a range of sorted set members.
+type zrange interface {
+	aboveMin(n *skiplistNode) bool // n is not below the start of the range
+	belowMax(n *skiplistNode) bool // n is not above the end of the range
+}
+
+// ScoreRange is a range of sorted set scores.
+type ScoreRange struct {
+	Min, Max                   float64
+	MinExclusive, MaxExclusive bool
+}
+
+// ParseScoreRange parses Redis-style score bounds such as "1.5", "(1.5",
+// "-inf" and "+inf".
+func ParseScoreRange(min, max string) (ScoreRange, error) {
+	var r ScoreRange
+	var err error
+	if r.Min, r.MinExclusive, err = parseScoreBound(min); err != nil {
+		return ScoreRange{}, err
+	}
+	if r.Max, r.MaxExclusive, err = parseScoreBound(max); err != nil {
+		return ScoreRange{}, err
+	}
+	return r, nil
+}
+
+func parseScoreBound(s string) (float64, bool, error) {
+	exclusive := strings.HasPrefix(s, "(")
+	if exclusive {
+		s = s[1:]
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) {
+		return 0, false, ErrInvalidRange
+	}
+	return f, exclusive, nil
+}
+
+func (r ScoreRange) aboveMin(n *skiplistNode) bool {
+	if r.MinExclusive {
+		return n.score > r.Min
+	}
+	return n.score >= r.Min
+}
+
+func (r ScoreRange) belowMax(n *skiplistNode) bool {
+	if r.MaxExclusive {
+		return n.score < r.Max
+	}
+	return n.score <= r.Max
+}
+
+// LexRange is a range of sorted set members, compared byte-wise. It is only
+// meaningful when all members have the same score.
+type LexRange struct {
+	min, max lexBound
+}
+
+type lexBound struct {
+	value     string
+	exclusive bool
+	inf       int // -1 for "-", +1 for "+", 0 for a value
+}
+
+// ParseLexRange parses Redis-style lex bounds: "[a" and "(a" are inclusive
+// and exclusive bounds, "-" and "+" are the smallest and largest strings.
+func ParseLexRange(min, max string) (LexRange, error) {
+	var r LexRange
+	var err error
+	if r.min, err = parseLexBound(min); err != nil {
+		return LexRange{}, err
+	}
+	if r.max, err = parseLexBound(max); err != nil {
+		return LexRange{}, err
+	}
+	return r, nil
+}
+
+func parseLexBound(s string) (lexBound, error) {
+	switch {
+	case s == "-":
+		return lexBound{inf: -1}, nil
+	case s == "+":
+		return lexBound{inf: 1}, nil
+	case strings.HasPrefix(s, "["):
+		return lexBound{value: s[1:]}, nil
+	case strings.HasPrefix(s, "("):
+		return lexBound{value: s[1:], exclusive: true}, nil
+	default:
+		return lexBound{}, ErrInvalidRange
+	}
+}
+
+func (r LexRange) aboveMin(n *skiplistNode) bool {
+	switch {
+	case r.min.inf != 0:
+		return r.min.inf < 0
+	case r.min.exclusive:
+		return n.member > r.min.value
+	default:
+		return n.member >= r.min.value
+	}
+}
+
+func (r LexRange) belowMax(n *skiplistNode) bool {
+	switch {
+	case r.max.inf != 0:
+		return r.max.inf > 0
+	case r.max.exclusive:
+		return n.member < r.max.value
+	default:
+		return n.member <= r.max.value
+	}
+}
+
+// ZAdd adds members to the sorted set stored at key or updates their scores,
+// creating the set if it does not exist. It returns the number of members
+// added, or with CH the number of members added or changed. Scores must be
+// finite.
+func (s *Store) ZAdd(key string, members []ZMember, opts ZAddOptions) (int, error) {
+	c := &command{
+		Op:    "zadd",
+		Key:   key,
+		Flags: opts.flags(),
+	}
+	for _, m := range members {
+		if math.IsNaN(m.Score) || math.IsInf(m.Score, 0) {
+			return 0, ErrNotFinite
+		}
+		c.Values = append(c.Values, m.Member)
+		c.Scores = append(c.Scores, m.Score)
+	}
+
+	resp, err := s.apply(c)
+	if err != nil {
+		return 0, err
+	}
+	return resp.(zaddResult).count, nil
+}
+
+// ZAddIncr increments the score of member in the sorted set stored at key
+// by delta, like ZADD with the INCR option. It returns the new score, or
+// false if the options prevented the update.
+func (s *Store) ZAddIncr(key, member string, delta float64, opts ZAddOptions) (float64, bool, error) {
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return 0, false, ErrNotFinite
+	}
+	resp, err := s.apply(&command{
+		Op:     "zadd",
+		Key:    key,
+		Values: []string{member},
+		Scores: []float64{delta},
+		Flags:  append(opts.flags(), "incr"),
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	res := resp.(zaddResult)
+	return res.score, res.updated, nil
+}
+
+// ZRem removes members from the sorted set stored at key and returns the
+// number of members that were removed. The key is deleted once the set is
+// empty.
+func (s *Store) ZRem(key string, members ...string) (int, error) {
+	resp, err := s.apply(&command{
+		Op:     "zrem",
+		Key:    key,
+		Values: members,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.(int), nil
+}
+
+// ZScore returns the score of member in the sorted set stored at key.
+func (s *Store) ZScore(key, member string) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z, err := s.zset(key)
+	if err != nil {
+		return 0, err
+	}
+	if z == nil {
+		return 0, ErrKeyNotFound
+	}
+	score, ok := z.scores[member]
+	if !ok {
+		return 0, ErrMemberNotFound
+	}
+	return score, nil
+}
+
+// ZRank returns the 0-based rank of member in the sorted set stored at key,
+// ordered from the lowest score, or with rev from the highest.
+func (s *Store) ZRank(key, member string, rev bool) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z, err := s.zset(key)
+	if err != nil {
+		return 0, err
+	}
+	if z == nil {
+		return 0, ErrKeyNotFound
+	}
+	score, ok := z.scores[member]
+	if !ok {
+		return 0, ErrMemberNotFound
+	}
+	rank := z.zsl.rank(score, member) - 1
+	if rev {
+		rank = z.zsl.length - 1 - rank
+	}
+	return rank, nil
+}
+
+// ZRange returns the members of the sorted set stored at key between the
+// ranks start and stop inclusive. Negative ranks count from the end. With
+// rev, ranks are counted from the highest score.
+func (s *Store) ZRange(key string, start, stop int64, rev bool) ([]ZMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z, err := s.zset(key)
+	if err != nil || z == nil {
+		return nil, err
+	}
+	lo, hi := listRange(start, stop, z.zsl.length)
+	if lo == hi {
+		return nil, nil
+	}
+
+	members := make([]ZMember, 0, hi-lo)
+	if rev {
+		x := z.zsl.byRank(z.zsl.length - lo)
+		for i := lo; i < hi; i++ {
+			members = append(members, ZMember{Member: x.member, Score: x.score})
+			x = x.backward
+		}
+	} else {
+		x := z.zsl.byRank(lo + 1)
+		for i := lo; i < hi; i++ {
+			members = append(members, ZMember{Member: x.member, Score: x.score})
+			x = x.level[0].forward
+		}
+	}
+	return members, nil
+}
+
+// ZRangeByScore returns the members of the sorted set stored at key with a
+// score in r, from the lowest score or with rev from the highest. It skips
+// offset members and returns at most count; a negative count means no limit.
+func (s *Store) ZRangeByScore(key string, r ScoreRange, rev bool, offset, count int) ([]ZMember, error) {
+	return s.zrangeBy(key, r, rev, offset, count)
+}
+
+// ZRangeByLex is like ZRangeByScore but selects members by a lex range.
+func (s *Store) ZRangeByLex(key string, r LexRange, rev bool, offset, count int) ([]ZMember, error) {
+	return s.zrangeBy(key, r, rev, offset, count)
+}
+
+func (s *Store) zrangeBy(key string, r zrange, rev bool, offset, count int) ([]ZMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z, err := s.zset(key)
+	if err != nil || z == nil {
+		return nil, err
+	}
+	return z.collect(r, rev, offset, count), nil
+}
+
+// ZCount returns the number of members of the sorted set stored at key with
+// a score in r.
+func (s *Store) ZCount(key string, r ScoreRange) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z, err := s.zset(key)
+	if err != nil || z == nil {
+		return 0, err
+	}
+	first, last := z.zsl.first(r), z.zsl.last(r)
+	if first == nil || last == nil {
+		return 0, nil
+	}
+	return z.zsl.rank(last.score, last.member) - z.zsl.rank(first.score, first.member) + 1, nil
+}
+
+// ZPopMin removes and returns up to count members with the lowest scores
+// from the sorted set stored at key.
+func (s *Store) ZPopMin(key string, count int) ([]ZMember, error) {
+	return s.zpop("zpopmin", key, count)
+}
+
+// ZPopMax removes and returns up to count members with the highest scores
+// from the sorted set stored at key.
+func (s *Store) ZPopMax(key string, count int) ([]ZMember, error) {
+	return s.zpop("zpopmax", key, count)
+}
+
+func (s *Store) zpop(op, key string, count int) ([]ZMember, error) {
+	resp, err := s.apply(&command{
+		Op:    op,
+		Key:   key,
+		Count: count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.([]ZMember), nil
+}
+
+// zset returns the sorted set stored at key, or nil if the key does not
+// exist. It must be called with the lock held.
+func (s *Store) zset(key string) (*zsetValue, error) {
+	item, ok := s.peek(key)
+	if !ok {
+		return nil, nil
+	}
+	z, ok := item.value.(*zsetValue)
+	if !ok {
+		return nil, ErrWrongType
+	}
+	return z, nil
+}
+
+func (f *fsm) applyZAdd(key string, members []string, scores []float64, flags []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	opts, incr := zaddOptions(flags)
+	item, ok := f.lookup(key, now)
+	if !ok {
+		if opts.XX {
+			return zaddResult{}
+		}
+		item = cacheItem{value: newZSet()}
+	}
+	z, ok := item.value.(*zsetValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	var res zaddResult
+	for i, member := range members {
+		score := scores[i]
+		cur, exists := z.scores[member]
+		switch {
+		case exists && opts.NX, !exists && opts.XX:
+			continue
+		case exists && incr:
+			score += cur
+			if math.IsInf(score, 0) {
+				return ErrNotFinite
+			}
+		}
+		if exists && (opts.GT && score <= cur || opts.LT && score >= cur) {
+			continue
+		}
+
+		res.score, res.updated = score, true
+		if !exists {
+			res.count++
+		} else if score != cur && opts.CH {
+			res.count++
+		}
+		z.add(member, score)
+	}
+	if z.zsl.length > 0 {
+		f.cache.Add(key, item)
+	}
+	return res
+}
+
+func (f *fsm) applyZRem(key string, members []string, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return 0
+	}
+	z, ok := item.value.(*zsetValue)
+	if !ok {
+		return ErrWrongType
+	}
+
+	removed := 0
+	for _, m := range members {
+		if z.remove(m) {
+			removed++
+		}
+	}
+	if z.zsl.length == 0 {
+		f.cache.Remove(key)
+	}
+	return removed
+}
+
+func (f *fsm) applyZPop(key string, count int, max bool, now time.Time) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	item, ok := f.lookup(key, now)
+	if !ok {
+		return []ZMember{}
+	}
+	z, ok := item.value.(*zsetValue)
+	if !ok {
+		return ErrWrongType
+	}
+	if count < 1 {
+		count = 1
+	}
+
+	popped := make([]ZMember, 0, min(count, z.zsl.length))
+	for len(popped) < count && z.zsl.length > 0 {
+		x := z.zsl.header.level[0].forward
+		if max {
+			x = z.zsl.tail
+		}
+		popped = append(popped, ZMember{Member: x.member, Score: x.score})
+		z.remove(x.member)
+	}
+	if z.zsl.length == 0 {
+		f.cache.Remove(key)
+	}
+	return popped
+}
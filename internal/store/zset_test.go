@@ -0,0 +1,124 @@
+package store
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestZSet(t *testing.T) {
+	s := openStore(t)
+
+	if n, err := s.ZAdd("z", []ZMember{{"a", 1}, {"b", 2}, {"c", 3}}, ZAddOptions{}); err != nil || n != 3 {
+		t.Fatalf("ZAdd = %d, %v, want 3", n, err)
+	}
+
+	tests := []struct {
+		name    string
+		members []ZMember
+		opts    ZAddOptions
+		want    int
+		score   float64 // Score of the first member afterwards
+	}{
+		{"update", []ZMember{{"a", 5}}, ZAddOptions{}, 0, 5},
+		{"changed", []ZMember{{"a", 1}, {"d", 4}}, ZAddOptions{CH: true}, 2, 1},
+		{"nx skips existing", []ZMember{{"a", 9}}, ZAddOptions{NX: true}, 0, 1},
+		{"xx skips new", []ZMember{{"e", 9}}, ZAddOptions{XX: true}, 0, 0},
+		{"gt keeps the higher score", []ZMember{{"b", 1}}, ZAddOptions{GT: true, CH: true}, 0, 2},
+		{"lt lowers the score", []ZMember{{"b", 1}}, ZAddOptions{LT: true, CH: true}, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := s.ZAdd("z", tt.members, tt.opts)
+			if err != nil || n != tt.want {
+				t.Fatalf("ZAdd = %d, %v, want %d", n, err, tt.want)
+			}
+			score, err := s.ZScore("z", tt.members[0].Member)
+			if tt.score == 0 {
+				if !errors.Is(err, ErrMemberNotFound) {
+					t.Fatalf("ZScore = %v, %v, want %v", score, err, ErrMemberNotFound)
+				}
+				return
+			}
+			if err != nil || score != tt.score {
+				t.Fatalf("ZScore = %v, %v, want %v", score, err, tt.score)
+			}
+		})
+	}
+
+	// z now holds a:1 b:1 c:3 d:4.
+	if score, ok, err := s.ZAddIncr("z", "c", 2.5, ZAddOptions{}); err != nil || !ok || score != 5.5 {
+		t.Fatalf("ZAddIncr = %v, %v, %v, want 5.5", score, ok, err)
+	}
+	if _, ok, err := s.ZAddIncr("z", "c", -1, ZAddOptions{GT: true}); err != nil || ok {
+		t.Fatalf("ZAddIncr GT with a lower score = %v, %v, want no update", ok, err)
+	}
+
+	if rank, err := s.ZRank("z", "b", false); err != nil || rank != 1 {
+		t.Fatalf("ZRank(b) = %d, %v, want 1", rank, err)
+	}
+	if rank, err := s.ZRank("z", "b", true); err != nil || rank != 2 {
+		t.Fatalf("ZRevRank(b) = %d, %v, want 2", rank, err)
+	}
+	if _, err := s.ZRank("z", "missing", false); !errors.Is(err, ErrMemberNotFound) {
+		t.Fatalf("ZRank(missing) = %v, want %v", err, ErrMemberNotFound)
+	}
+
+	want := []ZMember{{"a", 1}, {"b", 1}, {"d", 4}, {"c", 5.5}}
+	if got, err := s.ZRange("z", 0, -1, false); err != nil || !reflect.DeepEqual(got, want) {
+		t.Fatalf("ZRange = %v, %v, want %v", got, err, want)
+	}
+	r, err := ParseScoreRange("(1", "+inf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.ZCount("z", r); err != nil || n != 2 {
+		t.Fatalf("ZCount = %d, %v, want 2", n, err)
+	}
+
+	if got, err := s.ZPopMin("z", 1); err != nil || !reflect.DeepEqual(got, []ZMember{{"a", 1}}) {
+		t.Fatalf("ZPopMin = %v, %v, want [a]", got, err)
+	}
+	if got, err := s.ZPopMax("z", 2); err != nil || !reflect.DeepEqual(got, []ZMember{{"c", 5.5}, {"d", 4}}) {
+		t.Fatalf("ZPopMax = %v, %v, want [c d]", got, err)
+	}
+	if n, err := s.ZRem("z", "b", "missing"); err != nil || n != 1 {
+		t.Fatalf("ZRem = %d, %v, want 1", n, err)
+	}
+	if _, err := s.ZScore("z", "b"); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("the emptied sorted set was not deleted: %v", err)
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		min, max string
+		lex      bool
+		wantErr  bool
+	}{
+		{"-inf", "+inf", false, false},
+		{"(1", "2.5", false, false},
+		{"1e3", "(inf", false, false},
+		{"abc", "1", false, true},
+		{"1", "nan", false, true},
+		{"(", "1", false, true},
+		{"-", "+", true, false},
+		{"[a", "(b", true, false},
+		{"a", "[b", true, true},
+		{"[a", "", true, true},
+	}
+	for _, tt := range tests {
+		var err error
+		if tt.lex {
+			_, err = ParseLexRange(tt.min, tt.max)
+		} else {
+			_, err = ParseScoreRange(tt.min, tt.max)
+		}
+		if tt.wantErr != (err != nil) {
+			t.Errorf("parse %q %q: got error %v, want error %v", tt.min, tt.max, err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("parse %q %q: got error %v, want %v", tt.min, tt.max, err, ErrInvalidRange)
+		}
+	}
+}